@@ -0,0 +1,127 @@
+package diceware
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// batchEntropyBufferSize represents the number of bytes each worker of
+// `RollWordsBatchParallel` reads from `crypto/rand` at a time.
+const batchEntropyBufferSize = 4096
+
+// RollWordsBatchParallel returns a slice of strings.
+// Implements the logic required to generate n passphrases from the given
+// options, fanning the work out across the given number of goroutines.  When
+// workers is less than 1, `runtime.GOMAXPROCS` workers are used.  Without a
+// configured RandomSource, each worker buffers its own reads from
+// `crypto/rand`, otherwise the configured RandomSource is shared between the
+// workers and must be safe for concurrent use.
+func RollWordsBatchParallel(opts PassphraseOptions, n, workers int) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, ErrInvalidCount
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > n {
+		workers = n
+	}
+
+	// the words are planned once and shared by every worker, as a Generator
+	// does for every passphrase
+	plan, err := opts.planWords()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	passphrases := make([]string, n)
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			// each worker fills every index congruent to its own number
+			if err := rollBatchWorker(ctx, opts, plan, passphrases, w, workers); err != nil {
+				errs[w] = err
+				cancel()
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+	}
+
+	return passphrases, nil
+}
+
+// rollBatchWorker returns an error.
+// Implements the logic of a single worker of `RollWordsBatchParallel`,
+// filling every index of out from first onwards in the given step, stopping at
+// the first error.
+func rollBatchWorker(ctx context.Context, opts PassphraseOptions, plan wordPlan, out []string, first, step int) error {
+	if opts.RandomSource == nil {
+		opts.RandomSource = newBufferedRandomSource()
+	}
+
+	for i := first; i < len(out); i += step {
+		passphrase, err := rollPlannedPassphrase(ctx, opts, plan)
+		if err != nil {
+			return err
+		}
+
+		out[i] = passphrase.String()
+	}
+
+	return nil
+}
+
+// bufferedRandomSource defines a RandomSource reading from `crypto/rand`
+// through a buffer, reducing the number of reads made to the operating
+// system.  It is not safe for concurrent use.
+type bufferedRandomSource struct {
+	// reader represents the buffered `crypto/rand` reader.
+	reader *bufio.Reader
+}
+
+// newBufferedRandomSource returns an initialized bufferedRandomSource object.
+func newBufferedRandomSource() *bufferedRandomSource {
+	return &bufferedRandomSource{reader: bufio.NewReaderSize(rand.Reader, batchEntropyBufferSize)}
+}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the RandomSource interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// buffered reader.
+func (rs *bufferedRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	return uniformInt(rs.reader, max)
+}
+
+// GetRandom64 returns an int64.
+// It implements the logic for the RandomSource64 interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// buffered reader.
+func (rs *bufferedRandomSource) GetRandom64(max int64) (int64, error) {
+	return uniformInt64(rs.reader, max)
+}
@@ -0,0 +1,98 @@
+package diceware_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingRandomSource is a RandomSource that always fails.
+type failingRandomSource struct{}
+
+var errEntropy = errors.New("entropy source failure")
+
+func (failingRandomSource) GetRandom(*big.Int) (*big.Int, error) {
+	return nil, errEntropy
+}
+
+func TestRollWordsBatchParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	opts := diceware.PassphraseOptions{
+		WordCount: 4,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	}
+
+	failing := opts
+	failing.RandomSource = failingRandomSource{}
+
+	unavailable := opts
+	unavailable.MinWordLength = 50
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Count   int
+		Workers int
+		Error   error
+	}{
+		{
+			Name:    "will error with invalid options",
+			Options: diceware.PassphraseOptions{WordCount: 4},
+			Count:   10,
+			Error:   diceware.ErrInvalidWordlist,
+		}, {
+			Name:    "will error with a negative count",
+			Options: opts,
+			Count:   -1,
+			Error:   diceware.ErrInvalidCount,
+		}, {
+			Name:    "will error when no words meet the constraints",
+			Options: unavailable,
+			Count:   10,
+			Workers: 3,
+			Error:   diceware.ErrNoWordsAvailable,
+		}, {
+			Name:    "will error when the random source fails",
+			Options: failing,
+			Count:   10,
+			Workers: 3,
+			Error:   errEntropy,
+		}, {
+			Name:    "will return an empty slice with a count of zero",
+			Options: opts,
+		}, {
+			Name:    "will generate with the default number of workers",
+			Options: opts,
+			Count:   100,
+		}, {
+			Name:    "will generate with more workers than passphrases",
+			Options: opts,
+			Count:   5,
+			Workers: 16,
+		},
+	}
+
+	for _, test := range tests {
+		passphrases, err := diceware.RollWordsBatchParallel(test.Options, test.Count, test.Workers)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Len(passphrases, test.Count, test.Name)
+		for _, passphrase := range passphrases {
+			assert.Len(strings.Split(passphrase, " "), 4, test.Name)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package diceware
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Capitalization defines the modes in which the words of a passphrase can be
+// capitalized.
+type Capitalization int
+
+const (
+	// CapitalizationNone leaves each of the words as they are within the
+	// wordlist.
+	CapitalizationNone Capitalization = iota
+	// CapitalizationTitleCase upper cases the first letter of every word.
+	CapitalizationTitleCase
+	// CapitalizationAllUpper upper cases every letter of every word.
+	CapitalizationAllUpper
+	// CapitalizationRandomWord upper cases every letter of a single randomly
+	// chosen word.
+	CapitalizationRandomWord
+	// CapitalizationRandomLetter upper cases a single randomly chosen letter
+	// within every word.
+	CapitalizationRandomLetter
+)
+
+// capitalizeWords returns a float64.
+// Implements the logic to capitalize the given words according to the mode,
+// returning the entropy in bits added by any random choices that were made.
+func capitalizeWords(words []string, mode Capitalization, rs RandomSource) (float64, error) {
+	switch mode {
+	case CapitalizationTitleCase:
+		for i, word := range words {
+			words[i] = titleWord(word)
+		}
+	case CapitalizationAllUpper:
+		for i, word := range words {
+			words[i] = strings.ToUpper(word)
+		}
+	case CapitalizationRandomWord:
+		return capitalizeRandomWord(words, rs)
+	case CapitalizationRandomLetter:
+		return capitalizeRandomLetters(words, rs)
+	case CapitalizationNone:
+	}
+
+	return 0, nil
+}
+
+// capitalizeRandomWord returns a float64.
+// Implements the logic to upper case every letter of a single randomly chosen
+// word among the words with a lower case letter, returning the entropy in bits
+// added by the choice.  Words without any lower case letters, such as emoji,
+// are unchanged by capitalization, so they are never chosen.
+func capitalizeRandomWord(words []string, rs RandomSource) (float64, error) {
+	cased := []int{}
+	for i, word := range words {
+		if strings.IndexFunc(word, unicode.IsLower) >= 0 {
+			cased = append(cased, i)
+		}
+	}
+
+	if len(cased) == 0 {
+		return 0, nil
+	}
+
+	chosen, err := randomInt(rs, len(cased))
+	if err != nil {
+		return 0, err
+	}
+
+	words[cased[chosen]] = strings.ToUpper(words[cased[chosen]])
+
+	return math.Log2(float64(len(cased))), nil
+}
+
+// capitalizeRandomLetters returns a float64.
+// Implements the logic to upper case a single randomly chosen letter within
+// every word, returning the entropy in bits added by the choices.
+func capitalizeRandomLetters(words []string, rs RandomSource) (float64, error) {
+	bits := 0.0
+	for i, word := range words {
+		letters := 0
+		for _, r := range word {
+			if unicode.IsLower(r) {
+				letters++
+			}
+		}
+
+		if letters == 0 {
+			continue
+		}
+
+		chosen, err := randomInt(rs, letters)
+		if err != nil {
+			return 0, err
+		}
+
+		words[i] = upperLetter(word, chosen)
+		bits += math.Log2(float64(letters))
+	}
+
+	return bits, nil
+}
+
+// titleWord returns a string.
+// Implements the logic to upper case the first character of the word.
+func titleWord(word string) string {
+	if word == "" {
+		return word
+	}
+
+	r, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+// upperLetter returns a string.
+// Implements the logic to upper case the lower case letter of the word found
+// at the given index among only the lower case letters.
+func upperLetter(word string, index int) string {
+	runes := []rune(word)
+	letter := 0
+	for i, r := range runes {
+		if !unicode.IsLower(r) {
+			continue
+		}
+
+		if letter == index {
+			runes[i] = unicode.ToUpper(r)
+			break
+		}
+
+		letter++
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,105 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapitalization(t *testing.T) {
+	assert := assert.New(t)
+
+	// each roll of the 1 die wordlist picks the word at the value + 1, which is
+	// then followed by the random capitalization choices.
+	words := wordlist.NewMap(1, 3, map[int]string{
+		1: "correct",
+		2: "horse",
+		3: "battery",
+	})
+
+	tests := []struct {
+		Name           string
+		Capitalization diceware.Capitalization
+		Values         []int64
+		Value          string
+		Bits           float64
+	}{
+		{
+			Name:           "will leave the words as they are",
+			Capitalization: diceware.CapitalizationNone,
+			Values:         []int64{0, 1, 2},
+			Value:          "correct horse battery",
+		}, {
+			Name:           "will title case every word",
+			Capitalization: diceware.CapitalizationTitleCase,
+			Values:         []int64{0, 1, 2},
+			Value:          "Correct Horse Battery",
+		}, {
+			Name:           "will upper case every word",
+			Capitalization: diceware.CapitalizationAllUpper,
+			Values:         []int64{0, 1, 2},
+			Value:          "CORRECT HORSE BATTERY",
+		}, {
+			Name:           "will upper case a random word",
+			Capitalization: diceware.CapitalizationRandomWord,
+			Values:         []int64{0, 1, 2, 1},
+			Value:          "correct HORSE battery",
+			Bits:           1.5849,
+		}, {
+			Name:           "will upper case a random letter within every word",
+			Capitalization: diceware.CapitalizationRandomLetter,
+			Values:         []int64{0, 1, 2, 0, 4, 6},
+			Value:          "Correct horsE batterY",
+			Bits:           7.9366,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:      3,
+			Separator:      " ",
+			Wordlist:       words,
+			Capitalization: test.Capitalization,
+			RandomSource:   &sequenceRandomSource{values: test.Values},
+		})
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Value, passphrase.String(), test.Name)
+			assert.InDelta(3*diceware.WordEntropy(words)+test.Bits, passphrase.EntropyBits, 0.0001, test.Name)
+		}
+	}
+
+	// words without lower case letters are never chosen, as capitalizing them
+	// adds no entropy
+	mixed := wordlist.NewMap(1, 3, map[int]string{1: "🐂", 2: "horse", 3: "🍋"})
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      3,
+		Separator:      " ",
+		Wordlist:       mixed,
+		Capitalization: diceware.CapitalizationRandomWord,
+		RandomSource:   &sequenceRandomSource{values: []int64{0, 1, 2, 0}},
+	})
+	if assert.NoError(err) {
+		assert.Equal("🐂 HORSE 🍋", passphrase.String())
+		assert.InDelta(3*diceware.WordEntropy(mixed), passphrase.EntropyBits, 0.0001)
+	}
+
+	emoji := wordlist.NewMap(1, 3, map[int]string{1: "🐂", 2: "🍋", 3: "🦊"})
+	passphrase, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      4,
+		Separator:      " ",
+		Wordlist:       emoji,
+		Capitalization: diceware.CapitalizationRandomWord,
+	})
+	if assert.NoError(err) {
+		assert.InDelta(4*diceware.WordEntropy(emoji), passphrase.EntropyBits, 0.0001)
+	}
+
+	_, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      3,
+		Wordlist:       words,
+		Capitalization: diceware.Capitalization(-1),
+	})
+	assert.ErrorIs(err, diceware.ErrInvalidCapitalization)
+}
@@ -0,0 +1,132 @@
+package diceware
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// checksumSeparator represents the separator the words are joined with before
+// being hashed, keeping the checksum independent of the passphrase separator.
+const checksumSeparator = " "
+
+// ErrInvalidChecksum represents the error given when a checksum word is
+// requested alongside options that `ValidateChecksum` is unable to check
+var ErrInvalidChecksum = errors.New("invalid checksum options given")
+
+// validateChecksum returns an error.
+// Implements the logic to verify that a passphrase with a checksum word is
+// able to be checked by `ValidateChecksum`, which requires the words to be
+// joined by a single non-empty separator and left as they were rolled, other
+// than their capitalization.
+func (opts PassphraseOptions) validateChecksum() error {
+	if !opts.Checksum {
+		return nil
+	}
+
+	if opts.separator() == "" || opts.RandomSeparators {
+		return fmt.Errorf("%w: the words must be joined by a single non-empty separator", ErrInvalidChecksum)
+	}
+
+	if opts.Leetspeak || opts.IncludeDigits || opts.EnhanceEntropy || opts.RequireClasses&^ClassUpper != 0 {
+		return fmt.Errorf("%w: the words must not be modified beyond their capitalization", ErrInvalidChecksum)
+	}
+
+	return nil
+}
+
+// checksumRolls returns a slice of ints.
+// Implements the logic to collect the roll values of the wordlist that fetch a
+// word satisfying each of the word constraints, in ascending order, which the
+// checksum word is chosen from, so that it never breaks the constraints.
+func checksumRolls(wl Wordlist, c *wordConstraints) []int {
+	rollValues := []int{}
+	forEachRoll(wl, func(rollValue int) bool {
+		if word := wl.FetchWord(rollValue); word != "" && c.accepts(word) {
+			rollValues = append(rollValues, rollValue)
+		}
+
+		return true
+	})
+
+	return rollValues
+}
+
+// checksumWord returns a string and an int.
+// Implements the logic to derive the checksum word of the given words, which
+// is the word of the wordlist at the SHA-256 digest of the words modulo the
+// number of words within the wordlist, alongside its roll value.  The roll
+// values are those given by `checksumRolls`, which are collected once for
+// every passphrase of a Generator.
+func checksumWord(wl Wordlist, rollValues []int, words []string) (string, int) {
+	if len(rollValues) == 0 {
+		return "", 0
+	}
+
+	digest := sha256.Sum256([]byte(strings.Join(words, checksumSeparator)))
+	index := new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), big.NewInt(int64(len(rollValues)))).Int64()
+
+	return wl.FetchWord(rollValues[index]), rollValues[index]
+}
+
+// ValidateChecksum returns a bool.
+// Implements the logic required to check whether the final word of the
+// passphrase is the checksum word of the preceding words, catching words that
+// were mistyped or swapped while being transcribed.  Capitalization is
+// ignored, however any other modification of the words fails the check.
+// Passphrases generated with word constraints, such as ExcludeWords or
+// MaxWordLength, must be checked with `ValidateChecksumWithOptions` instead.
+func ValidateChecksum(passphrase, separator string, wl Wordlist) (bool, error) {
+	return checkChecksum(passphrase, separator, wl, nil)
+}
+
+// ValidateChecksumWithOptions returns a bool.
+// Implements the logic required to check the checksum word of a passphrase as
+// `ValidateChecksum` does, using the Wordlist, separator and word constraints
+// of the options the passphrase was generated with.  Words that break the
+// word constraints fail the check.
+func ValidateChecksumWithOptions(passphrase string, opts PassphraseOptions) (bool, error) {
+	return checkChecksum(passphrase, opts.separator(), opts.Wordlist, opts.wordConstraints())
+}
+
+// checkChecksum returns a bool.
+// Implements the logic to check the checksum word of the passphrase, choosing
+// it from the words of the wordlist satisfying the given word constraints.
+func checkChecksum(passphrase, separator string, wl Wordlist, c *wordConstraints) (bool, error) {
+	if wl == nil {
+		return false, ErrInvalidWordlist
+	}
+
+	if separator == "" {
+		return false, ErrInvalidSeparator
+	}
+
+	canonical := map[string]string{}
+	for word := range wordlist.Index(wl).All() {
+		if c.accepts(word) {
+			canonical[strings.ToLower(word)] = word
+		}
+	}
+
+	words := strings.Split(passphrase, separator)
+	if len(words) < 2 {
+		return false, nil
+	}
+
+	for i, word := range words {
+		found, ok := canonical[strings.ToLower(word)]
+		if !ok {
+			return false, nil
+		}
+
+		words[i] = found
+	}
+
+	checksum, _ := checksumWord(wl, checksumRolls(wl, c), words[:len(words)-1])
+
+	return checksum == words[len(words)-1], nil
+}
@@ -0,0 +1,216 @@
+package diceware_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount: 5,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+		Checksum:  true,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Len(passphrase.Words, 6)
+	assert.Len(passphrase.Rolls, 6)
+	assert.Equal(passphrase.Words[5], wordlist.EFFLong.FetchWord(passphrase.Rolls[5]))
+	assert.InDelta(5*diceware.WordEntropy(wordlist.EFFLong), passphrase.EntropyBits, 0.0001)
+
+	valid, err := diceware.ValidateChecksum(passphrase.String(), " ", wordlist.EFFLong)
+	assert.NoError(err)
+	assert.True(valid)
+
+	_, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+		Pattern:   "W-W",
+		Checksum:  true,
+	})
+	assert.ErrorIs(err, diceware.ErrInvalidPattern)
+
+	// every passphrase of a Generator is able to be validated
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount:      4,
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		Capitalization: diceware.CapitalizationTitleCase,
+		RequireClasses: diceware.ClassUpper,
+		Checksum:       true,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	for i := 0; i < 10; i++ {
+		passphrase, err := generator.GeneratePassphrase()
+		if !assert.NoError(err) {
+			return
+		}
+
+		valid, err := diceware.ValidateChecksum(passphrase.String(), " ", wordlist.EFFLong)
+		assert.NoError(err)
+		assert.True(valid, passphrase.String())
+	}
+}
+
+func TestChecksumWordConstraints(t *testing.T) {
+	assert := assert.New(t)
+
+	opts := diceware.PassphraseOptions{
+		WordCount:     2,
+		Separator:     " ",
+		Wordlist:      wordlist.EFFLong,
+		Checksum:      true,
+		ExcludeWords:  []string{"zoom", "yoyo"},
+		ExcludeFunc:   func(word string) bool { return strings.HasPrefix(word, "a") },
+		MaxWordLength: 4,
+	}
+
+	generator, err := diceware.NewGenerator(opts)
+	if !assert.NoError(err) {
+		return
+	}
+
+	for i := 0; i < 100; i++ {
+		passphrase, err := generator.GeneratePassphrase()
+		if !assert.NoError(err) {
+			return
+		}
+
+		// the checksum word must satisfy the constraints as the rolled words do
+		for _, word := range passphrase.Words {
+			assert.LessOrEqual(len(word), 4, word)
+			assert.False(strings.HasPrefix(word, "a"), word)
+			assert.NotContains(opts.ExcludeWords, word)
+		}
+
+		valid, err := diceware.ValidateChecksumWithOptions(passphrase.String(), opts)
+		assert.NoError(err)
+		assert.True(valid, passphrase.String())
+	}
+
+	// a word breaking the constraints fails the check
+	valid, err := diceware.ValidateChecksumWithOptions("acid zoom zoom", opts)
+	assert.NoError(err)
+	assert.False(valid)
+}
+
+func TestChecksumOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+	}{
+		{
+			Name:    "will reject an empty separator",
+			Options: diceware.PassphraseOptions{},
+		}, {
+			Name:    "will reject a join style without a separator",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinCamelCase},
+		}, {
+			Name:    "will reject random separators",
+			Options: diceware.PassphraseOptions{Separator: " ", RandomSeparators: true},
+		}, {
+			Name:    "will reject leetspeak",
+			Options: diceware.PassphraseOptions{Separator: " ", Leetspeak: true},
+		}, {
+			Name:    "will reject an included digit",
+			Options: diceware.PassphraseOptions{Separator: " ", IncludeDigits: true},
+		}, {
+			Name:    "will reject enhanced entropy",
+			Options: diceware.PassphraseOptions{Separator: " ", EnhanceEntropy: true},
+		}, {
+			Name:    "will reject a required symbol",
+			Options: diceware.PassphraseOptions{Separator: " ", RequireClasses: diceware.ClassUpper | diceware.ClassSymbol},
+		},
+	}
+
+	for _, test := range tests {
+		test.Options.WordCount = 4
+		test.Options.Wordlist = wordlist.EFFLong
+		test.Options.Checksum = true
+
+		_, err := diceware.GeneratePassphrase(test.Options)
+		assert.ErrorIs(err, diceware.ErrInvalidChecksum, test.Name)
+	}
+}
+
+func TestValidateChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 4, map[int]string{
+		1: "acid",
+		2: "cat",
+		3: "horse",
+		4: "zoom",
+	})
+
+	// SHA-256("acid horse") modulo 4 selects the third word
+	checksum := "horse"
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Separator  string
+		Wordlist   diceware.Wordlist
+		Value      bool
+		Error      error
+	}{
+		{
+			Name:       "will error with a nil wordlist",
+			Passphrase: "acid horse " + checksum,
+			Separator:  " ",
+			Error:      diceware.ErrInvalidWordlist,
+		}, {
+			Name:       "will error with an empty separator",
+			Passphrase: "acid horse " + checksum,
+			Wordlist:   words,
+			Error:      diceware.ErrInvalidSeparator,
+		}, {
+			Name:       "will validate the checksum word",
+			Passphrase: "acid horse " + checksum,
+			Separator:  " ",
+			Wordlist:   words,
+			Value:      true,
+		}, {
+			Name:       "will validate the checksum word ignoring capitalization",
+			Passphrase: "Acid HORSE " + strings.ToUpper(checksum),
+			Separator:  " ",
+			Wordlist:   words,
+			Value:      true,
+		}, {
+			Name:       "will not validate a wrong checksum word",
+			Passphrase: "acid horse cat",
+			Separator:  " ",
+			Wordlist:   words,
+		}, {
+			Name:       "will not validate a word missing from the wordlist",
+			Passphrase: "acid horsr " + checksum,
+			Separator:  " ",
+			Wordlist:   words,
+		}, {
+			Name:       "will not validate a single word",
+			Passphrase: "acid",
+			Separator:  " ",
+			Wordlist:   words,
+		},
+	}
+
+	for _, test := range tests {
+		value, err := diceware.ValidateChecksum(test.Passphrase, test.Separator, test.Wordlist)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, value, test.Name)
+	}
+}
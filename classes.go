@@ -0,0 +1,191 @@
+package diceware
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidCharacterClass represents the error given when a passphrase is
+// requested with an unknown required character class, or a required symbol
+// while every symbol collides with the separators
+var ErrInvalidCharacterClass = errors.New("invalid required character class given")
+
+// CharacterClass defines the classes of characters a passphrase can be
+// required to contain, which are combined with a bitwise or.
+type CharacterClass int
+
+const (
+	// ClassUpper requires at least 1 upper case letter.
+	ClassUpper CharacterClass = 1 << iota
+	// ClassDigit requires at least 1 digit.
+	ClassDigit
+	// ClassSymbol requires at least 1 character that is neither a letter, digit
+	// or whitespace.
+	ClassSymbol
+)
+
+// upperLetters represents the letters inserted for ClassUpper when none of the
+// words contain a lower case letter to upper case.
+var upperLetters = strings.Split("ABCDEFGHIJKLMNOPQRSTUVWXYZ", "")
+
+// classesOf returns a CharacterClass.
+// Implements the logic to collect each of the classes of the characters found
+// within the text.
+func classesOf(text string) CharacterClass {
+	var classes CharacterClass
+	for _, r := range text {
+		switch {
+		case unicode.IsUpper(r):
+			classes |= ClassUpper
+		case unicode.IsDigit(r):
+			classes |= ClassDigit
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			classes |= ClassSymbol
+		}
+	}
+
+	return classes
+}
+
+// validateRequiredClasses returns an error.
+// Implements the logic to verify that each of the required classes is known,
+// and that a digit or symbol is able to be inserted when one is required.
+func (opts PassphraseOptions) validateRequiredClasses() error {
+	if opts.RequireClasses&^(ClassUpper|ClassDigit|ClassSymbol) != 0 {
+		return ErrInvalidCharacterClass
+	}
+
+	if opts.RequireClasses&ClassSymbol != 0 && len(opts.requiredSymbols()) == 0 {
+		return ErrInvalidCharacterClass
+	}
+
+	if opts.RequireClasses&ClassDigit != 0 && digitEntropy(opts.reservedSeparators()) == 0 {
+		return ErrInvalidCharacterClass
+	}
+
+	return nil
+}
+
+// requiredSymbols returns a slice of strings.
+// Implements the logic to select the symbols inserted for ClassSymbol, which
+// are the symbols of the EnhancementCharset when given, leaving out any that
+// collide with the separators.
+func (opts PassphraseOptions) requiredSymbols() []string {
+	reserved := opts.reservedSeparators()
+
+	symbols := []string{}
+	for _, symbol := range opts.patternSymbols() {
+		if classesOf(symbol)&ClassSymbol != 0 && !strings.Contains(reserved, symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	return symbols
+}
+
+// requireClasses returns a float64.
+// Implements the logic to guarantee that the passphrase contains each of the
+// required classes, upper casing a randomly chosen letter or inserting a
+// randomly chosen character into a randomly chosen word for each class that
+// is missing.  The entropy in bits added by the choices is returned.
+func requireClasses(
+	ctx context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	present := classesOf(passphrase.String())
+
+	bits := 0.0
+	for _, class := range []CharacterClass{ClassUpper, ClassDigit, ClassSymbol} {
+		if opts.RequireClasses&class == 0 || present&class != 0 {
+			continue
+		}
+
+		var (
+			added float64
+			err   error
+		)
+
+		switch class {
+		case ClassUpper:
+			added, err = capitalizeAnyLetter(rs, passphrase.Words, passphrase.Transcript)
+		case ClassDigit:
+			added, err = insertDigit(ctx, rs, passphrase.Words, opts.reservedSeparators(), passphrase.Transcript)
+		case ClassSymbol:
+			added, err = insertChoice(rs, passphrase.Words, opts.requiredSymbols(), passphrase.Transcript)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		bits += added
+	}
+
+	return bits, nil
+}
+
+// capitalizeAnyLetter returns a float64.
+// Implements the logic to upper case a single letter chosen at random from
+// every lower case letter of the words, inserting an upper case letter when
+// there are none.  The entropy in bits added by the choice is returned.
+func capitalizeAnyLetter(rs RandomSource, words []string, transcript *Transcript) (float64, error) {
+	letters := make([]int, len(words))
+	total := 0
+	for i, word := range words {
+		for _, r := range word {
+			if unicode.IsLower(r) {
+				letters[i]++
+			}
+		}
+
+		total += letters[i]
+	}
+
+	if total == 0 {
+		return insertChoice(rs, words, upperLetters, transcript)
+	}
+
+	chosen, err := randomInt(rs, total)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range words {
+		if chosen < letters[i] {
+			words[i] = upperLetter(words[i], chosen)
+			break
+		}
+
+		chosen -= letters[i]
+	}
+
+	return math.Log2(float64(total)), nil
+}
+
+// insertChoice returns a float64.
+// Implements the logic to insert a character chosen at random from the
+// choices into a randomly chosen word, returning the entropy in bits added by
+// the character.  The insertion is recorded within the transcript.
+func insertChoice(rs RandomSource, words, choices []string, transcript *Transcript) (float64, error) {
+	choice, err := randomInt(rs, len(choices))
+	if err != nil {
+		return 0, err
+	}
+
+	index, err := randomInt(rs, len(words))
+	if err != nil {
+		return 0, err
+	}
+
+	var offset int
+	words[index], offset, err = insertCharacter(words[index], choices[choice], rs)
+	if err != nil {
+		return 0, err
+	}
+
+	transcript.recordInsertion(index, offset, choices[choice])
+
+	return math.Log2(float64(len(choices))), nil
+}
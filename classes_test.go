@@ -0,0 +1,110 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireClasses(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 4, map[int]string{
+		1: "correct",
+		2: "horse",
+		3: "battery",
+		4: "staple",
+	})
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Pattern string
+		MinBits float64
+		Error   error
+	}{
+		{
+			Name:    "will add an upper case letter",
+			Options: diceware.PassphraseOptions{RequireClasses: diceware.ClassUpper},
+			Pattern: `[A-Z]`,
+			MinBits: 8 + 4,
+		}, {
+			Name:    "will add a digit",
+			Options: diceware.PassphraseOptions{RequireClasses: diceware.ClassDigit},
+			Pattern: `[0-9]`,
+			MinBits: 8 + 3.3219,
+		}, {
+			Name: "will add a symbol that does not collide with the separator",
+			Options: diceware.PassphraseOptions{
+				RequireClasses:     diceware.ClassSymbol,
+				EnhancementCharset: []string{"!", "+"},
+			},
+			Pattern: `[!+]`,
+			MinBits: 8 + 1,
+		}, {
+			Name: "will add every class",
+			Options: diceware.PassphraseOptions{
+				RequireClasses: diceware.ClassUpper | diceware.ClassDigit | diceware.ClassSymbol,
+			},
+			Pattern: `^(.*[A-Z].*[0-9]|.*[0-9].*[A-Z])`,
+			MinBits: 8 + 4 + 3.3219,
+		}, {
+			Name: "will leave a class already found within the passphrase",
+			Options: diceware.PassphraseOptions{
+				RequireClasses: diceware.ClassUpper,
+				Capitalization: diceware.CapitalizationTitleCase,
+			},
+			Pattern: `^([A-Z][a-z]+ ){3}[A-Z][a-z]+$`,
+			MinBits: 8,
+		}, {
+			Name: "will count a symbol within the separators",
+			Options: diceware.PassphraseOptions{
+				RequireClasses: diceware.ClassSymbol,
+				Pattern:        "W!W",
+			},
+			Pattern: `^[a-z]+![a-z]+$`,
+			MinBits: 4,
+		}, {
+			Name:    "will error with an unknown class",
+			Options: diceware.PassphraseOptions{RequireClasses: diceware.CharacterClass(8)},
+			Error:   diceware.ErrInvalidCharacterClass,
+		}, {
+			Name: "will error when none of the characters are symbols",
+			Options: diceware.PassphraseOptions{
+				RequireClasses:     diceware.ClassSymbol,
+				EnhancementCharset: []string{" ", "a"},
+			},
+			Error: diceware.ErrInvalidCharacterClass,
+		}, {
+			Name: "will error when every digit is found within the separators",
+			Options: diceware.PassphraseOptions{
+				RequireClasses:   diceware.ClassDigit,
+				RandomSeparators: true,
+			},
+			Error: diceware.ErrInvalidCharacterClass,
+		},
+	}
+
+	for _, test := range tests {
+		test.Options.WordCount = 4
+		test.Options.Separator = " "
+		test.Options.Wordlist = words
+
+		for i := 0; i < 20; i++ {
+			passphrase, err := diceware.GeneratePassphrase(test.Options)
+			if test.Error != nil {
+				assert.ErrorIs(err, test.Error, test.Name)
+				break
+			}
+
+			if !assert.NoError(err, test.Name) {
+				break
+			}
+
+			assert.Regexp(test.Pattern, passphrase.String(), test.Name)
+			assert.GreaterOrEqual(passphrase.EntropyBits, test.MinBits-0.0001, test.Name)
+		}
+	}
+}
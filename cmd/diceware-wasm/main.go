@@ -0,0 +1,24 @@
+//go:build js && wasm
+
+// Command diceware-wasm publishes the functions of the dicewarejs package as
+// the global diceware object of a JavaScript runtime, e.g.
+//
+//	GOOS=js GOARCH=wasm go build -o diceware.wasm ./cmd/diceware-wasm
+//
+// The module is loaded with the wasm_exec.js support file of the Go release
+// it was built with, after which diceware.rollWords(6, " ") returns a
+// passphrase.
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/everlastingbeta/diceware/dicewarejs"
+)
+
+func main() {
+	js.Global().Set("diceware", dicewarejs.NewObject())
+
+	// the functions are only able to be called while the program is running
+	select {}
+}
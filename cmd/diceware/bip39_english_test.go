@@ -0,0 +1,33 @@
+//go:build !diceware_minimal && !diceware_no_bip39_english
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRollRejected(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"roll", "-manual", "-words", "1", "-list", "bip39-english"},
+		strings.NewReader("8 8 8 8\n1 1 1 1\n"), &stdout, &stderr)
+
+	assert.Equal(0, code)
+	assert.Contains(stderr.String(), "roll them again")
+	assert.Equal(wordlist.BIP39English.FetchWord(1111)+"\n", stdout.String())
+}
+
+func TestRunCheckBIP39English(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"check"}, strings.NewReader("abandon.ability.able\n"), &stdout, &stderr))
+	assert.Contains(stdout.String(), "wordlist:\tbip39-english (3 of 3 words)\n")
+	assert.Contains(stdout.String(), "entropy:\t33.0 bits")
+}
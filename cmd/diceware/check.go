@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// errEmptyPassphrase represents the error given when the check subcommand is
+// given no passphrase.
+var errEmptyPassphrase = errors.New("no passphrase given to check")
+
+// checkSeparators represents the separators tried when the separator of the
+// checked passphrase is not given, keeping the one matching the most words.
+var checkSeparators = []string{" ", "-", ".", "_", ",", "+"}
+
+// checkResult defines the evaluation of an existing passphrase.
+type checkResult struct {
+	// words represents the words of the passphrase.
+	words []string
+
+	// separator represents the separator the words were split by.
+	separator string
+
+	// wordlist represents the name of the wordlist most of the words are
+	// found within, which is empty when none of them are.
+	wordlist string
+
+	// found represents the number of words found within the wordlist.
+	found int
+
+	// entropyBits represents the entropy in bits of the words found within the
+	// wordlist, assuming each of them was rolled with diceware.
+	entropyBits float64
+}
+
+// runCheck returns an int.
+// Implements the logic of the check subcommand, evaluating the passphrase
+// given as the arguments, or as the first line of stdin so that it is kept
+// out of the shell history, and writing the report to stdout, returning the
+// exit code of the command.  The passphrase is compared against every
+// built-in wordlist unless -list or -list-file is given.
+func runCheck(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diceware check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	separator := flags.String("sep", "", "separator placed between the words, detected when not given")
+	list, listFile := wordlistFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	passphrase := strings.Join(flags.Args(), " ")
+	if flags.NArg() == 0 && stdin != nil {
+		line, err := bufio.NewReader(stdin).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitFailure
+		}
+
+		passphrase = strings.TrimRight(line, "\r\n")
+	}
+
+	if strings.TrimSpace(passphrase) == "" {
+		fmt.Fprintf(stderr, "diceware: %v\n", errEmptyPassphrase)
+		return exitUsage
+	}
+
+	candidates := []diceware.Wordlist{}
+	if *list != "" || *listFile != "" {
+		wl, err := selectWordlist(*list, *listFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitUsage
+		}
+
+		candidates = append(candidates, wl)
+	} else {
+		for _, name := range wordlist.Names() {
+			wl, err := wordlist.Get(name)
+			if err != nil {
+				fmt.Fprintf(stderr, "diceware: %v\n", err)
+				return exitFailure
+			}
+
+			candidates = append(candidates, wl)
+		}
+	}
+
+	result := checkPassphrase(passphrase, *separator, candidates)
+	if err := writeCheckResult(stdout, stderr, result); err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	return 0
+}
+
+// checkPassphrase returns a checkResult.
+// Implements the logic to split the passphrase into its words, detecting the
+// separator when none is given, and to find the wordlist containing the most
+// of them, ignoring their case.  Ties are given to the wordlist providing the
+// least entropy for each word, so that the estimate is never overstated.
+func checkPassphrase(passphrase, separator string, candidates []diceware.Wordlist) checkResult {
+	separators := checkSeparators
+	if separator != "" {
+		separators = []string{separator}
+	}
+
+	lowered := make([]map[string]bool, len(candidates))
+	for i, wl := range candidates {
+		lowered[i] = map[string]bool{}
+		for word := range wordlist.Index(wl).All() {
+			lowered[i][strings.ToLower(word)] = true
+		}
+	}
+
+	var best checkResult
+	bestPerWord := 0.0
+	for _, sep := range separators {
+		words := strings.Split(strings.TrimSpace(passphrase), sep)
+		if sep == " " {
+			words = strings.Fields(passphrase)
+		}
+
+		for i, wl := range candidates {
+			found := 0
+			for _, word := range words {
+				if lowered[i][strings.ToLower(word)] {
+					found++
+				}
+			}
+
+			perWord := diceware.WordEntropy(wl)
+			if found < best.found || (found == best.found && (found == 0 || perWord >= bestPerWord)) {
+				continue
+			}
+
+			name := ""
+			if named, ok := wl.(diceware.NamedWordlist); ok {
+				name = named.Name()
+			}
+
+			best = checkResult{
+				words:       words,
+				separator:   sep,
+				wordlist:    name,
+				found:       found,
+				entropyBits: float64(found) * perWord,
+			}
+			bestPerWord = perWord
+		}
+	}
+
+	if best.words == nil {
+		best = checkResult{words: strings.Fields(passphrase), separator: " "}
+	}
+
+	return best
+}
+
+// writeCheckResult returns an error.
+// Implements the logic to write the evaluation of a passphrase to w, and a
+// warning to stderr when any of its words are not found within the wordlist.
+func writeCheckResult(w, stderr io.Writer, result checkResult) error {
+	if result.found == 0 {
+		fmt.Fprintln(stderr, "warning: none of the words are from a known wordlist, "+
+			"the passphrase was likely not generated with diceware and its entropy is unknown")
+		_, err := fmt.Fprintf(w, "words:\t\t%d\n", len(result.words))
+		return err
+	}
+
+	if missing := len(result.words) - result.found; missing > 0 {
+		fmt.Fprintf(stderr, "warning: %d of the %d words are not from the %s wordlist, "+
+			"they are left out of the entropy\n", missing, len(result.words), result.wordlist)
+	}
+
+	crackTime := diceware.EstimateStrength(result.entropyBits, crackRate).CrackTimes[0]
+	_, err := fmt.Fprintf(w, "words:\t\t%d\nseparator:\t%q\nwordlist:\t%s (%d of %d words)\n"+
+		"entropy:\t%.1f bits, assuming the words were rolled with diceware\ncrack time:\t%s %s\n",
+		len(result.words), result.separator, result.wordlist, result.found, len(result.words),
+		result.entropyBits, crackTime, crackRate.Name)
+
+	return err
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name   string
+		Args   []string
+		Input  string
+		Stdout []string
+		Code   int
+		Stderr string
+	}{
+		{
+			Name:   "will detect the wordlist of the passphrase",
+			Args:   []string{"check", "abacus abdomen abdominal abide abiding ability"},
+			Stdout: []string{"words:\t\t6\n", "wordlist:\teff-long (6 of 6 words)\n", "entropy:\t77.5 bits"},
+		}, {
+			Name:   "will detect the separator and ignore the case of the words",
+			Args:   []string{"check", "Abacus-Yodel-Zoom"},
+			Stdout: []string{"separator:\t\"-\"\n", "wordlist:\teff-long (3 of 3 words)\n", "entropy:\t38.8 bits"},
+		}, {
+			Name:   "will read the passphrase from stdin",
+			Args:   []string{"check"},
+			Input:  "abacus.abide.zoom\n",
+			Stdout: []string{"separator:\t\".\"\n", "wordlist:\teff-long (3 of 3 words)\n"},
+		}, {
+			Name:   "will warn about words that are not from the wordlist",
+			Args:   []string{"check", "-list", "eff-long", "abacus", "xyzzy", "abide"},
+			Stdout: []string{"wordlist:\teff-long (2 of 3 words)\n", "entropy:\t25.8 bits"},
+			Stderr: "warning: 1 of the 3 words are not from the eff-long wordlist",
+		}, {
+			Name:   "will warn when none of the words are from a known wordlist",
+			Args:   []string{"check", "Tr0ub4dor&3"},
+			Stdout: []string{"words:\t\t1\n"},
+			Stderr: "warning: none of the words are from a known wordlist",
+		}, {
+			Name:   "will exit with a usage error without a passphrase",
+			Args:   []string{"check"},
+			Code:   exitUsage,
+			Stderr: errEmptyPassphrase.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, strings.NewReader(test.Input), &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+		for _, line := range test.Stdout {
+			assert.Contains(stdout.String(), line, test.Name)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var (
+	// errNoClipboard represents the error given when none of the clipboard
+	// commands of the operating system are installed.
+	errNoClipboard = errors.New("no clipboard command found")
+	// errCopyConflict represents the error given when -copy is combined with
+	// flags that write more than a single passphrase.
+	errCopyConflict = errors.New("-copy is unable to be combined with -json, -show-entropy, -phonetic or -n")
+	// errClearWithoutCopy represents the error given when -clear-after is
+	// given without -copy.
+	errClearWithoutCopy = errors.New("-clear-after requires -copy")
+)
+
+// clipboard defines the access to the system clipboard used by -copy.
+type clipboard interface {
+	// Copy replaces the contents of the clipboard with the given text.
+	Copy(text string) error
+
+	// Paste returns the current contents of the clipboard.
+	Paste() (string, error)
+}
+
+// clipboardCommand defines the external commands that copy to and paste from
+// the system clipboard.
+type clipboardCommand struct {
+	// copy represents the command reading the text to copy from stdin.
+	copy []string
+
+	// paste represents the command writing the contents of the clipboard to
+	// stdout.
+	paste []string
+}
+
+// clipboardCommands represents the clipboard commands of each operating
+// system, in the order they are tried.
+var clipboardCommands = map[string][]clipboardCommand{
+	"darwin": {
+		{copy: []string{"pbcopy"}, paste: []string{"pbpaste"}},
+	},
+	"windows": {
+		{copy: []string{"clip"}, paste: []string{"powershell", "-NoProfile", "-Command", "Get-Clipboard"}},
+	},
+	"linux": {
+		{copy: []string{"wl-copy"}, paste: []string{"wl-paste", "-n"}},
+		{copy: []string{"xclip", "-selection", "clipboard"}, paste: []string{"xclip", "-selection", "clipboard", "-o"}},
+		{copy: []string{"xsel", "--clipboard", "--input"}, paste: []string{"xsel", "--clipboard", "--output"}},
+	},
+}
+
+// systemClipboard represents the clipboard used by -copy, which is replaced
+// within the tests.
+var systemClipboard clipboard = commandClipboard{}
+
+// sleep represents the wait before -clear-after wipes the clipboard, which is
+// replaced within the tests.
+var sleep = time.Sleep
+
+// commandClipboard implements the clipboard interface by running the first
+// clipboard command of the operating system that is installed.
+type commandClipboard struct{}
+
+// Copy returns an error.
+// It implements the logic for the clipboard interface to pipe the text into
+// the copy command of the operating system.
+func (commandClipboard) Copy(text string) error {
+	command, err := findClipboardCommand()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(command.copy[0], command.copy[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+
+	return cmd.Run()
+}
+
+// Paste returns a string and an error.
+// It implements the logic for the clipboard interface to read the output of
+// the paste command of the operating system.
+func (commandClipboard) Paste() (string, error) {
+	command, err := findClipboardCommand()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := exec.Command(command.paste[0], command.paste[1:]...).Output()
+
+	return strings.TrimRight(string(output), "\r\n"), err
+}
+
+// findClipboardCommand returns a clipboardCommand.
+// Implements the logic to find the first clipboard command of the operating
+// system that is installed, naming every command tried when none is.
+func findClipboardCommand() (clipboardCommand, error) {
+	commands := clipboardCommands[runtime.GOOS]
+	names := make([]string, 0, len(commands))
+	for _, command := range commands {
+		if _, err := exec.LookPath(command.copy[0]); err == nil {
+			return command, nil
+		}
+
+		names = append(names, command.copy[0])
+	}
+
+	if len(names) == 0 {
+		return clipboardCommand{}, fmt.Errorf("%w for %s", errNoClipboard, runtime.GOOS)
+	}
+
+	return clipboardCommand{}, fmt.Errorf("%w, install one of: %s", errNoClipboard, strings.Join(names, ", "))
+}
+
+// clearClipboard returns an error.
+// Implements the logic to wait for the given duration before wiping the
+// passphrase from the clipboard.  The clipboard is left alone when something
+// else has been copied in the meantime.
+func clearClipboard(cb clipboard, passphrase string, after time.Duration) error {
+	sleep(after)
+
+	if current, err := cb.Paste(); err == nil && current != passphrase {
+		return nil
+	}
+
+	if err := cb.Copy(""); err != nil {
+		return fmt.Errorf("clearing the clipboard: %w", err)
+	}
+
+	return nil
+}
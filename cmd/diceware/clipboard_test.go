@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClipboard implements the clipboard interface in memory, replacing its
+// contents with replacement once the passphrase has been copied when set.
+type fakeClipboard struct {
+	contents    string
+	replacement string
+	err         error
+}
+
+func (cb *fakeClipboard) Copy(text string) error {
+	if cb.err != nil {
+		return cb.err
+	}
+
+	cb.contents = text
+
+	return nil
+}
+
+func (cb *fakeClipboard) Paste() (string, error) {
+	if cb.replacement != "" {
+		return cb.replacement, nil
+	}
+
+	return cb.contents, nil
+}
+
+func TestRunCopy(t *testing.T) {
+	assert := assert.New(t)
+
+	var slept time.Duration
+	sleep = func(d time.Duration) { slept = d }
+	t.Cleanup(func() {
+		systemClipboard = commandClipboard{}
+		sleep = time.Sleep
+	})
+
+	tests := []struct {
+		Name        string
+		Args        []string
+		Clipboard   *fakeClipboard
+		Cleared     bool
+		Slept       time.Duration
+		Code        int
+		Stderr      string
+		CopiedWords int
+	}{
+		{
+			Name:        "will copy the passphrase without printing it",
+			Args:        []string{"-copy", "-words", "4"},
+			Clipboard:   &fakeClipboard{},
+			Stderr:      "passphrase copied to the clipboard",
+			CopiedWords: 4,
+		}, {
+			Name:      "will wipe the clipboard after the duration",
+			Args:      []string{"-copy", "-clear-after", "30s"},
+			Clipboard: &fakeClipboard{},
+			Cleared:   true,
+			Slept:     30 * time.Second,
+			Stderr:    "clearing in 30s",
+		}, {
+			Name:        "will leave the clipboard alone once something else is copied",
+			Args:        []string{"-copy", "-clear-after", "1m", "-words", "3"},
+			Clipboard:   &fakeClipboard{replacement: "something else"},
+			Slept:       time.Minute,
+			CopiedWords: 3,
+		}, {
+			Name:      "will exit with a failure when the clipboard is unavailable",
+			Args:      []string{"-copy"},
+			Clipboard: &fakeClipboard{err: errNoClipboard},
+			Code:      exitFailure,
+			Stderr:    "no clipboard command found",
+		}, {
+			Name:      "will exit with a usage error for -clear-after without -copy",
+			Args:      []string{"-clear-after", "30s"},
+			Clipboard: &fakeClipboard{},
+			Code:      exitUsage,
+			Stderr:    errClearWithoutCopy.Error(),
+		}, {
+			Name:      "will exit with a usage error for -copy with -n",
+			Args:      []string{"-copy", "-n", "2"},
+			Clipboard: &fakeClipboard{},
+			Code:      exitUsage,
+			Stderr:    errCopyConflict.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		slept = 0
+		systemClipboard = test.Clipboard
+
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, nil, &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Empty(stdout.String(), test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+		assert.Equal(test.Slept, slept, test.Name)
+		if test.Cleared {
+			assert.Empty(test.Clipboard.contents, test.Name)
+		}
+
+		if test.CopiedWords > 0 {
+			words := bytes.Fields([]byte(test.Clipboard.contents))
+			assert.Len(words, test.CopiedWords, test.Name)
+			for _, word := range words {
+				_, found := wordlist.Index(wordlist.EFFLong).RollFor(string(word))
+				assert.True(found, test.Name)
+			}
+		}
+	}
+}
+
+func TestClearClipboard(t *testing.T) {
+	assert := assert.New(t)
+
+	sleep = func(time.Duration) {}
+	t.Cleanup(func() { sleep = time.Sleep })
+
+	cb := &fakeClipboard{contents: "correct horse"}
+	assert.NoError(clearClipboard(cb, "correct horse", time.Second))
+	assert.Empty(cb.contents)
+
+	failing := &fakeClipboard{contents: "correct horse", err: errors.New("boom")}
+	assert.ErrorContains(clearClipboard(failing, "correct horse", time.Second), "clearing the clipboard: boom")
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+var (
+	// errInvalidConfig represents the error given when the config file or the
+	// environment variables hold an invalid setting.
+	errInvalidConfig = errors.New("invalid config")
+	// errUnknownCapitalization represents the error given when a capitalization
+	// mode is not one of capitalizations.
+	errUnknownCapitalization = errors.New("unknown capitalization")
+)
+
+// capitalizations represents the name of each capitalization mode accepted by
+// -capitalize and the config.
+var capitalizations = map[string]diceware.Capitalization{
+	"none":          diceware.CapitalizationNone,
+	"title":         diceware.CapitalizationTitleCase,
+	"upper":         diceware.CapitalizationAllUpper,
+	"random-word":   diceware.CapitalizationRandomWord,
+	"random-letter": diceware.CapitalizationRandomLetter,
+}
+
+// configEnv represents the environment variable of each config setting, which
+// take priority over the config file.
+var configEnv = map[string]string{
+	"DICEWARE_WORDS":      "words",
+	"DICEWARE_SEPARATOR":  "separator",
+	"DICEWARE_LIST":       "list",
+	"DICEWARE_CAPITALIZE": "capitalize",
+}
+
+// config defines the preferences used as the defaults of the flags, read from
+// the config file and the environment variables.
+type config struct {
+	// words represents the number of words within the passphrase.
+	words int
+
+	// separator represents the separator placed between the words.
+	separator string
+
+	// list represents the name of the built-in wordlist.
+	list string
+
+	// capitalize represents the name of the capitalization mode.
+	capitalize string
+}
+
+// loadConfig returns a config.
+// Implements the logic to read the preferences of the user, starting from the
+// built-in defaults, replacing them with the settings of the config file and
+// then with the settings of the environment variables.  The config file is
+// found at $DICEWARE_CONFIG, or otherwise at diceware/config.toml within the
+// user config directory, e.g. ~/.config/diceware/config.toml, where it is
+// allowed to be missing.
+func loadConfig() (config, error) {
+	cfg := config{words: 6, separator: " ", capitalize: "none"}
+
+	path, required := os.LookupEnv("DICEWARE_CONFIG")
+	if !required {
+		dir, err := os.UserConfigDir()
+		if err == nil {
+			path = filepath.Join(dir, "diceware", "config.toml")
+		}
+	}
+
+	if path != "" {
+		file, err := os.Open(path)
+		switch {
+		case err == nil:
+			err = cfg.parse(file, path)
+			_ = file.Close()
+			if err != nil {
+				return cfg, err
+			}
+		case required || !errors.Is(err, os.ErrNotExist):
+			return cfg, err
+		}
+	}
+
+	names := make([]string, 0, len(configEnv))
+	for name := range configEnv {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if value, found := os.LookupEnv(name); found {
+			if err := cfg.set(configEnv[name], value); err != nil {
+				return cfg, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// parse returns an error.
+// Implements the logic to read the settings of a config file, which holds the
+// flat `key = value` pairs of TOML with `#` comments, where the values are
+// strings in double or single quotes, or integers, e.g.
+//
+//	words = 7
+//	separator = "-"
+//	list = "eff-short"
+//	capitalize = "title"
+func (cfg *config) parse(r io.Reader, name string) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		key, raw, found := strings.Cut(text, "=")
+		if !found {
+			return fmt.Errorf("%s:%d: %w: expected key = value", name, line, errInvalidConfig)
+		}
+
+		value, err := tomlValue(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+
+		if err := cfg.set(strings.TrimSpace(key), value); err != nil {
+			return fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// set returns an error.
+// Implements the logic to replace the setting of the given key with the value.
+func (cfg *config) set(key, value string) error {
+	switch key {
+	case "words":
+		words, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%w: words must be a number, got %q", errInvalidConfig, value)
+		}
+
+		cfg.words = words
+	case "separator":
+		cfg.separator = value
+	case "list":
+		cfg.list = value
+	case "capitalize":
+		if _, err := parseCapitalization(value); err != nil {
+			return err
+		}
+
+		cfg.capitalize = value
+	default:
+		return fmt.Errorf("%w: unknown key %q", errInvalidConfig, key)
+	}
+
+	return nil
+}
+
+// tomlValue returns a string.
+// Implements the logic to decode a TOML string in double or single quotes, or
+// an integer, leaving out any trailing comment.
+func tomlValue(raw string) (string, error) {
+	switch {
+	case strings.HasPrefix(raw, `"`):
+		quoted, err := strconv.QuotedPrefix(raw)
+		if err != nil {
+			return "", fmt.Errorf("%w: unterminated string %s", errInvalidConfig, raw)
+		}
+
+		if rest := strings.TrimSpace(raw[len(quoted):]); rest != "" && !strings.HasPrefix(rest, "#") {
+			return "", fmt.Errorf("%w: unexpected %q after the value", errInvalidConfig, rest)
+		}
+
+		return strconv.Unquote(quoted)
+	case strings.HasPrefix(raw, "'"):
+		end := strings.Index(raw[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("%w: unterminated string %s", errInvalidConfig, raw)
+		}
+
+		if rest := strings.TrimSpace(raw[end+2:]); rest != "" && !strings.HasPrefix(rest, "#") {
+			return "", fmt.Errorf("%w: unexpected %q after the value", errInvalidConfig, rest)
+		}
+
+		return raw[1 : end+1], nil
+	}
+
+	value, _, _ := strings.Cut(raw, "#")
+	value = strings.TrimSpace(value)
+	if _, err := strconv.Atoi(value); err != nil {
+		return "", fmt.Errorf("%w: %q is neither a quoted string nor a number", errInvalidConfig, value)
+	}
+
+	return value, nil
+}
+
+// parseCapitalization returns a diceware.Capitalization.
+// Implements the logic to look up the capitalization mode of the given name.
+func parseCapitalization(name string) (diceware.Capitalization, error) {
+	mode, found := capitalizations[name]
+	if !found {
+		return mode, fmt.Errorf("%w %q, choose one of: %s", errUnknownCapitalization, name, capitalizationNames())
+	}
+
+	return mode, nil
+}
+
+// capitalizationNames returns a string.
+// Implements the logic to list the name of every capitalization mode.
+func capitalizationNames() string {
+	names := make([]string, 0, len(capitalizations))
+	for name := range capitalizations {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
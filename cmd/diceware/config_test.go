@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMain points DICEWARE_CONFIG at an empty config file, so that the tests
+// never read the config of the user running them.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "diceware")
+	if err != nil {
+		panic(err)
+	}
+
+	empty := filepath.Join(dir, "config.toml")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		panic(err)
+	}
+
+	os.Setenv("DICEWARE_CONFIG", empty)
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name   string
+		File   string
+		Env    map[string]string
+		Config config
+		Error  string
+	}{
+		{
+			Name:   "will use the built-in defaults for an empty config",
+			Config: config{words: 6, separator: " ", capitalize: "none"},
+		}, {
+			Name: "will read the settings of the config file",
+			File: "# preferences\nwords = 7\nseparator = \"-\" # between words\n" +
+				"list = 'eff-short'\n\ncapitalize = \"title\"\n",
+			Config: config{words: 7, separator: "-", list: "eff-short", capitalize: "title"},
+		}, {
+			Name:   "will give the environment variables priority over the config file",
+			File:   "words = 7\nlist = \"eff-short\"\n",
+			Env:    map[string]string{"DICEWARE_WORDS": "9", "DICEWARE_SEPARATOR": "."},
+			Config: config{words: 9, separator: ".", list: "eff-short", capitalize: "none"},
+		}, {
+			Name:  "will report the line of an unknown key",
+			File:  "words = 7\ncolour = \"red\"\n",
+			Error: "config.toml:2: invalid config: unknown key \"colour\"",
+		}, {
+			Name:  "will report a value that is not quoted",
+			File:  "separator = -\n",
+			Error: "neither a quoted string nor a number",
+		}, {
+			Name:  "will report an unterminated string",
+			File:  "list = \"eff-short\n",
+			Error: "unterminated string",
+		}, {
+			Name:  "will report an unknown capitalization",
+			File:  "capitalize = \"shouting\"\n",
+			Error: "unknown capitalization \"shouting\", choose one of: none, random-letter, random-word, title, upper",
+		}, {
+			Name:  "will report an invalid environment variable",
+			Env:   map[string]string{"DICEWARE_WORDS": "many"},
+			Error: "DICEWARE_WORDS: invalid config: words must be a number",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.toml")
+			assert.NoError(os.WriteFile(path, []byte(test.File), 0o600))
+			t.Setenv("DICEWARE_CONFIG", path)
+			for name, value := range test.Env {
+				t.Setenv(name, value)
+			}
+
+			cfg, err := loadConfig()
+			if test.Error != "" {
+				assert.ErrorContains(err, test.Error, test.Name)
+				return
+			}
+
+			assert.NoError(err, test.Name)
+			assert.Equal(test.Config, cfg, test.Name)
+		})
+	}
+}
+
+func TestLoadConfigMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Setenv("DICEWARE_CONFIG", filepath.Join(t.TempDir(), "missing.toml"))
+	_, err := loadConfig()
+	assert.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestRunConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	settings := "words = 3\nseparator = \".\"\nlist = \"eff-long\"\ncapitalize = \"upper\"\n"
+	assert.NoError(os.WriteFile(path, []byte(settings), 0o600))
+	t.Setenv("DICEWARE_CONFIG", path)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run(nil, nil, &stdout, &stderr))
+
+	words := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), ".")
+	assert.Len(words, 3)
+	for _, word := range words {
+		_, found := wordlist.Index(wordlist.EFFLong).RollFor(strings.ToLower(word))
+		assert.True(found)
+		assert.Equal(strings.ToUpper(word), word)
+	}
+
+	stdout.Reset()
+	assert.Equal(0, run([]string{"-words", "5", "-sep", " ", "-capitalize", "none", "-list", "eff-long"},
+		nil, &stdout, &stderr))
+
+	words = strings.Fields(stdout.String())
+	assert.Len(words, 5)
+	for _, word := range words {
+		_, found := wordlist.Index(wordlist.EFFLong).RollFor(word)
+		assert.True(found)
+	}
+
+	custom := filepath.Join(t.TempDir(), "custom.txt")
+	assert.NoError(os.WriteFile(custom, []byte("1\tacid\n2\tcat\n"), 0o600))
+
+	stdout.Reset()
+	assert.Equal(0, run([]string{"-list-file", custom, "-capitalize", "none"}, nil, &stdout, &stderr), stderr.String())
+	assert.Regexp(`^(acid|cat)(\.(acid|cat)){2}\n$`, stdout.String())
+}
+
+func TestRunBrokenConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	assert.NoError(os.WriteFile(path, []byte("words = \"many\"\n"), 0o600))
+	t.Setenv("DICEWARE_CONFIG", path)
+
+	tests := []struct {
+		Name string
+		Args []string
+		Code int
+	}{
+		{
+			Name: "will report the config when generating a passphrase",
+			Args: nil,
+			Code: exitUsage,
+		}, {
+			Name: "will report the config when rolling a passphrase",
+			Args: []string{"roll"},
+			Code: exitUsage,
+		}, {
+			Name: "will check a passphrase without the config",
+			Args: []string{"check", "-list", "eff-long", "abacus abdomen abide"},
+		}, {
+			Name: "will print a wordlist without the config",
+			Args: []string{"wordlist", "print", "eff-long"},
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(test.Code, run(test.Args, strings.NewReader(""), &stdout, &stderr), test.Name)
+		if test.Code != 0 {
+			assert.Contains(stderr.String(), path, test.Name)
+		}
+	}
+}
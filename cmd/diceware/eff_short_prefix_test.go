@@ -0,0 +1,18 @@
+//go:build !diceware_minimal && !diceware_no_eff_short_prefix
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectWordlistEFFShortPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	wl, err := selectWordlist("eff-short-prefix", "")
+	if assert.NoError(err, "will select a built-in wordlist by name") {
+		assert.Equal("eff-short-prefix", wl.(interface{ Name() string }).Name())
+	}
+}
@@ -0,0 +1,111 @@
+//go:build !diceware_minimal && !diceware_no_eff_short
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Args  []string
+		Words int
+		Sep   string
+	}{
+		{
+			Name:  "will generate the requested words from the requested wordlist",
+			Args:  []string{"-words", "7", "-sep", ".", "-list", "eff-short"},
+			Words: 7,
+			Sep:   ".",
+		}, {
+			Name:  "will generate the words needed for the minimum entropy of the wordlist",
+			Args:  []string{"--min-entropy=90", "--list=eff-short"},
+			Words: 9,
+			Sep:   " ",
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(0, run(test.Args, nil, &stdout, &stderr), test.Name)
+
+		words := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), test.Sep)
+		assert.Len(words, test.Words, test.Name)
+		for _, word := range words {
+			_, found := wordlist.Index(wordlist.EFFShort).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
+
+func TestRunCheckEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"check", "Acid-Acorn-Acre"}, nil, &stdout, &stderr))
+	assert.Contains(stdout.String(), "separator:\t\"-\"\n")
+	assert.Contains(stdout.String(), "wordlist:\teff-short (3 of 3 words)\n")
+	assert.Contains(stdout.String(), "entropy:\t31.0 bits")
+}
+
+func TestRunJSONEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"-json", "-words", "4", "-list", "eff-short"}, nil, &stdout, &stderr))
+
+	var result jsonPassphrase
+	if assert.NoError(json.Unmarshal(stdout.Bytes(), &result)) {
+		assert.Equal("eff-short", result.Wordlist)
+		assert.InDelta(41.36, result.EntropyBits, 0.01)
+	}
+}
+
+func TestRunPrintEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"wordlist", "print", "eff-short", "-width", "100"}, nil, &stdout, &stderr))
+
+	pages := strings.Split(stdout.String(), "\f")
+	assert.Len(pages, 4)
+	assert.True(strings.HasPrefix(pages[0], "eff-short, 4 dice with 6 sides, page 1 of 4\n\n1111 acid"))
+	assert.Contains(pages[3], "6666 zoom")
+}
+
+func TestRunRollEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"roll", "-manual", "-words", "2", "-list", "eff-short"},
+		strings.NewReader("1 1 1 1\n6 6 6 6\n"), &stdout, &stderr)
+
+	assert.Equal(0, code)
+	assert.Equal(wordlist.EFFShort.FetchWord(1111)+" "+wordlist.EFFShort.FetchWord(6666)+"\n", stdout.String())
+	assert.Contains(stderr.String(), "word 2 of 2, roll 4 dice with 6 sides: ")
+}
+
+func TestRunFetchEFFShort(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "eff-short.txt")
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"wordlist", "fetch", "eff-short", "--out", path}, nil, &stdout, &stderr))
+	assert.Contains(stderr.String(), "sha256 ")
+
+	written, err := wordlist.LoadFile(path)
+	if assert.NoError(err) {
+		assert.Equal(wordlist.EFFShortSHA256, wordlist.Digest(written))
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+var (
+	// errEntropy represents the error given when the source of randomness
+	// fails, which exits with exitEntropy rather than exitFailure.
+	errEntropy = errors.New("reading from the source of randomness failed")
+	// errMinEntropyConflict represents the error given when -min-entropy is
+	// combined with -words.
+	errMinEntropyConflict = errors.New("-min-entropy and -words are unable to be combined")
+	// errNoWordEntropy represents the error given when -min-entropy is given
+	// for a wordlist whose words provide no entropy.
+	errNoWordEntropy = errors.New("the words of the wordlist provide no entropy")
+)
+
+// randomSource represents the source of randomness the passphrases are rolled
+// with, which is replaced within the tests.
+var randomSource diceware.RandomSource = diceware.CryptoRandomSource{}
+
+// entropySource defines a diceware.RandomSource marking every failure of the
+// wrapped source with errEntropy, so that a failing source of randomness is
+// told apart from any other failure.
+type entropySource struct {
+	// source represents the wrapped source of randomness.
+	source diceware.RandomSource
+}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the diceware.RandomSource interface which pulls
+// a random number from the wrapped source, wrapping any failure with
+// errEntropy.
+func (rs entropySource) GetRandom(max *big.Int) (*big.Int, error) {
+	value, err := rs.source.GetRandom(max)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errEntropy, err)
+	}
+
+	return value, nil
+}
+
+// minEntropyWords returns an int.
+// Implements the logic of -min-entropy, calculating the smallest number of
+// words rolled from the wordlist providing at least the given entropy in bits.
+func minEntropyWords(wl diceware.Wordlist, bits float64) (int, error) {
+	if bits < 0 {
+		return 0, fmt.Errorf("%w: %g", diceware.ErrInvalidMinEntropy, bits)
+	}
+
+	words := diceware.SuggestWordCount(wl, bits)
+	if words == 0 {
+		return 0, errNoWordEntropy
+	}
+
+	return words, nil
+}
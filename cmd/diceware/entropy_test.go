@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingSource implements the diceware.RandomSource interface, failing every
+// call.
+type failingSource struct{}
+
+func (failingSource) GetRandom(*big.Int) (*big.Int, error) {
+	return nil, errors.New("device not configured")
+}
+
+func TestRunEntropyFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	randomSource = failingSource{}
+	t.Cleanup(func() { randomSource = diceware.CryptoRandomSource{} })
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(exitEntropy, run([]string{"-q"}, nil, &stdout, &stderr))
+	assert.Empty(stdout.String())
+	assert.Contains(stderr.String(), "reading from the source of randomness failed: device not configured")
+}
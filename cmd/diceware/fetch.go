@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// errFetchArgs represents the error given when the fetch subcommand is not
+	// given exactly one wordlist name or URL.
+	errFetchArgs = errors.New("wordlist fetch requires the name of a built-in wordlist or a single URL")
+	// errDigestRequired represents the error given when a wordlist is fetched
+	// from a URL without its SHA-256 digest.
+	errDigestRequired = errors.New("-sha256 is required to fetch a wordlist from a URL")
+)
+
+// fetcher represents the downloader of the wordlists fetched from a URL, which
+// is replaced within the tests.
+var fetcher = &wordlist.Fetcher{}
+
+// runFetch returns an int.
+// Implements the logic of the wordlist fetch subcommand, writing the built-in
+// wordlist of the given name, or the wordlist downloaded from the given HTTPS
+// URL, to the file given by -out, or otherwise to stdout, so that it is able
+// to be staged for machines without network access.  The built-in wordlists
+// are checked against their digests and the downloaded wordlist against the
+// digest given by -sha256 before anything is written.  The SHA-256 digest of
+// the written file is reported on stderr.  Flags are accepted before and after
+// the name or URL.
+func runFetch(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diceware wordlist fetch", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	out := flags.String("out", "", "path of the file the wordlist is written to, stdout when not given")
+	digest := flags.String("sha256", "", "hexadecimal SHA-256 digest of the wordlist fetched from a URL")
+	timeout := flags.Duration("timeout", 30*time.Second, "time allowed to download the wordlist from a URL")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if len(positional) != 1 {
+		fmt.Fprintf(stderr, "diceware: %v\n", errFetchArgs)
+		return exitUsage
+	}
+
+	var wl wordlist.Source
+	if source := positional[0]; strings.Contains(source, "://") {
+		if *digest == "" {
+			fmt.Fprintf(stderr, "diceware: %v\n", errDigestRequired)
+			return exitUsage
+		}
+
+		wl, err = downloadWordlist(source, *digest, *timeout)
+		if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitFailure
+		}
+	} else {
+		wl, err = selectWordlist(source, "")
+		if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitUsage
+		}
+
+		if err := wordlist.VerifyIntegrity(); err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitFailure
+		}
+	}
+
+	written, err := writeWordlist(wl, *out, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	fmt.Fprintf(stderr, "sha256 %x\n", sha256.Sum256(written))
+
+	return 0
+}
+
+// downloadWordlist returns a wordlist.Source.
+// Implements the logic to download the wordlist found at the given URL within
+// the timeout, rejecting it unless it matches the given SHA-256 digest.
+func downloadWordlist(url, digest string, timeout time.Duration) (wordlist.Source, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return fetcher.Fetch(ctx, url, digest)
+}
+
+// writeWordlist returns a slice of bytes.
+// Implements the logic to write the wordlist to the file of the given path,
+// or to stdout when the path is empty, returning the bytes written.
+func writeWordlist(wl wordlist.Source, out string, stdout io.Writer) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := wordlist.Write(&buffer, wl); err != nil {
+		return nil, err
+	}
+
+	if out == "" {
+		if _, err := stdout.Write(buffer.Bytes()); err != nil {
+			return nil, err
+		}
+
+		return buffer.Bytes(), nil
+	}
+
+	if err := os.WriteFile(out, buffer.Bytes(), 0o644); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
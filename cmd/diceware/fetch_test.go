@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunFetch(t *testing.T) {
+	assert := assert.New(t)
+
+	custom := "1\tacid\n2\tbanjo\n3\tcomet\n4\tdrum\n5\tember\n6\tfjord\n"
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, custom)
+	}))
+	t.Cleanup(server.Close)
+
+	fetcher = &wordlist.Fetcher{Client: server.Client()}
+	t.Cleanup(func() { fetcher = &wordlist.Fetcher{} })
+
+	digest := sha256.Sum256([]byte(custom))
+	dir := t.TempDir()
+
+	tests := []struct {
+		Name   string
+		Args   []string
+		Value  string
+		Code   int
+		Stderr string
+	}{
+		{
+			Name:   "will write a built-in wordlist to the file",
+			Args:   []string{"wordlist", "fetch", "eff-long", "--out", filepath.Join(dir, "eff-long.txt")},
+			Stderr: "sha256 ",
+		}, {
+			Name:   "will write a wordlist downloaded from a URL with its digest",
+			Args:   []string{"wordlist", "fetch", "-sha256", hex.EncodeToString(digest[:]), server.URL + "/custom.txt"},
+			Value:  custom,
+			Stderr: "sha256 " + hex.EncodeToString(digest[:]),
+		}, {
+			Name:   "will exit with a failure when the digest does not match",
+			Args:   []string{"wordlist", "fetch", server.URL + "/custom.txt", "-sha256", wordlist.DigitsSHA256},
+			Code:   exitFailure,
+			Stderr: wordlist.ErrDigestMismatch.Error(),
+		}, {
+			Name:   "will exit with a usage error for a URL without a digest",
+			Args:   []string{"wordlist", "fetch", server.URL + "/custom.txt"},
+			Code:   exitUsage,
+			Stderr: errDigestRequired.Error(),
+		}, {
+			Name:   "will exit with a usage error for an unknown wordlist",
+			Args:   []string{"wordlist", "fetch", "klingon"},
+			Code:   exitUsage,
+			Stderr: "wordlist not registered",
+		}, {
+			Name:   "will exit with a usage error without a wordlist",
+			Args:   []string{"wordlist", "fetch", "-out", filepath.Join(dir, "none.txt")},
+			Code:   exitUsage,
+			Stderr: errFetchArgs.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, nil, &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+		assert.Equal(test.Value, stdout.String(), test.Name)
+	}
+
+	written, err := wordlist.LoadFile(filepath.Join(dir, "eff-long.txt"))
+	if assert.NoError(err) {
+		assert.Equal(wordlist.EFFLongSHA256, wordlist.Digest(written))
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "none.txt"))
+	assert.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestParseInterspersed(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Args       []string
+		Positional []string
+		Out        string
+	}{
+		{
+			Name:       "will parse the flags before the positional arguments",
+			Args:       []string{"-out", "words.txt", "eff-long"},
+			Positional: []string{"eff-long"},
+			Out:        "words.txt",
+		}, {
+			Name:       "will parse the flags after the positional arguments",
+			Args:       []string{"eff-long", "--out", "words.txt", "extra"},
+			Positional: []string{"eff-long", "extra"},
+			Out:        "words.txt",
+		}, {
+			Name:       "will keep every argument following -- as positional",
+			Args:       []string{"eff-long", "--", "-out", "words.txt"},
+			Positional: []string{"eff-long", "-out", "words.txt"},
+		},
+	}
+
+	for _, test := range tests {
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		out := flags.String("out", "", "")
+
+		positional, err := parseInterspersed(flags, test.Args)
+		assert.NoError(err, test.Name)
+		assert.Equal(test.Positional, positional, test.Name)
+		assert.Equal(test.Out, *out, test.Name)
+	}
+}
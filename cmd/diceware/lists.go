@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// errConflictingLists represents the error given when both a wordlist name
+	// and a wordlist file are given.
+	errConflictingLists = errors.New("-list and -list-file are unable to be combined")
+	// errUnknownWordlistCommand represents the error given when the wordlist
+	// subcommand is given an unknown command.
+	errUnknownWordlistCommand = errors.New("unknown wordlist command")
+)
+
+// wordlistCommands represents the commands of the wordlist subcommand.
+var wordlistCommands = []string{"validate", "fetch", "print"}
+
+// selectWordlist returns a diceware.Wordlist.
+// Implements the logic to load the custom wordlist found at the given file
+// when one is given, and otherwise to look up the built-in wordlist of the
+// given name, listing every registered name when there is none.
+func selectWordlist(name, file string) (diceware.Wordlist, error) {
+	if file != "" {
+		if name != "" {
+			return nil, errConflictingLists
+		}
+
+		return wordlist.LoadFile(file)
+	}
+
+	if name == "" {
+		name = defaultList
+	}
+
+	wl, err := wordlist.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w, choose one of: %s", err, strings.Join(wordlist.Names(), ", "))
+	}
+
+	return wl, nil
+}
+
+// wordlistFlags returns two string pointers.
+// Implements the logic to define the -list and -list-file flags on the given
+// flag set, which are shared by the command and its subcommands.  The list of
+// the config is applied by `configuredList` once the flags are parsed, so that
+// it never conflicts with -list-file.
+func wordlistFlags(flags *flag.FlagSet) (*string, *string) {
+	list := flags.String("list", "", "name of the built-in wordlist the words are rolled from, one of: "+
+		strings.Join(wordlist.Names(), ", ")+" (default "+defaultList+")")
+	listFile := flags.String("list-file", "", "path of a custom wordlist the words are rolled from")
+
+	return list, listFile
+}
+
+// configuredList returns a string.
+// Implements the logic to fall back to the wordlist of the config when
+// neither -list nor -list-file were given.
+func configuredList(list, listFile string, cfg config) string {
+	if list == "" && listFile == "" {
+		return cfg.list
+	}
+
+	return list
+}
+
+// runWordlist returns an int.
+// Implements the logic of the wordlist subcommand, running the command given
+// as its first argument, returning the exit code of the command.
+func runWordlist(args []string, stdout, stderr io.Writer) int {
+	command := ""
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "validate":
+		return runValidate(args[1:], stdout, stderr)
+	case "fetch":
+		return runFetch(args[1:], stdout, stderr)
+	case "print":
+		return runPrint(args[1:], stdout, stderr)
+	}
+
+	fmt.Fprintf(stderr, "diceware: %v %q, choose one of: %s\n",
+		errUnknownWordlistCommand, command, strings.Join(wordlistCommands, ", "))
+
+	return exitUsage
+}
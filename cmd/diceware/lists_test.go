@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectWordlist(t *testing.T) {
+	assert := assert.New(t)
+
+	custom := filepath.Join(t.TempDir(), "custom.txt")
+	assert.NoError(os.WriteFile(custom, []byte("1\tacid\n2\tcat\n"), 0o600))
+
+	tests := []struct {
+		Name  string
+		List  string
+		File  string
+		Value string
+		Error error
+	}{
+		{
+			Name:  "will default to the EFF long wordlist",
+			Value: "eff-long",
+		}, {
+			Name:  "will select a built-in wordlist by name",
+			List:  "eff-long",
+			Value: "eff-long",
+		}, {
+			Name:  "will load a custom wordlist from a file",
+			File:  custom,
+			Value: "custom",
+		}, {
+			Name:  "will error for an unknown wordlist",
+			List:  "klingon",
+			Error: wordlist.ErrNotRegistered,
+		}, {
+			Name:  "will error when given both a name and a file",
+			List:  "eff-long",
+			File:  custom,
+			Error: errConflictingLists,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := selectWordlist(test.List, test.File)
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error == nil {
+			assert.Equal(test.Value, wl.(interface{ Name() string }).Name(), test.Name)
+		}
+	}
+
+	_, err := selectWordlist("klingon", "")
+	assert.ErrorContains(err, "choose one of: "+strings.Join(wordlist.Names(), ", "))
+}
@@ -0,0 +1,454 @@
+// Command diceware generates diceware passphrases from the terminal.
+//
+// Usage:
+//
+//	diceware [flags]
+//	diceware roll -manual [flags]
+//	diceware check [flags] [passphrase]
+//	diceware wordlist validate [flags] file
+//	diceware wordlist fetch [flags] name|url
+//	diceware wordlist print [flags] [name]
+//
+// For example, `diceware -words 7 -sep "-" -list eff-short` prints a
+// passphrase of 7 words from the EFF short wordlist separated by "-".
+//
+// The flags are:
+//
+//	-words n
+//		number of words within the passphrase, 6 by default
+//	-min-entropy bits
+//		minimum entropy in bits of the passphrase, replacing -words with the
+//		smallest number of words from the wordlist providing it
+//	-sep separator
+//		separator placed between the words, a space by default
+//	-pattern pattern
+//		structure of the passphrase replacing -words and -sep, where each W is
+//		replaced by a word, each D by a digit, each S by a symbol and every
+//		other character is kept, e.g. "W-W-W-DD!", for systems with strict
+//		composition rules
+//	-list name
+//		name of any built-in wordlist, "eff-long" by default
+//	-list-file path
+//		custom wordlist in the format of the original diceware lists, JSON or CSV
+//	-capitalize mode
+//		capitalization of the words, one of none, title, upper, random-word or
+//		random-letter, "none" by default
+//	-json
+//		write each passphrase as a JSON object holding the passphrase, its
+//		words, the name of the wordlist, the number of words and the entropy
+//	-n count
+//		number of passphrases to generate, one on each line, so that the most
+//		memorable can be chosen
+//	-show-entropy
+//		write the entropy in bits of each passphrase and the estimated time to
+//		crack it offline with GPUs alongside it
+//	-phonetic
+//		write the NATO phonetic spelling of each passphrase on the line beneath
+//		it, for reading it over the phone
+//	-copy
+//		put the passphrase on the system clipboard instead of printing it, using
+//		pbcopy, clip, wl-copy, xclip or xsel
+//	-clear-after duration
+//		with -copy, wait for the duration, e.g. 30s, before wiping the
+//		clipboard, unless something else has been copied in the meantime
+//	-q
+//		write only the passphrase without a trailing newline, separating the
+//		passphrases of -n with newlines, and nothing but errors to stderr
+//	-0
+//		terminate each passphrase with a NUL character instead of a newline, for
+//		piping the passphrases of -n into `xargs -0` even when the separator
+//		holds spaces
+//
+// The roll subcommand builds the passphrase from physical dice instead.  With
+// -manual it prompts for the results of the dice of each word in turn, such
+// as "3 1 2 4 6", and prints the resulting passphrase.  It accepts the -words,
+// -sep, -list and -list-file flags.
+//
+// The check subcommand evaluates an existing passphrase, given as its
+// arguments or as the first line of stdin so that it is kept out of the shell
+// history.  It detects the separator and the built-in wordlist holding the
+// most of the words, and reports the number of words and the entropy assuming
+// they were rolled with diceware, warning about any words not from the
+// wordlist.  -sep gives the separator, and -list or -list-file the wordlist.
+//
+// The wordlist validate subcommand vets a custom wordlist file before it is
+// distributed, reporting its duplicated words, words containing whitespace,
+// words that are a prefix of another, pairs of words closer than the edit
+// distance given by -min-distance, 3 by default, and offensive words.  It
+// exits with 1 when the wordlist has duplicated words or words containing
+// whitespace, or any of the other findings when -strict is given.
+//
+// The wordlist fetch subcommand writes a wordlist to the file given by -out,
+// or to stdout, so that it is able to be staged for machines without network
+// access.  It writes the built-in wordlist of the given name once the
+// built-in wordlists are checked against their digests, or downloads the
+// wordlist found at the given HTTPS URL, which must match the SHA-256 digest
+// given by -sha256.  The SHA-256 digest of the written file is reported on
+// stderr.
+//
+// The wordlist print subcommand writes a sheet of the roll values and words of
+// a wordlist, "eff-long" by default or the file given by -list-file, to be
+// printed and used with physical dice.  The words are laid out in columns
+// fitting the -width of each line, 80 by default, on pages of -lines lines,
+// 60 by default, separated by form feeds.
+//
+// The defaults of -words, -sep, -list and -capitalize are read from the config
+// file found at $DICEWARE_CONFIG, or otherwise at diceware/config.toml within
+// the user config directory, e.g. ~/.config/diceware/config.toml:
+//
+//	words = 7
+//	separator = "-"
+//	list = "eff-short"
+//	capitalize = "title"
+//
+// The environment variables DICEWARE_WORDS, DICEWARE_SEPARATOR, DICEWARE_LIST
+// and DICEWARE_CAPITALIZE take priority over the config file, and the flags
+// take priority over both.
+//
+// The exit codes are:
+//
+//	0	the passphrases were generated
+//	1	the passphrases were unable to be generated, written or copied
+//	2	the flags are invalid
+//	3	the source of randomness failed
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+// defaultList represents the name of the wordlist used when none is given.
+const defaultList = "eff-long"
+
+const (
+	// exitFailure represents the exit code given when a passphrase is unable to
+	// be generated, written or copied.
+	exitFailure = 1
+	// exitUsage represents the exit code given when the command line flags are
+	// invalid, matching the exit code of the flag package.
+	exitUsage = 2
+	// exitEntropy represents the exit code given when the source of randomness
+	// fails, so that scripts are able to tell it apart from invalid flags.
+	exitEntropy = 3
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// generateFlags defines the flags of the command when generating passphrases.
+type generateFlags struct {
+	// words represents the number of words within each passphrase.
+	words int
+
+	// minEntropy represents the minimum entropy in bits replacing words.
+	minEntropy float64
+
+	// separator represents the separator placed between the words.
+	separator string
+
+	// pattern represents the structure replacing words and separator.
+	pattern string
+
+	// list represents the name of the built-in wordlist.
+	list string
+
+	// listFile represents the path of a custom wordlist.
+	listFile string
+
+	// capitalize represents the name of the capitalization of the words.
+	capitalize string
+
+	// format represents the way each passphrase is written.
+	format outputFormat
+
+	// count represents the number of passphrases to generate.
+	count int
+
+	// copy represents whether the passphrase is put on the clipboard.
+	copy bool
+
+	// clearAfter represents the duration before the clipboard is wiped.
+	clearAfter time.Duration
+
+	// wordsSet represents whether -words was given on the command line.
+	wordsSet bool
+
+	// separatorSet represents whether -sep was given on the command line.
+	separatorSet bool
+}
+
+// run returns an int.
+// Implements the logic of the command, parsing the given arguments and
+// writing the generated passphrases to stdout, or any problem to stderr,
+// returning the exit code of the command.  The roll subcommand reads the
+// results of physical dice from stdin, the check subcommand evaluates an
+// existing passphrase and the wordlist subcommand works with wordlists.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "check":
+			return runCheck(args[1:], stdin, stdout, stderr)
+		case "wordlist":
+			return runWordlist(args[1:], stdout, stderr)
+		}
+	}
+
+	// the config is only loaded when generating or rolling a passphrase, so
+	// that a broken config never stops a passphrase or wordlist being checked
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitUsage
+	}
+
+	if len(args) > 0 && args[0] == "roll" {
+		return runRoll(args[1:], cfg, stdin, stdout, stderr)
+	}
+
+	return runGenerate(args, cfg, stdout, stderr)
+}
+
+// runGenerate returns an int.
+// Implements the logic of the command without a subcommand, generating the
+// passphrases described by the given arguments and writing them to stdout,
+// returning the exit code of the command.
+func runGenerate(args []string, cfg config, stdout, stderr io.Writer) int {
+	gf, err := parseGenerateFlags(args, cfg, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	generator, err := newGenerator(gf, cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitUsage
+	}
+
+	return writePassphrases(generator, gf, stdout, stderr)
+}
+
+// parseGenerateFlags returns a generateFlags.
+// Implements the logic to parse the flags of the command without a
+// subcommand, taking the defaults of -words, -sep and -capitalize from the
+// config.  The flag package has already reported any error returned.
+func parseGenerateFlags(args []string, cfg config, stderr io.Writer) (generateFlags, error) {
+	flags := flag.NewFlagSet("diceware", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	gf := generateFlags{}
+	flags.IntVar(&gf.words, "words", cfg.words, "number of words within the passphrase")
+	flags.Float64Var(&gf.minEntropy, "min-entropy", 0,
+		"minimum entropy in bits, replacing -words with the number of words providing it")
+	flags.StringVar(&gf.separator, "sep", cfg.separator, "separator placed between the words")
+	flags.StringVar(&gf.pattern, "pattern", "", "structure of the passphrase replacing -words and -sep, "+
+		"where each W is a word, D a digit and S a symbol, e.g. \"W-W-W-DD!\"")
+	list, listFile := wordlistFlags(flags)
+	flags.StringVar(&gf.capitalize, "capitalize", cfg.capitalize, "capitalization of the words, one of: "+
+		capitalizationNames())
+	flags.BoolVar(&gf.format.json, "json", false, "write each passphrase as a JSON object on its own line")
+	flags.BoolVar(&gf.format.showEntropy, "show-entropy", false,
+		"write the entropy in bits and the estimated crack time alongside each passphrase")
+	flags.BoolVar(&gf.format.phonetic, "phonetic", false,
+		"write the NATO phonetic spelling beneath each passphrase, for reading it over the phone")
+	flags.IntVar(&gf.count, "n", 1, "number of passphrases to generate, one on each line")
+	flags.BoolVar(&gf.format.quiet, "q", false, "write only the passphrase, without a trailing newline")
+	flags.BoolVar(&gf.format.nul, "0", false, "terminate each passphrase with a NUL character instead of a newline")
+	flags.BoolVar(&gf.copy, "copy", false, "put the passphrase on the system clipboard instead of printing it")
+	flags.DurationVar(&gf.clearAfter, "clear-after", 0,
+		"wipe the clipboard after the given duration, e.g. 30s, with -copy")
+
+	if err := flags.Parse(args); err != nil {
+		return generateFlags{}, err
+	}
+
+	gf.list, gf.listFile = *list, *listFile
+	gf.wordsSet, gf.separatorSet = isFlagSet(flags, "words"), isFlagSet(flags, "sep")
+
+	return gf, nil
+}
+
+// newGenerator returns a Generator.
+// Implements the logic to check the parsed flags against each other and build
+// the Generator of the passphrases they describe.
+func newGenerator(gf generateFlags, cfg config) (*diceware.Generator, error) {
+	wl, err := selectWordlist(configuredList(gf.list, gf.listFile, cfg), gf.listFile)
+	if err != nil {
+		return nil, err
+	}
+
+	capitalization, err := parseCapitalization(gf.capitalize)
+	if err != nil {
+		return nil, err
+	}
+
+	if gf.pattern != "" && (gf.wordsSet || gf.separatorSet || gf.minEntropy != 0) {
+		return nil, errPatternConflict
+	}
+
+	words := gf.words
+	if gf.minEntropy != 0 {
+		if gf.wordsSet {
+			return nil, errMinEntropyConflict
+		}
+
+		words, err = minEntropyWords(wl, gf.minEntropy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkOutputFlags(gf); err != nil {
+		return nil, err
+	}
+
+	return diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount:      words,
+		Separator:      gf.separator,
+		Pattern:        gf.pattern,
+		Wordlist:       wl,
+		Capitalization: capitalization,
+		RandomSource:   entropySource{source: randomSource},
+	})
+}
+
+// checkOutputFlags returns an error.
+// Implements the logic to reject the flags describing how the passphrases are
+// written that conflict with each other.
+func checkOutputFlags(gf generateFlags) error {
+	if gf.count < 1 {
+		return fmt.Errorf("invalid passphrase count %d, must be at least 1", gf.count)
+	}
+
+	if gf.clearAfter != 0 && !gf.copy {
+		return errClearWithoutCopy
+	}
+
+	format := gf.format
+	if format.quiet && (format.json || format.showEntropy || format.phonetic) {
+		return errQuietConflict
+	}
+
+	if format.nul && (format.json || format.showEntropy || format.phonetic || format.quiet) {
+		return errNulConflict
+	}
+
+	if gf.copy && (format.json || format.showEntropy || format.phonetic || gf.count != 1) {
+		return errCopyConflict
+	}
+
+	return nil
+}
+
+// writePassphrases returns an int.
+// Implements the logic to generate the passphrases and write them to stdout,
+// or put the passphrase on the clipboard with -copy, returning the exit code
+// of the command.
+func writePassphrases(generator *diceware.Generator, gf generateFlags, stdout, stderr io.Writer) int {
+	for i := 0; i < gf.count; i++ {
+		passphrase, err := generator.GeneratePassphrase()
+		if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			if errors.Is(err, errEntropy) {
+				return exitEntropy
+			}
+
+			return exitFailure
+		}
+
+		if gf.copy {
+			return copyAndClear(passphrase.String(), gf.clearAfter, gf.format.quiet, stderr)
+		}
+
+		if gf.format.quiet && i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		if err := writePassphrase(stdout, passphrase, gf.format); err != nil {
+			fmt.Fprintf(stderr, "diceware: %v\n", err)
+			return exitFailure
+		}
+	}
+
+	return 0
+}
+
+// copyAndClear returns an int.
+// Implements the logic of -copy, putting the passphrase on the system
+// clipboard and waiting to wipe it when clearAfter is positive, returning the
+// exit code of the command.
+func copyAndClear(passphrase string, clearAfter time.Duration, quiet bool, stderr io.Writer) int {
+	if err := systemClipboard.Copy(passphrase); err != nil {
+		fmt.Fprintf(stderr, "diceware: copying to the clipboard: %v\n", err)
+		return exitFailure
+	}
+
+	if clearAfter <= 0 {
+		if !quiet {
+			fmt.Fprintln(stderr, "passphrase copied to the clipboard")
+		}
+
+		return 0
+	}
+
+	if !quiet {
+		fmt.Fprintf(stderr, "passphrase copied to the clipboard, clearing in %s\n", clearAfter)
+	}
+
+	if err := clearClipboard(systemClipboard, passphrase, clearAfter); err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	return 0
+}
+
+// isFlagSet returns a bool.
+// Implements the logic to report whether the flag of the given name was given
+// on the command line, rather than left at its default.
+func isFlagSet(flags *flag.FlagSet, name string) bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// parseInterspersed returns a slice of strings.
+// Implements the logic to parse the flags found both before and after the
+// positional arguments, which the flag package stops at, returning the
+// positional arguments.  Every argument following "--" is positional.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := flags.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+
+		if parsed := len(args) - len(rest); parsed > 0 && args[parsed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Args     []string
+		Words    int
+		Sep      string
+		Wordlist *wordlist.Map
+		Code     int
+		Stderr   string
+	}{
+		{
+			Name:     "will generate 6 words from the EFF long wordlist by default",
+			Words:    6,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will generate the requested words from the requested wordlist",
+			Args:     []string{"-words", "7", "-sep", ".", "-list", "eff-long"},
+			Words:    7,
+			Sep:      ".",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will accept flags with two dashes",
+			Args:     []string{"--words=3", "--sep=_"},
+			Words:    3,
+			Sep:      "_",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will write only the passphrase with -q",
+			Args:     []string{"-q", "-words", "4", "-sep", "."},
+			Words:    4,
+			Sep:      ".",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will generate the words needed for the minimum entropy",
+			Args:     []string{"-min-entropy", "90"},
+			Words:    7,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:   "will exit with a usage error for -min-entropy with -words",
+			Args:   []string{"-min-entropy", "90", "-words", "4"},
+			Code:   exitUsage,
+			Stderr: errMinEntropyConflict.Error(),
+		}, {
+			Name:   "will exit with a usage error for a negative minimum entropy",
+			Args:   []string{"-min-entropy", "-1"},
+			Code:   exitUsage,
+			Stderr: "invalid negative minimum entropy given",
+		}, {
+			Name:   "will exit with a usage error for -pattern with -words",
+			Args:   []string{"-pattern", "W-W", "-words", "4"},
+			Code:   exitUsage,
+			Stderr: errPatternConflict.Error(),
+		}, {
+			Name:   "will exit with a usage error for a pattern without a word",
+			Args:   []string{"-pattern", "DD!"},
+			Code:   exitUsage,
+			Stderr: "invalid pattern given",
+		}, {
+			Name:   "will exit with a usage error for -0 with -json",
+			Args:   []string{"-0", "-json"},
+			Code:   exitUsage,
+			Stderr: errNulConflict.Error(),
+		}, {
+			Name:   "will exit with a usage error for -q with -json",
+			Args:   []string{"-q", "-json"},
+			Code:   exitUsage,
+			Stderr: errQuietConflict.Error(),
+		}, {
+			Name:   "will exit with a usage error for an unknown wordlist",
+			Args:   []string{"-list", "klingon"},
+			Code:   exitUsage,
+			Stderr: "wordlist not registered",
+		}, {
+			Name:   "will exit with a usage error for an invalid word count",
+			Args:   []string{"-words", "0"},
+			Code:   exitUsage,
+			Stderr: "invalid word count",
+		}, {
+			Name:   "will exit with a usage error for an invalid passphrase count",
+			Args:   []string{"-n", "0"},
+			Code:   exitUsage,
+			Stderr: "invalid passphrase count",
+		}, {
+			Name:   "will exit with a usage error for an unknown flag",
+			Args:   []string{"-bogus"},
+			Code:   exitUsage,
+			Stderr: "flag provided but not defined",
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, nil, &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+		if test.Code != 0 {
+			assert.Empty(stdout.String(), test.Name)
+			continue
+		}
+
+		words := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), test.Sep)
+		assert.Len(words, test.Words, test.Name)
+		for _, word := range words {
+			_, found := wordlist.Index(test.Wordlist).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
+
+func TestRunCount(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"-n", "5", "-words", "3"}, nil, &stdout, &stderr))
+
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	assert.Len(lines, 5)
+	for _, line := range lines {
+		assert.Len(strings.Fields(line), 3)
+	}
+
+	stdout.Reset()
+	assert.Equal(0, run([]string{"-n", "3", "-json"}, nil, &stdout, &stderr))
+	assert.Equal(3, strings.Count(stdout.String(), "\n"))
+
+	stdout.Reset()
+	assert.Equal(0, run([]string{"-n", "4", "-0", "-sep", " "}, nil, &stdout, &stderr))
+	assert.Equal(4, strings.Count(stdout.String(), "\x00"))
+	assert.True(strings.HasSuffix(stdout.String(), "\x00"))
+	assert.NotContains(stdout.String(), "\n")
+
+	stdout.Reset()
+	assert.Equal(0, run([]string{"-n", "3", "-q"}, nil, &stdout, &stderr))
+	assert.Equal(2, strings.Count(stdout.String(), "\n"))
+	assert.False(strings.HasSuffix(stdout.String(), "\n"))
+}
+
+func TestRunPattern(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"-pattern", "W-W-W-DD!", "-list", "eff-long"}, nil, &stdout, &stderr))
+	assert.Regexp(`^[a-z-]+-[a-z-]+-[a-z-]+-[0-9]{2}!\n$`, stdout.String())
+}
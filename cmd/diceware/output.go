@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+// crackRate represents the guess rate the crack time shown by -show-entropy is
+// estimated at, which is the fastest of the library's guess rates.
+var crackRate = diceware.GuessRateOfflineGPU
+
+var (
+	// errQuietConflict represents the error given when -q is combined with
+	// flags that write more than the passphrase.
+	errQuietConflict = errors.New("-q is unable to be combined with -json, -show-entropy or -phonetic")
+	// errPatternConflict represents the error given when -pattern is combined
+	// with the flags it replaces.
+	errPatternConflict = errors.New("-pattern is unable to be combined with -words, -sep or -min-entropy")
+	// errNulConflict represents the error given when -0 is combined with flags
+	// that write more than the passphrases.
+	errNulConflict = errors.New("-0 is unable to be combined with -json, -show-entropy, -phonetic or -q")
+)
+
+// outputFormat defines how each generated passphrase is written.
+type outputFormat struct {
+	// json represents whether each passphrase is written as a JSON object.
+	json bool
+
+	// showEntropy represents whether the entropy in bits and the estimated
+	// crack time are written alongside each passphrase.
+	showEntropy bool
+
+	// quiet represents whether only the passphrase is written, without a
+	// trailing newline.
+	quiet bool
+
+	// phonetic represents whether the NATO phonetic spelling is written
+	// beneath each passphrase.
+	phonetic bool
+
+	// nul represents whether each passphrase is terminated by a NUL character
+	// rather than a newline.
+	nul bool
+}
+
+// jsonPassphrase defines the JSON object written for each passphrase when the
+// -json flag is given, one object on each line.
+type jsonPassphrase struct {
+	// Passphrase represents the generated passphrase.
+	Passphrase string `json:"passphrase"`
+
+	// Words represents each of the words within the passphrase.
+	Words []string `json:"words"`
+
+	// Wordlist represents the name of the wordlist the words were rolled from.
+	Wordlist string `json:"wordlist"`
+
+	// WordCount represents the number of words within the passphrase.
+	WordCount int `json:"word_count"`
+
+	// EntropyBits represents the entropy in bits of the passphrase.
+	EntropyBits float64 `json:"entropy_bits"`
+
+	// CrackTime represents the estimated time to guess the passphrase, which
+	// is only set when the -show-entropy flag is given.
+	CrackTime string `json:"crack_time,omitempty"`
+
+	// Phonetic represents the NATO phonetic spelling of the passphrase, which
+	// is only set when the -phonetic flag is given.
+	Phonetic string `json:"phonetic,omitempty"`
+}
+
+// writePassphrase returns an error.
+// Implements the logic to write the passphrase to w on its own line, in the
+// given format.  A quiet format writes the passphrase alone, without the
+// newline, and a nul format terminates the passphrase with a NUL character
+// instead.  The phonetic spelling is written on the line beneath.
+func writePassphrase(w io.Writer, passphrase *diceware.Passphrase, format outputFormat) error {
+	crackTime := ""
+	if format.showEntropy {
+		crackTime = diceware.EstimateStrength(passphrase.EntropyBits, crackRate).CrackTimes[0].String()
+	}
+
+	if format.quiet {
+		_, err := io.WriteString(w, passphrase.String())
+		return err
+	}
+
+	if format.nul {
+		_, err := io.WriteString(w, passphrase.String()+"\x00")
+		return err
+	}
+
+	phonetic := ""
+	if format.phonetic {
+		phonetic = passphrase.Phonetic()
+	}
+
+	if format.json {
+		return json.NewEncoder(w).Encode(jsonPassphrase{
+			Passphrase:  passphrase.String(),
+			Words:       passphrase.Words,
+			Wordlist:    passphrase.Wordlist,
+			WordCount:   len(passphrase.Words),
+			EntropyBits: passphrase.EntropyBits,
+			CrackTime:   crackTime,
+			Phonetic:    phonetic,
+		})
+	}
+
+	var err error
+	if format.showEntropy {
+		_, err = fmt.Fprintf(w, "%s\t(%.1f bits, %s to crack %s)\n",
+			passphrase.String(), passphrase.EntropyBits, crackTime, crackRate.Name)
+	} else {
+		_, err = fmt.Fprintln(w, passphrase.String())
+	}
+
+	if err != nil || !format.phonetic {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, phonetic)
+
+	return err
+}
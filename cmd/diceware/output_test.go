@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePassphrase(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase := &diceware.Passphrase{
+		Words:       []string{"correct", "horse", "battery"},
+		Separator:   "-",
+		Wordlist:    "eff-long",
+		EntropyBits: 38.77,
+	}
+
+	tests := []struct {
+		Name   string
+		Format outputFormat
+		Value  string
+	}{
+		{
+			Name:  "will write the passphrase on its own line",
+			Value: "correct-horse-battery\n",
+		}, {
+			Name:   "will write the passphrase as a JSON object on its own line",
+			Format: outputFormat{json: true},
+			Value: `{"passphrase":"correct-horse-battery","words":["correct","horse","battery"],` +
+				`"wordlist":"eff-long","word_count":3,"entropy_bits":38.77}` + "\n",
+		}, {
+			Name:   "will write the passphrase alone when quiet",
+			Format: outputFormat{quiet: true},
+			Value:  "correct-horse-battery",
+		}, {
+			Name:   "will write the phonetic spelling beneath the passphrase",
+			Format: outputFormat{phonetic: true},
+			Value: "correct-horse-battery\n" +
+				"charlie oscar romeo romeo echo charlie tango hyphen hotel oscar romeo sierra echo hyphen " +
+				"bravo alfa tango tango echo romeo yankee\n",
+		}, {
+			Name:   "will add the phonetic spelling to the JSON object",
+			Format: outputFormat{json: true, phonetic: true},
+			Value: `{"passphrase":"correct-horse-battery","words":["correct","horse","battery"],` +
+				`"wordlist":"eff-long","word_count":3,"entropy_bits":38.77,"phonetic":"charlie oscar romeo romeo ` +
+				`echo charlie tango hyphen hotel oscar romeo sierra echo hyphen bravo alfa tango tango echo romeo ` +
+				`yankee"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buffer bytes.Buffer
+		assert.NoError(writePassphrase(&buffer, passphrase, test.Format), test.Name)
+		assert.Equal(test.Value, buffer.String(), test.Name)
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"-json", "-words", "4", "-list", "eff-long"}, nil, &stdout, &stderr))
+
+	var result jsonPassphrase
+	if assert.NoError(json.Unmarshal(stdout.Bytes(), &result)) {
+		assert.Len(result.Words, 4)
+		assert.Equal(4, result.WordCount)
+		assert.Equal("eff-long", result.Wordlist)
+		assert.InDelta(51.70, result.EntropyBits, 0.01)
+	}
+}
+
+func TestWritePassphraseShowEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase := &diceware.Passphrase{
+		Words:       []string{"correct", "horse", "battery"},
+		Separator:   "-",
+		Wordlist:    "eff-long",
+		EntropyBits: 38.77,
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(writePassphrase(&buffer, passphrase, outputFormat{showEntropy: true}))
+	assert.Equal("correct-horse-battery\t(38.8 bits, 23 seconds to crack offline, fast hash on GPUs)\n", buffer.String())
+
+	buffer.Reset()
+	assert.NoError(writePassphrase(&buffer, passphrase, outputFormat{json: true, showEntropy: true}))
+	assert.Contains(buffer.String(), `"crack_time":"23 seconds"`)
+}
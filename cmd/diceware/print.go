@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// sheetGap represents the spaces placed between the columns of a sheet.
+const sheetGap = 3
+
+var (
+	// errPrintArgs represents the error given when the print subcommand is
+	// given more than one wordlist.
+	errPrintArgs = errors.New("wordlist print accepts the name of a single built-in wordlist")
+	// errInvalidPage represents the error given when the page of a sheet is too
+	// small to hold any words.
+	errInvalidPage = errors.New("invalid page size, -width must be at least 1 and -lines at least 3")
+)
+
+// runPrint returns an int.
+// Implements the logic of the wordlist print subcommand, writing the built-in
+// wordlist of the given name, or the wordlist file given by -list-file, to
+// stdout as a sheet to be printed and used with physical dice, returning the
+// exit code of the command.  Flags are accepted before and after the name.
+func runPrint(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diceware wordlist print", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	width := flags.Int("width", 80, "number of characters on each line of the sheet")
+	lines := flags.Int("lines", 60, "number of lines on each page of the sheet, including its header")
+	listFile := flags.String("list-file", "", "path of a custom wordlist to print")
+
+	positional, err := parseInterspersed(flags, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if len(positional) > 1 {
+		fmt.Fprintf(stderr, "diceware: %v\n", errPrintArgs)
+		return exitUsage
+	}
+
+	name := ""
+	if len(positional) == 1 {
+		name = positional[0]
+	}
+
+	if *width < 1 || *lines < 3 {
+		fmt.Fprintf(stderr, "diceware: %v\n", errInvalidPage)
+		return exitUsage
+	}
+
+	wl, err := selectWordlist(name, *listFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitUsage
+	}
+
+	if err := writeSheet(stdout, wl, *width, *lines); err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	return 0
+}
+
+// writeSheet returns an error.
+// Implements the logic to write the roll value and word of every roll value of
+// the wordlist in columns read from top to bottom, then left to right, fitting
+// as many columns as the width allows.  Each page holds the given number of
+// lines, starting with a header naming the wordlist, its dice and the page,
+// and is separated from the next page by a form feed.
+func writeSheet(w io.Writer, wl diceware.Wordlist, width, lines int) error {
+	rolls := wl.Rolls()
+	sides := int(wl.SidesOfDice().Int64())
+
+	entries := []string{}
+	entryWidth := 0
+	for rollValue, word := range sheetWords(wl) {
+		entry := wordlist.FormatRollValue(rollValue, rolls, sides) + " " + word
+		entries = append(entries, entry)
+		entryWidth = max(entryWidth, utf8.RuneCountInString(entry))
+	}
+
+	name := "wordlist"
+	if named, ok := wl.(diceware.NamedWordlist); ok && named.Name() != "" {
+		name = named.Name()
+	}
+
+	columns := max((width+sheetGap)/(entryWidth+sheetGap), 1)
+	rows := lines - 2
+	perPage := columns * rows
+	pages := max((len(entries)+perPage-1)/perPage, 1)
+
+	buffered := bufio.NewWriter(w)
+	for page := 0; page < pages; page++ {
+		if page > 0 {
+			fmt.Fprint(buffered, "\f")
+		}
+
+		fmt.Fprintf(buffered, "%s, %d dice with %d sides, page %d of %d\n\n", name, rolls, sides, page+1, pages)
+
+		onPage := entries[page*perPage : min((page+1)*perPage, len(entries))]
+		pageRows := (len(onPage) + columns - 1) / columns
+		for row := 0; row < pageRows; row++ {
+			var line strings.Builder
+			for column := 0; column < columns; column++ {
+				index := column*pageRows + row
+				if index >= len(onPage) {
+					break
+				}
+
+				if column > 0 {
+					line.WriteString(strings.Repeat(" ", sheetGap))
+				}
+
+				entry := onPage[index]
+				line.WriteString(entry)
+				line.WriteString(strings.Repeat(" ", entryWidth-utf8.RuneCountInString(entry)))
+			}
+
+			fmt.Fprintln(buffered, strings.TrimRight(line.String(), " "))
+		}
+	}
+
+	return buffered.Flush()
+}
+
+// sheetWords returns an iter.Seq2 of ints and strings.
+// Implements the logic to yield the roll value and word of each roll value of
+// the wordlist with a word, in ascending order of the roll values, through the
+// Words method of wordlists implementing wordlist.Enumerable and otherwise by
+// fetching the word of every roll value.
+func sheetWords(wl diceware.Wordlist) iter.Seq2[int, string] {
+	if enumerable, ok := wl.(wordlist.Enumerable); ok {
+		return enumerable.Words()
+	}
+
+	return func(yield func(int, string) bool) {
+		rolls := wl.Rolls()
+		sides := int(wl.SidesOfDice().Int64())
+
+		total := 1
+		for i := 0; i < rolls; i++ {
+			total *= sides
+		}
+
+		for index := 0; index < total; index++ {
+			rollValue := wordlist.RollValueForIndex(index, rolls, sides)
+			if word := wl.FetchWord(rollValue); word != "" && !yield(rollValue, word) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSheet(t *testing.T) {
+	assert := assert.New(t)
+
+	wl := wordlist.NewNamedMap("tiny", 1, 6, map[int]string{
+		1: "acid", 2: "banjo", 3: "comet", 4: "drum", 5: "ember", 6: "fjord",
+	})
+
+	tests := []struct {
+		Name  string
+		Width int
+		Lines int
+		Value string
+	}{
+		{
+			Name:  "will fit the columns within the width",
+			Width: 27,
+			Lines: 10,
+			Value: "tiny, 1 dice with 6 sides, page 1 of 1\n\n" +
+				"1 acid    3 comet   5 ember\n" +
+				"2 banjo   4 drum    6 fjord\n",
+		}, {
+			Name:  "will read down each column before the next",
+			Width: 17,
+			Lines: 10,
+			Value: "tiny, 1 dice with 6 sides, page 1 of 1\n\n" +
+				"1 acid    4 drum\n" +
+				"2 banjo   5 ember\n" +
+				"3 comet   6 fjord\n",
+		}, {
+			Name:  "will separate the pages with form feeds",
+			Width: 7,
+			Lines: 5,
+			Value: "tiny, 1 dice with 6 sides, page 1 of 2\n\n1 acid\n2 banjo\n3 comet\n" +
+				"\ftiny, 1 dice with 6 sides, page 2 of 2\n\n4 drum\n5 ember\n6 fjord\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buffer bytes.Buffer
+		assert.NoError(writeSheet(&buffer, wl, test.Width, test.Lines), test.Name)
+		assert.Equal(test.Value, buffer.String(), test.Name)
+	}
+}
+
+func TestRunPrint(t *testing.T) {
+	assert := assert.New(t)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(0, run([]string{"wordlist", "print", "eff-long", "-width", "100"}, nil, &stdout, &stderr))
+
+	pages := strings.Split(stdout.String(), "\f")
+	assert.Len(pages, 27)
+	assert.True(strings.HasPrefix(pages[0], "eff-long, 5 dice with 6 sides, page 1 of 27\n\n11111 abacus"))
+	assert.Contains(pages[26], "66666 zoom")
+	for _, page := range pages {
+		lines := strings.Split(strings.TrimSuffix(page, "\n"), "\n")
+		assert.LessOrEqual(len(lines), 60)
+		for _, line := range lines {
+			assert.LessOrEqual(len(line), 100)
+		}
+	}
+
+	stderr.Reset()
+	assert.Equal(exitUsage, run([]string{"wordlist", "print", "-lines", "2"}, nil, &stdout, &stderr))
+	assert.Contains(stderr.String(), errInvalidPage.Error())
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// errManualRequired represents the error given when the roll subcommand is
+	// run without -manual.
+	errManualRequired = errors.New("roll requires -manual, run diceware without roll to use virtual dice")
+	// errRollsEnded represents the error given when stdin ends before the dice
+	// of every word were rolled.
+	errRollsEnded = errors.New("dice results ended before the passphrase was complete")
+)
+
+// runRoll returns an int.
+// Implements the logic of the roll subcommand, prompting on stderr for the
+// results of the physical dice of each word, reading them from stdin and
+// writing the resulting passphrase to stdout, returning the exit code of the
+// command.  Invalid results and results the wordlist rejects are prompted for
+// again.  The words, separator and wordlist of the config are used as the
+// defaults, its capitalization is not applied to physical dice.
+func runRoll(args []string, cfg config, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diceware roll", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	manual := flags.Bool("manual", false, "prompt for the results of physical dice for each word")
+	words := flags.Int("words", cfg.words, "number of words within the passphrase")
+	separator := flags.String("sep", cfg.separator, "separator placed between the words")
+	list, listFile := wordlistFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if !*manual {
+		fmt.Fprintf(stderr, "diceware: %v\n", errManualRequired)
+		return exitUsage
+	}
+
+	wl, err := selectWordlist(configuredList(*list, *listFile, cfg), *listFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitUsage
+	}
+
+	opts := diceware.PassphraseOptions{
+		WordCount: *words,
+		Separator: *separator,
+		Wordlist:  wl,
+	}
+
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitUsage
+	}
+
+	phrase, err := promptWords(stdin, stderr, wl, *words)
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	fmt.Fprintln(stdout, strings.Join(phrase, *separator))
+
+	return 0
+}
+
+// promptWords returns a slice of strings.
+// Implements the logic to prompt on stderr for the results of the dice of each
+// of the given number of words, reading them from stdin until every word is
+// rolled, prompting again for invalid or rejected results.
+func promptWords(stdin io.Reader, stderr io.Writer, wl diceware.Wordlist, words int) ([]string, error) {
+	scanner := bufio.NewScanner(stdin)
+	phrase := make([]string, 0, words)
+	for len(phrase) < words {
+		fmt.Fprintf(stderr, "word %d of %d, roll %d dice with %d sides: ",
+			len(phrase)+1, words, wl.Rolls(), wl.SidesOfDice().Int64())
+
+		if !scanner.Scan() {
+			fmt.Fprintln(stderr)
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+
+			return nil, errRollsEnded
+		}
+
+		rolls, err := parseRolls(scanner.Text(), wl)
+		if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v, try again\n", err)
+			continue
+		}
+
+		word, err := diceware.WordFromDiceRolls(wl, rolls)
+		if errors.Is(err, wordlist.ErrRollRejected) {
+			fmt.Fprintln(stderr, "diceware: no word for these dice, roll them again")
+			continue
+		} else if err != nil {
+			fmt.Fprintf(stderr, "diceware: %v, try again\n", err)
+			continue
+		}
+
+		phrase = append(phrase, word)
+	}
+
+	return phrase, nil
+}
+
+// parseRolls returns a slice of ints.
+// Implements the logic to read the results of the dice from a line typed by
+// the user, separated by whitespace, e.g. "3 1 2 4 6".  When every die has
+// fewer than 10 sides the results are also able to be typed together, e.g.
+// "31246".
+func parseRolls(line string, wl diceware.Wordlist) ([]int, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 1 && wl.Rolls() > 1 && wl.SidesOfDice().Int64() < 10 {
+		fields = strings.Split(fields[0], "")
+	}
+
+	rolls := make([]int, 0, len(fields))
+	for _, field := range fields {
+		roll, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not a number", diceware.ErrInvalidDiceRoll, field)
+		}
+
+		rolls = append(rolls, roll)
+	}
+
+	return rolls, nil
+}
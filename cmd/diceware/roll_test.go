@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRoll(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name   string
+		Args   []string
+		Input  string
+		Stdout string
+		Code   int
+		Stderr string
+	}{
+		{
+			Name:   "will build the passphrase from the typed dice results",
+			Args:   []string{"roll", "-manual", "-words", "2", "-list", "eff-long"},
+			Input:  "1 1 1 1 1\n6 6 6 6 6\n",
+			Stdout: wordlist.EFFLong.FetchWord(11111) + " " + wordlist.EFFLong.FetchWord(66666) + "\n",
+			Stderr: "word 2 of 2, roll 5 dice with 6 sides: ",
+		}, {
+			Name:   "will accept dice results typed together",
+			Args:   []string{"roll", "--manual", "--words=1", "--sep=-"},
+			Input:  "31246\n",
+			Stdout: wordlist.EFFLong.FetchWord(31246) + "\n",
+		}, {
+			Name:   "will prompt again for invalid dice results",
+			Args:   []string{"roll", "-manual", "-words", "1"},
+			Input:  "1 1 1\n1 1 1 1 7\n1 a 1 1 1\n2 2 2 2 2\n",
+			Stdout: wordlist.EFFLong.FetchWord(22222) + "\n",
+			Stderr: "invalid dice roll given",
+		}, {
+			Name:   "will exit with a failure when the dice results end early",
+			Args:   []string{"roll", "-manual", "-words", "3"},
+			Input:  "1 1 1 1 1\n",
+			Code:   exitFailure,
+			Stderr: "dice results ended",
+		}, {
+			Name:   "will exit with a usage error without -manual",
+			Args:   []string{"roll"},
+			Code:   exitUsage,
+			Stderr: "roll requires -manual",
+		}, {
+			Name:   "will exit with a usage error for an invalid word count",
+			Args:   []string{"roll", "-manual", "-words", "0"},
+			Code:   exitUsage,
+			Stderr: "invalid word count",
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, strings.NewReader(test.Input), &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Equal(test.Stdout, stdout.String(), test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+	}
+}
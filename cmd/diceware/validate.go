@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// errValidateArgs represents the error given when the validate subcommand is
+// not given exactly one file.
+var errValidateArgs = errors.New("wordlist validate requires the path of a single wordlist file")
+
+// runValidate returns an int.
+// Implements the logic of the wordlist validate subcommand, loading the
+// wordlist file given as its argument and writing a report of its duplicated
+// words, words containing whitespace, prefix violations, words closer than
+// the minimum edit distance and offensive words to stdout, returning the exit
+// code of the command.  Only duplicated words and words containing whitespace
+// fail the validation, unless -strict is given.
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diceware wordlist validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	minDistance := flags.Int("min-distance", wordlist.EFFMinEditDistance,
+		"minimum edit distance between any 2 words, 0 skips the check")
+	strict := flags.Bool("strict", false, "fail the validation for prefix violations, similar and offensive words")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if flags.NArg() != 1 {
+		fmt.Fprintf(stderr, "diceware: %v\n", errValidateArgs)
+		return exitUsage
+	}
+
+	wl, err := wordlist.LoadFile(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", err)
+		return exitFailure
+	}
+
+	w := &reportWriter{w: stdout}
+	valid := writeValidation(w, wl, *minDistance, *strict)
+	if valid {
+		w.printf("result:\tvalid\n")
+	} else {
+		w.printf("result:\tinvalid\n")
+	}
+
+	if w.err != nil {
+		fmt.Fprintf(stderr, "diceware: %v\n", w.err)
+		return exitFailure
+	}
+
+	if !valid {
+		return exitFailure
+	}
+
+	return 0
+}
+
+// writeValidation returns a bool.
+// Implements the logic to write each section of the report on the wordlist,
+// reporting whether it passed the validation.
+func writeValidation(w *reportWriter, wl *wordlist.Map, minDistance int, strict bool) bool {
+	report := wordlist.Validate(wl)
+	w.printf("wordlist:\t%s (%d words, %d dice with %d sides, %.2f bits each)\n",
+		wl.Name(), report.Words, wl.Rolls(), wl.SidesOfDice().Int64(), diceware.WordEntropy(wl))
+
+	writeDuplicates(w, report)
+
+	w.printf("whitespace:\t%d\n", len(report.Whitespace))
+	for _, rollValue := range report.Whitespace {
+		w.printf("\t%q at %d\n", wl.FetchWord(rollValue), rollValue)
+	}
+
+	violations := wordlist.PrefixViolations(wl)
+	w.printf("prefixes:\t%d\n", len(violations))
+	for _, violation := range violations {
+		w.printf("\t%q is a prefix of %q\n", violation.Prefix, violation.Word)
+	}
+
+	similar := writeSimilar(w, wl, minDistance)
+	offensive := writeOffensive(w, wl)
+
+	if strict {
+		return report.Valid() && len(violations) == 0 && similar == 0 && offensive == 0
+	}
+
+	return report.Valid()
+}
+
+// writeDuplicates implements the logic to write the duplicated words of the
+// report in ascending order, along with their roll values.
+func writeDuplicates(w *reportWriter, report wordlist.Report) {
+	duplicates := make([]string, 0, len(report.Duplicates))
+	for word := range report.Duplicates {
+		duplicates = append(duplicates, word)
+	}
+
+	sort.Strings(duplicates)
+
+	w.printf("duplicates:\t%d\n", len(duplicates))
+	for _, word := range duplicates {
+		w.printf("\t%q at %s\n", word, joinRollValues(report.Duplicates[word]))
+	}
+}
+
+// writeSimilar returns an int.
+// Implements the logic to write the pairs of words closer than the minimum
+// edit distance, skipping the check when it is not positive, returning the
+// number of pairs.
+func writeSimilar(w *reportWriter, wl *wordlist.Map, minDistance int) int {
+	if minDistance <= 0 {
+		return 0
+	}
+
+	similar := wordlist.SimilarWords(wl, minDistance)
+	w.printf("similar:\t%d below an edit distance of %d\n", len(similar), minDistance)
+	for _, pair := range similar {
+		w.printf("\t%q and %q (%d)\n", pair.First, pair.Second, pair.Distance)
+	}
+
+	return len(similar)
+}
+
+// writeOffensive returns an int.
+// Implements the logic to write the offensive words of the wordlist, returning
+// the number of them.
+func writeOffensive(w *reportWriter, wl *wordlist.Map) int {
+	offensive := []string{}
+	for _, word := range wl.Words() {
+		if wordlist.IsOffensive(word) {
+			offensive = append(offensive, word)
+		}
+	}
+
+	w.printf("offensive:\t%d\n", len(offensive))
+	for _, word := range offensive {
+		w.printf("\t%q\n", word)
+	}
+
+	return len(offensive)
+}
+
+// reportWriter defines the destination of a report, keeping the first failure
+// of its writes so that it is only checked once the report is written.
+type reportWriter struct {
+	// w represents the writer the report is written to.
+	w io.Writer
+
+	// err represents the first failure of the writes.
+	err error
+}
+
+// printf implements the logic to write the formatted text to the writer, doing
+// nothing once a write has failed.
+func (rw *reportWriter) printf(format string, args ...any) {
+	if rw.err == nil {
+		_, rw.err = fmt.Fprintf(rw.w, format, args...)
+	}
+}
+
+// joinRollValues returns a string.
+// Implements the logic to list the roll values separated by commas.
+func joinRollValues(rollValues []int) string {
+	values := make([]string, 0, len(rollValues))
+	for _, rollValue := range rollValues {
+		values = append(values, fmt.Sprint(rollValue))
+	}
+
+	return strings.Join(values, ", ")
+}
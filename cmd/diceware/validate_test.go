@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	clean := filepath.Join(dir, "clean.txt")
+	assert.NoError(os.WriteFile(clean, []byte("1\tacid\n2\tbanjo\n3\tcomet\n4\tdrum\n5\tember\n6\tfjord\n"), 0o600))
+	flawed := filepath.Join(dir, "flawed.txt")
+	assert.NoError(os.WriteFile(flawed, []byte("1\tcat\n2\tcar\n3\tcart\n4\tcat\n5\tdog\n6\thell\n"), 0o600))
+	incomplete := filepath.Join(dir, "incomplete.txt")
+	assert.NoError(os.WriteFile(incomplete, []byte("1\tcat\n3\tdog\n"), 0o600))
+
+	tests := []struct {
+		Name   string
+		Args   []string
+		Stdout []string
+		Code   int
+		Stderr string
+	}{
+		{
+			Name: "will report a valid wordlist",
+			Args: []string{"wordlist", "validate", clean},
+			Stdout: []string{
+				"wordlist:\tclean (6 words, 1 dice with 6 sides, 2.58 bits each)\n",
+				"duplicates:\t0\n", "prefixes:\t0\n", "similar:\t0 below an edit distance of 3\n",
+				"result:\tvalid\n",
+			},
+		}, {
+			Name: "will report the problems of a wordlist",
+			Args: []string{"wordlist", "validate", "-min-distance", "2", flawed},
+			Stdout: []string{
+				"duplicates:\t1\n\t\"cat\" at 1, 4\n",
+				"prefixes:\t1\n\t\"car\" is a prefix of \"cart\"\n",
+				"similar:\t3 below an edit distance of 2\n\t\"car\" and \"cart\" (1)\n",
+				"offensive:\t1\n\t\"hell\"\n",
+				"result:\tinvalid\n",
+			},
+			Code: exitFailure,
+		}, {
+			Name:   "will only fail the warnings with -strict",
+			Args:   []string{"wordlist", "validate", "-strict", "-min-distance", "0", clean},
+			Stdout: []string{"result:\tvalid\n"},
+		}, {
+			Name:   "will exit with a failure for a wordlist that is unable to be loaded",
+			Args:   []string{"wordlist", "validate", incomplete},
+			Code:   exitFailure,
+			Stderr: "wordlist is missing words",
+		}, {
+			Name:   "will exit with a usage error without a file",
+			Args:   []string{"wordlist", "validate"},
+			Code:   exitUsage,
+			Stderr: errValidateArgs.Error(),
+		}, {
+			Name:   "will exit with a usage error for an unknown wordlist command",
+			Args:   []string{"wordlist", "shuffle"},
+			Code:   exitUsage,
+			Stderr: "unknown wordlist command \"shuffle\", choose one of: validate, fetch, print",
+		},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(test.Args, nil, &stdout, &stderr)
+
+		assert.Equal(test.Code, code, test.Name)
+		assert.Contains(stderr.String(), test.Stderr, test.Name)
+		for _, report := range test.Stdout {
+			assert.Contains(stdout.String(), report, test.Name)
+		}
+	}
+}
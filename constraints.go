@@ -0,0 +1,106 @@
+package diceware
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoWordsAvailable represents the error given when none of the words within
+// the wordlist satisfy the configured word constraints
+var ErrNoWordsAvailable = errors.New("no words within the wordlist satisfy the word constraints")
+
+// wordConstraints defines the restrictions on which words can be rolled,
+// prepared once from the PassphraseOptions for every word that is checked.
+type wordConstraints struct {
+	// minLength represents the minimum number of characters of a word.
+	minLength int
+
+	// maxLength represents the maximum number of characters of a word.
+	maxLength int
+
+	// excluded represents the set of words that must be re-rolled.
+	excluded map[string]bool
+
+	// exclude represents the predicate of words that must be re-rolled.
+	exclude func(string) bool
+}
+
+// wordConstraints returns a wordConstraints.
+// Implements the logic to prepare the word constraints of the options, which
+// is nil when no constraints restrict the words that can be rolled.
+func (opts PassphraseOptions) wordConstraints() *wordConstraints {
+	if opts.MinWordLength <= 0 && opts.MaxWordLength <= 0 &&
+		len(opts.ExcludeWords) == 0 && opts.ExcludeFunc == nil {
+		return nil
+	}
+
+	constraints := &wordConstraints{
+		minLength: opts.MinWordLength,
+		maxLength: opts.MaxWordLength,
+		excluded:  make(map[string]bool, len(opts.ExcludeWords)),
+		exclude:   opts.ExcludeFunc,
+	}
+
+	for _, word := range opts.ExcludeWords {
+		constraints.excluded[word] = true
+	}
+
+	return constraints
+}
+
+// accepts returns a bool.
+// Implements the logic to verify that the word satisfies each of the word
+// constraints, measuring the length in user perceived characters rather than
+// bytes.  A nil wordConstraints accepts every word.
+func (c *wordConstraints) accepts(word string) bool {
+	if c == nil {
+		return true
+	}
+
+	length := characterCount(word)
+	if c.minLength > 0 && length < c.minLength {
+		return false
+	}
+
+	if c.maxLength > 0 && length > c.maxLength {
+		return false
+	}
+
+	if c.excluded[word] {
+		return false
+	}
+
+	return c.exclude == nil || !c.exclude(word)
+}
+
+// countAccepted returns an int.
+// Implements the logic to count the words within the wordlist that satisfy
+// each of the word constraints.
+func (c *wordConstraints) countAccepted(wl Wordlist) int {
+	accepted := 0
+	forEachRoll(wl, func(rollValue int) bool {
+		if word := wl.FetchWord(rollValue); word != "" && c.accepts(word) {
+			accepted++
+		}
+
+		return true
+	})
+
+	return accepted
+}
+
+// rollAcceptedWord returns a string and an int.
+// Implements the logic to re-roll words until one satisfies each of the word
+// constraints, returning both the word and the roll value.
+func rollAcceptedWord(ctx context.Context, rs RandomSource, wl Wordlist, c *wordConstraints) (string, int, error) {
+	for {
+		word, rollValue, err := rollWord(ctx, rs, wl)
+		if err != nil {
+			return "", 0, err
+		}
+
+		if c.accepts(word) {
+			return word, rollValue, nil
+		}
+	}
+}
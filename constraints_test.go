@@ -0,0 +1,160 @@
+package diceware_test
+
+import (
+	"math"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordLength(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(2, 2, map[int]string{
+		11: "ox",
+		12: "cat",
+		21: "horse",
+		22: "battery",
+	})
+
+	tests := []struct {
+		Name      string
+		MinLength int
+		MaxLength int
+		Error     error
+		Accepted  int
+	}{
+		{
+			Name:      "will error with a negative length",
+			MinLength: -1,
+			Error:     diceware.ErrInvalidWordLength,
+		}, {
+			Name:      "will error when the minimum exceeds the maximum",
+			MinLength: 5,
+			MaxLength: 3,
+			Error:     diceware.ErrInvalidWordLength,
+		}, {
+			Name:      "will error when no words satisfy the constraints",
+			MinLength: 8,
+			Error:     diceware.ErrNoWordsAvailable,
+		}, {
+			Name:      "will only roll words meeting the minimum length",
+			MinLength: 4,
+			Accepted:  2,
+		}, {
+			Name:      "will only roll words meeting the maximum length",
+			MaxLength: 3,
+			Accepted:  2,
+		}, {
+			Name:      "will only roll words within both lengths",
+			MinLength: 3,
+			MaxLength: 5,
+			Accepted:  2,
+		}, {
+			Name:      "will roll a single accepted word",
+			MinLength: 7,
+			MaxLength: 7,
+			Accepted:  1,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:     20,
+			Separator:     " ",
+			Wordlist:      words,
+			MinWordLength: test.MinLength,
+			MaxWordLength: test.MaxLength,
+		})
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.InDelta(20*math.Log2(float64(test.Accepted)), passphrase.EntropyBits, 0.0001, test.Name)
+		for _, word := range passphrase.Words {
+			length := utf8.RuneCountInString(word)
+			assert.GreaterOrEqual(length, test.MinLength, test.Name)
+			if test.MaxLength > 0 {
+				assert.LessOrEqual(length, test.MaxLength, test.Name)
+			}
+		}
+	}
+}
+
+func TestExcludeWords(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(2, 2, map[int]string{
+		11: "ox",
+		12: "cat",
+		21: "horse",
+		22: "battery",
+	})
+
+	tests := []struct {
+		Name     string
+		Words    []string
+		Func     func(string) bool
+		Error    error
+		Allowed  []string
+		Accepted int
+	}{
+		{
+			Name:     "will re-roll the excluded words",
+			Words:    []string{"cat", "battery"},
+			Allowed:  []string{"ox", "horse"},
+			Accepted: 2,
+		}, {
+			Name:     "will ignore excluded words missing from the wordlist",
+			Words:    []string{"dog"},
+			Allowed:  []string{"ox", "cat", "horse", "battery"},
+			Accepted: 4,
+		}, {
+			Name:     "will re-roll the words matching the predicate",
+			Func:     func(word string) bool { return word[0] == 'b' || word[0] == 'o' },
+			Allowed:  []string{"cat", "horse"},
+			Accepted: 2,
+		}, {
+			Name:     "will combine the excluded words and the predicate",
+			Words:    []string{"cat"},
+			Func:     func(word string) bool { return word == "horse" },
+			Allowed:  []string{"ox", "battery"},
+			Accepted: 2,
+		}, {
+			Name:  "will error when every word is excluded",
+			Words: []string{"ox", "cat", "horse", "battery"},
+			Error: diceware.ErrNoWordsAvailable,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:    20,
+			Separator:    " ",
+			Wordlist:     words,
+			ExcludeWords: test.Words,
+			ExcludeFunc:  test.Func,
+		})
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.InDelta(20*math.Log2(float64(test.Accepted)), passphrase.EntropyBits, 0.0001, test.Name)
+		for _, word := range passphrase.Words {
+			assert.Contains(test.Allowed, word, test.Name)
+		}
+	}
+}
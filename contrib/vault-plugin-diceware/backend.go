@@ -0,0 +1,61 @@
+// Package dicewareplugin implements a HashiCorp Vault secrets engine returning
+// diceware passphrases, generated with the policy of a role.
+//
+// Roles are written to roles/<name> with the number of words, the built-in
+// wordlist, the separator, the capitalization, the minimum entropy and whether
+// the entropy is enhanced, and a passphrase is read from gen/<name>.  The
+// passphrases are generated on request and never stored by the engine.
+//
+// It is its own module, keeping the Vault SDK out of the dependencies of the
+// diceware module itself.
+package dicewareplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// backendHelp represents the description of the secrets engine shown by
+// `vault path-help`.
+const backendHelp = `
+The diceware secrets engine generates diceware passphrases with the policy of
+a role, which sets the number of words, the wordlist, the separator and the
+capitalization of the passphrases.  Passphrases are not stored.
+`
+
+// backend defines the Vault secrets engine serving the roles and their
+// passphrases.
+type backend struct {
+	*framework.Backend
+}
+
+// Factory returns a logical.Backend.
+// It implements the logic for the logical.Factory type which creates the
+// secrets engine and sets it up with the given configuration, given to
+// `plugin.ServeMultiplex` to serve the engine as a plugin.
+func Factory(ctx context.Context, conf *logical.BackendConfig) (logical.Backend, error) {
+	b := newBackend()
+	if err := b.Setup(ctx, conf); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// newBackend returns an initialized backend object.
+func newBackend() *backend {
+	b := &backend{}
+	b.Backend = &framework.Backend{
+		Help:        strings.TrimSpace(backendHelp),
+		BackendType: logical.TypeLogical,
+		Paths: framework.PathAppend(
+			b.pathRoles(),
+			[]*framework.Path{b.pathGenerate()},
+		),
+	}
+
+	return b
+}
@@ -0,0 +1,56 @@
+package dicewareplugin_test
+
+import (
+	"context"
+	"testing"
+
+	dicewareplugin "github.com/everlastingbeta/diceware/contrib/vault-plugin-diceware"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBackend returns a logical.Backend and its logical.Storage.
+// Implements the logic to create the secrets engine with in memory storage.
+func newBackend(t *testing.T) (logical.Backend, logical.Storage) {
+	t.Helper()
+
+	b, err := dicewareplugin.Factory(context.Background(), logical.TestBackendConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b, &logical.InmemStorage{}
+}
+
+// request returns a *logical.Response.
+// Implements the logic to handle a request of the operation to the path of the
+// secrets engine with the given data.
+func request(
+	t *testing.T, b logical.Backend, storage logical.Storage, operation logical.Operation, path string,
+	data map[string]any,
+) (*logical.Response, error) {
+	t.Helper()
+
+	return b.HandleRequest(context.Background(), &logical.Request{
+		Operation: operation,
+		Path:      path,
+		Data:      data,
+		Storage:   storage,
+	})
+}
+
+func TestFactory(t *testing.T) {
+	assert := assert.New(t)
+
+	b, err := dicewareplugin.Factory(context.Background(), logical.TestBackendConfig())
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal(logical.TypeLogical, b.Type())
+
+	response, err := request(t, b, &logical.InmemStorage{}, logical.ListOperation, "roles/", nil)
+	if assert.NoError(err) {
+		assert.Empty(response.Data["keys"])
+	}
+}
@@ -0,0 +1,21 @@
+// Command vault-plugin-diceware serves the diceware secrets engine as a
+// HashiCorp Vault plugin, which is registered and enabled with e.g.
+//
+//	vault plugin register -sha256=<sha256> secret vault-plugin-diceware
+//	vault secrets enable -path=diceware vault-plugin-diceware
+package main
+
+import (
+	"os"
+
+	dicewareplugin "github.com/everlastingbeta/diceware/contrib/vault-plugin-diceware"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/plugin"
+)
+
+func main() {
+	if err := plugin.ServeMultiplex(&plugin.ServeOpts{BackendFactoryFunc: dicewareplugin.Factory}); err != nil {
+		hclog.New(&hclog.LoggerOptions{}).Error("plugin shutting down", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+package dicewareplugin
+
+import (
+	"context"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// pathGenerate returns a *framework.Path.
+// Implements the logic to describe the path generating a passphrase with the
+// policy of a role.
+func (b *backend) pathGenerate() *framework.Path {
+	return &framework.Path{
+		Pattern: "gen/" + framework.GenericNameRegex("name"),
+		Fields: map[string]*framework.FieldSchema{
+			"name": {
+				Type:        framework.TypeString,
+				Description: "Name of the role.",
+				Required:    true,
+			},
+		},
+		Operations: map[logical.Operation]framework.OperationHandler{
+			logical.ReadOperation: &framework.PathOperation{Callback: b.pathGenerateRead},
+		},
+		HelpSynopsis:    "Generate a passphrase with the policy of a role.",
+		HelpDescription: "Generates a diceware passphrase, which is not stored, with the policy of the role.",
+	}
+}
+
+// pathGenerateRead returns a *logical.Response.
+// Implements the logic to generate a passphrase with the policy of the role,
+// giving the passphrase along with its words, wordlist and entropy.
+func (b *backend) pathGenerateRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (
+	*logical.Response, error,
+) {
+	name := data.Get("name").(string)
+
+	r, err := getRole(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return logical.ErrorResponse("unknown role %q", name), nil
+	}
+
+	opts, err := r.options()
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), nil
+	}
+
+	passphrase, err := diceware.GeneratePassphraseContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logical.Response{
+		Data: map[string]any{
+			"passphrase":   passphrase.String(),
+			"words":        passphrase.Words,
+			"wordlist":     passphrase.Wordlist,
+			"entropy_bits": passphrase.EntropyBits,
+		},
+	}, nil
+}
@@ -0,0 +1,100 @@
+package dicewareplugin_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	b, storage := newBackend(t)
+
+	roles := map[string]map[string]any{
+		"default": nil,
+		"short":   {"words": 4, "wordlist": "eff-short", "separator": "-", "capitalization": "title"},
+		"strong":  {"words": 1, "min_entropy_bits": 100},
+	}
+
+	for name, data := range roles {
+		_, err := request(t, b, storage, logical.CreateOperation, "roles/"+name, data)
+		if !assert.NoError(err, name) {
+			return
+		}
+	}
+
+	tests := []struct {
+		Name     string
+		Role     string
+		Words    int
+		Sep      string
+		Wordlist *wordlist.Map
+		Title    bool
+		Error    string
+	}{
+		{
+			Name:     "will generate a passphrase with the defaults",
+			Role:     "default",
+			Words:    6,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will generate a passphrase with the policy of the role",
+			Role:     "short",
+			Words:    4,
+			Sep:      "-",
+			Wordlist: wordlist.EFFShort,
+			Title:    true,
+		}, {
+			Name:     "will raise the words to the minimum entropy of the role",
+			Role:     "strong",
+			Words:    8,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:  "will reject an unknown role",
+			Role:  "unknown",
+			Error: `unknown role "unknown"`,
+		},
+	}
+
+	for _, test := range tests {
+		response, err := request(t, b, storage, logical.ReadOperation, "gen/"+test.Role, nil)
+		if test.Error != "" {
+			if assert.True(response.IsError(), test.Name) {
+				assert.EqualError(response.Error(), test.Error, test.Name)
+			}
+
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) || !assert.NotNil(response, test.Name) {
+			continue
+		}
+
+		words, _ := response.Data["words"].([]string)
+		assert.Len(words, test.Words, test.Name)
+		assert.Equal(strings.Join(words, test.Sep), response.Data["passphrase"], test.Name)
+		assert.Equal(test.Wordlist.Name(), response.Data["wordlist"], test.Name)
+		assert.InDelta(float64(test.Words)*diceware.WordEntropy(test.Wordlist), response.Data["entropy_bits"], 0.01,
+			test.Name)
+
+		for _, word := range words {
+			if test.Title {
+				first, _ := utf8.DecodeRuneInString(word)
+				assert.True(unicode.IsUpper(first), test.Name)
+				word = strings.ToLower(word)
+			}
+
+			_, found := wordlist.Index(test.Wordlist).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
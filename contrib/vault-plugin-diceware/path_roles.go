@@ -0,0 +1,314 @@
+package dicewareplugin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// rolePrefix represents the prefix of the storage key of every role.
+const rolePrefix = "roles/"
+
+// capitalizations represents the name of each capitalization mode a role is
+// able to use.
+var capitalizations = map[string]diceware.Capitalization{
+	"none":          diceware.CapitalizationNone,
+	"title":         diceware.CapitalizationTitleCase,
+	"upper":         diceware.CapitalizationAllUpper,
+	"random-word":   diceware.CapitalizationRandomWord,
+	"random-letter": diceware.CapitalizationRandomLetter,
+}
+
+// role defines the policy the passphrases of a role are generated with.
+type role struct {
+	// Words represents the number of words within the passphrases.
+	Words int `json:"words"`
+
+	// Wordlist represents the name of the built-in wordlist the words are
+	// rolled from.
+	Wordlist string `json:"wordlist"`
+
+	// Separator represents the character(s) placed between the words.
+	Separator string `json:"separator"`
+
+	// Capitalization represents the name of the capitalization mode of the
+	// words.
+	Capitalization string `json:"capitalization"`
+
+	// MinEntropyBits represents the minimum entropy in bits of the
+	// passphrases, raising the number of words when it is not met.
+	MinEntropyBits float64 `json:"min_entropy_bits"`
+
+	// EnhanceEntropy represents whether a random character is added within
+	// the passphrases.
+	EnhanceEntropy bool `json:"enhance_entropy"`
+}
+
+// defaultRole returns an initialized role object.
+// Implements the logic to give the policy of a role before any of its fields
+// are written, matching the defaults of `diceware.New`.
+func defaultRole() *role {
+	return &role{
+		Words:          diceware.DefaultWordCount,
+		Wordlist:       wordlist.EFFLong.Name(),
+		Separator:      diceware.DefaultSeparator,
+		Capitalization: "none",
+	}
+}
+
+// options returns a diceware.PassphraseOptions.
+// Implements the logic to convert the policy of the role into the options of
+// its passphrases, failing for an unknown wordlist or capitalization mode.
+func (r *role) options() (diceware.PassphraseOptions, error) {
+	if r.Words < 1 || r.Words > diceware.MaxOverrideWords {
+		return diceware.PassphraseOptions{}, fmt.Errorf("words must be between 1 and %d", diceware.MaxOverrideWords)
+	}
+
+	wl, err := wordlist.Get(r.Wordlist)
+	if err != nil {
+		return diceware.PassphraseOptions{}, fmt.Errorf("wordlist must be one of: %s",
+			strings.Join(wordlist.Names(), ", "))
+	}
+
+	mode, found := capitalizations[r.Capitalization]
+	if !found {
+		return diceware.PassphraseOptions{}, fmt.Errorf("capitalization must be one of: %s",
+			strings.Join(capitalizationNames(), ", "))
+	}
+
+	return diceware.PassphraseOptions{
+		WordCount:      r.Words,
+		Separator:      r.Separator,
+		Wordlist:       wl,
+		Capitalization: mode,
+		MinEntropyBits: r.MinEntropyBits,
+		EnhanceEntropy: r.EnhanceEntropy,
+	}, nil
+}
+
+// capitalizationNames returns a slice of strings.
+// Implements the logic to list the name of every capitalization mode, in
+// alphabetical order.
+func capitalizationNames() []string {
+	names := make([]string, 0, len(capitalizations))
+	for name := range capitalizations {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// pathRoles returns a slice of *framework.Path.
+// Implements the logic to describe the paths listing, reading, writing and
+// deleting the roles.
+func (b *backend) pathRoles() []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "roles/?$",
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ListOperation: &framework.PathOperation{Callback: b.pathRoleList},
+			},
+			HelpSynopsis:    "List the roles.",
+			HelpDescription: "Lists the name of every role passphrases are able to be generated for.",
+		},
+		{
+			Pattern:        "roles/" + framework.GenericNameRegex("name"),
+			Fields:         roleFields(),
+			ExistenceCheck: b.pathRoleExistenceCheck,
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation:   &framework.PathOperation{Callback: b.pathRoleRead},
+				logical.CreateOperation: &framework.PathOperation{Callback: b.pathRoleWrite},
+				logical.UpdateOperation: &framework.PathOperation{Callback: b.pathRoleWrite},
+				logical.DeleteOperation: &framework.PathOperation{Callback: b.pathRoleDelete},
+			},
+			HelpSynopsis: "Manage the policy of a role.",
+			HelpDescription: "Sets the number of words, the wordlist, the separator, the capitalization, " +
+				"the minimum entropy and whether the entropy is enhanced for the passphrases of the role.  " +
+				"Fields which are not given keep their current value.",
+		},
+	}
+}
+
+// roleFields returns a map of *framework.FieldSchema.
+// Implements the logic to describe the fields of a role, along with the
+// default of each.
+func roleFields() map[string]*framework.FieldSchema {
+	return map[string]*framework.FieldSchema{
+		"name": {
+			Type:        framework.TypeString,
+			Description: "Name of the role.",
+			Required:    true,
+		},
+		"words": {
+			Type:        framework.TypeInt,
+			Description: fmt.Sprintf("Number of words within the passphrases, between 1 and %d.", diceware.MaxOverrideWords),
+			Default:     diceware.DefaultWordCount,
+		},
+		"wordlist": {
+			Type:        framework.TypeString,
+			Description: "Name of the built-in wordlist the words are rolled from.",
+			Default:     wordlist.EFFLong.Name(),
+		},
+		"separator": {
+			Type:        framework.TypeString,
+			Description: "Character(s) placed between the words.",
+			Default:     diceware.DefaultSeparator,
+		},
+		"capitalization": {
+			Type: framework.TypeString,
+			Description: "Capitalization of the words, one of: " +
+				strings.Join(capitalizationNames(), ", ") + ".",
+			Default: "none",
+		},
+		"min_entropy_bits": {
+			Type:        framework.TypeFloat,
+			Description: "Minimum entropy in bits of the passphrases, raising the number of words when it is not met.",
+		},
+		"enhance_entropy": {
+			Type:        framework.TypeBool,
+			Description: "Whether a random character is added within the passphrases.",
+		},
+	}
+}
+
+// getRole returns a role.
+// Implements the logic to read the role of the given name from the storage,
+// returning nil when it does not exist.
+func getRole(ctx context.Context, storage logical.Storage, name string) (*role, error) {
+	entry, err := storage.Get(ctx, rolePrefix+name)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	r := &role{}
+	if err := entry.DecodeJSON(r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// pathRoleExistenceCheck returns a bool.
+// Implements the logic to check whether the role of the request exists, so
+// that a write is routed as a create or an update.
+func (b *backend) pathRoleExistenceCheck(
+	ctx context.Context, req *logical.Request, data *framework.FieldData,
+) (bool, error) {
+	r, err := getRole(ctx, req.Storage, data.Get("name").(string))
+	if err != nil {
+		return false, err
+	}
+
+	return r != nil, nil
+}
+
+// pathRoleList returns a *logical.Response.
+// Implements the logic to list the name of every role.
+func (b *backend) pathRoleList(ctx context.Context, req *logical.Request, _ *framework.FieldData) (
+	*logical.Response, error,
+) {
+	names, err := req.Storage.List(ctx, rolePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return logical.ListResponse(names), nil
+}
+
+// pathRoleRead returns a *logical.Response.
+// Implements the logic to give the policy of the role, or nothing when it does
+// not exist.
+func (b *backend) pathRoleRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (
+	*logical.Response, error,
+) {
+	r, err := getRole(ctx, req.Storage, data.Get("name").(string))
+	if err != nil || r == nil {
+		return nil, err
+	}
+
+	return &logical.Response{
+		Data: map[string]any{
+			"words":            r.Words,
+			"wordlist":         r.Wordlist,
+			"separator":        r.Separator,
+			"capitalization":   r.Capitalization,
+			"min_entropy_bits": r.MinEntropyBits,
+			"enhance_entropy":  r.EnhanceEntropy,
+		},
+	}, nil
+}
+
+// pathRoleWrite returns a *logical.Response.
+// Implements the logic to create or update the role with the given fields,
+// keeping the current value of every field that is not given.  The role is
+// only stored when passphrases are able to be generated with its policy.
+func (b *backend) pathRoleWrite(ctx context.Context, req *logical.Request, data *framework.FieldData) (
+	*logical.Response, error,
+) {
+	name := data.Get("name").(string)
+
+	r, err := getRole(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		r = defaultRole()
+	}
+
+	if words, ok := data.GetOk("words"); ok {
+		r.Words = words.(int)
+	}
+
+	if wl, ok := data.GetOk("wordlist"); ok {
+		r.Wordlist = wl.(string)
+	}
+
+	if separator, ok := data.GetOk("separator"); ok {
+		r.Separator = separator.(string)
+	}
+
+	if capitalization, ok := data.GetOk("capitalization"); ok {
+		r.Capitalization = capitalization.(string)
+	}
+
+	if minEntropyBits, ok := data.GetOk("min_entropy_bits"); ok {
+		r.MinEntropyBits = minEntropyBits.(float64)
+	}
+
+	if enhanceEntropy, ok := data.GetOk("enhance_entropy"); ok {
+		r.EnhanceEntropy = enhanceEntropy.(bool)
+	}
+
+	opts, err := r.options()
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), nil
+	}
+
+	if _, err := diceware.NewGenerator(opts); err != nil {
+		return logical.ErrorResponse(err.Error()), nil
+	}
+
+	entry, err := logical.StorageEntryJSON(rolePrefix+name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, req.Storage.Put(ctx, entry)
+}
+
+// pathRoleDelete returns a *logical.Response.
+// Implements the logic to remove the role.
+func (b *backend) pathRoleDelete(ctx context.Context, req *logical.Request, data *framework.FieldData) (
+	*logical.Response, error,
+) {
+	return nil, req.Storage.Delete(ctx, rolePrefix+data.Get("name").(string))
+}
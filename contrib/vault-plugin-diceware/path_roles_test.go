@@ -0,0 +1,134 @@
+package dicewareplugin_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathRoles(t *testing.T) {
+	assert := assert.New(t)
+
+	b, storage := newBackend(t)
+
+	tests := []struct {
+		Name      string
+		Operation logical.Operation
+		Path      string
+		Data      map[string]any
+		Expected  map[string]any
+		Error     string
+	}{
+		{
+			Name:      "will create a role with the defaults",
+			Operation: logical.CreateOperation,
+			Path:      "roles/default",
+		}, {
+			Name:      "will read the defaults of a role",
+			Operation: logical.ReadOperation,
+			Path:      "roles/default",
+			Expected: map[string]any{
+				"words":            6,
+				"wordlist":         "eff-long",
+				"separator":        " ",
+				"capitalization":   "none",
+				"min_entropy_bits": 0.0,
+				"enhance_entropy":  false,
+			},
+		}, {
+			Name:      "will create a role with a policy",
+			Operation: logical.CreateOperation,
+			Path:      "roles/short",
+			Data: map[string]any{
+				"words":          4,
+				"wordlist":       "eff-short",
+				"separator":      "-",
+				"capitalization": "title",
+			},
+		}, {
+			Name:      "will update only the given fields of a role",
+			Operation: logical.UpdateOperation,
+			Path:      "roles/short",
+			Data:      map[string]any{"min_entropy_bits": 60, "enhance_entropy": true},
+		}, {
+			Name:      "will read the policy of a role",
+			Operation: logical.ReadOperation,
+			Path:      "roles/short",
+			Expected: map[string]any{
+				"words":            4,
+				"wordlist":         "eff-short",
+				"separator":        "-",
+				"capitalization":   "title",
+				"min_entropy_bits": 60.0,
+				"enhance_entropy":  true,
+			},
+		}, {
+			Name:      "will reject an unknown wordlist",
+			Operation: logical.CreateOperation,
+			Path:      "roles/invalid",
+			Data:      map[string]any{"wordlist": "unknown"},
+			Error:     "wordlist must be one of",
+		}, {
+			Name:      "will reject an unknown capitalization",
+			Operation: logical.CreateOperation,
+			Path:      "roles/invalid",
+			Data:      map[string]any{"capitalization": "lower"},
+			Error:     "capitalization must be one of: none, random-letter, random-word, title, upper",
+		}, {
+			Name:      "will reject too many words",
+			Operation: logical.CreateOperation,
+			Path:      "roles/invalid",
+			Data:      map[string]any{"words": 65},
+			Error:     "words must be between 1 and 64",
+		}, {
+			Name:      "will reject a negative minimum entropy",
+			Operation: logical.CreateOperation,
+			Path:      "roles/invalid",
+			Data:      map[string]any{"min_entropy_bits": -1},
+			Error:     "invalid negative minimum entropy given",
+		}, {
+			Name:      "will not store a rejected role",
+			Operation: logical.ReadOperation,
+			Path:      "roles/invalid",
+		}, {
+			Name:      "will list the roles",
+			Operation: logical.ListOperation,
+			Path:      "roles/",
+			Expected:  map[string]any{"keys": []string{"default", "short"}},
+		}, {
+			Name:      "will delete a role",
+			Operation: logical.DeleteOperation,
+			Path:      "roles/default",
+		}, {
+			Name:      "will list the remaining roles",
+			Operation: logical.ListOperation,
+			Path:      "roles/",
+			Expected:  map[string]any{"keys": []string{"short"}},
+		},
+	}
+
+	for _, test := range tests {
+		response, err := request(t, b, storage, test.Operation, test.Path, test.Data)
+		if test.Error != "" {
+			if assert.True(response.IsError(), test.Name) {
+				assert.Contains(response.Error().Error(), test.Error, test.Name)
+			}
+
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		if test.Expected == nil {
+			assert.Nil(response, test.Name)
+			continue
+		}
+
+		if assert.NotNil(response, test.Name) {
+			assert.Equal(test.Expected, response.Data, test.Name)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Package derive derives encryption keys from diceware passphrases used as
+// master secrets, with HKDF (RFC 5869) over SHA-256.
+//
+// HKDF is not a password hashing function, and does nothing to slow down a
+// guessing attack, so the passphrase itself must provide the strength of the
+// keys, e.g. 6 or more words from the EFF long wordlist (77 bits).  Passwords
+// chosen by people should instead be stretched with Argon2id.
+//
+// The parameters are fixed so that the same passphrase and salt always derive
+// the same key:
+//
+//   - the hash is SHA-256
+//   - the salt is at least MinSaltLength random bytes, stored alongside
+//     whatever the key protects
+//   - the info is Info, or the info given to KeyWithInfo to derive several
+//     independent keys from the same passphrase and salt
+//   - the length of the key is between 1 and MaxLength bytes
+package derive
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// Info represents the HKDF info used by Key, binding the keys to this
+	// package so that they differ from any other use of the same passphrase.
+	Info = "github.com/everlastingbeta/diceware/derive v1"
+	// MinSaltLength represents the smallest salt in bytes a key is able to be
+	// derived with.
+	MinSaltLength = 16
+	// MaxLength represents the longest key in bytes HKDF over SHA-256 is able
+	// to derive.
+	MaxLength = 255 * sha256.Size
+)
+
+var (
+	// ErrEmptyPassphrase represents the error given when a key is derived from
+	// an empty passphrase
+	ErrEmptyPassphrase = errors.New("unable to derive a key from an empty passphrase")
+	// ErrInvalidSalt represents the error given when a key is derived with a
+	// salt shorter than MinSaltLength
+	ErrInvalidSalt = errors.New("invalid salt given, must be at least 16 bytes")
+	// ErrInvalidLength represents the error given when a key is requested with
+	// a length outside of [1, MaxLength]
+	ErrInvalidLength = errors.New("invalid key length given, must be between 1 and 8160 bytes")
+)
+
+// Key returns a slice of bytes.
+// Implements the logic to derive a key of the given length in bytes from the
+// passphrase and salt with HKDF over SHA-256, using Info as the info.
+func Key(passphrase, salt []byte, length int) ([]byte, error) {
+	return KeyWithInfo(passphrase, salt, []byte(Info), length)
+}
+
+// KeyWithInfo returns a slice of bytes.
+// Implements the logic to derive a key of the given length in bytes from the
+// passphrase and salt with HKDF over SHA-256, using the given info, e.g.
+// "disk encryption", so that keys derived for different purposes are
+// independent of one another.
+func KeyWithInfo(passphrase, salt, info []byte, length int) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	if len(salt) < MinSaltLength {
+		return nil, ErrInvalidSalt
+	}
+
+	if length < 1 || length > MaxLength {
+		return nil, ErrInvalidLength
+	}
+
+	key := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, passphrase, salt, info), key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
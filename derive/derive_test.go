@@ -0,0 +1,114 @@
+package derive_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/derive"
+	"github.com/stretchr/testify/assert"
+)
+
+// sequence returns the bytes from start up to, but not including, end.
+func sequence(start, end int) []byte {
+	data := []byte{}
+	for b := start; b < end; b++ {
+		data = append(data, byte(b))
+	}
+
+	return data
+}
+
+func TestKeyWithInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase []byte
+		Salt       []byte
+		Info       []byte
+		Length     int
+		Expected   string
+		Error      error
+	}{
+		{
+			Name:       "will derive the key of test case 2 of RFC 5869",
+			Passphrase: sequence(0x00, 0x50),
+			Salt:       sequence(0x60, 0xb0),
+			Info:       sequence(0xb0, 0x100),
+			Length:     82,
+			Expected: "b11e398dc80327a1c8e7f78c596a49344f012eda2d4efad8a050cc4c19afa97c" +
+				"59045a99cac7827271cb41c65e590e09da3275600c2f09b8367793a9aca3db71" +
+				"cc30c58179ec3e87c14c01d5c1f3434f1d87",
+		}, {
+			Name:       "will error with an empty passphrase",
+			Passphrase: []byte{},
+			Salt:       sequence(0, 16),
+			Length:     32,
+			Error:      derive.ErrEmptyPassphrase,
+		}, {
+			Name:       "will error with a short salt",
+			Passphrase: []byte("correct horse battery staple"),
+			Salt:       sequence(0, 15),
+			Length:     32,
+			Error:      derive.ErrInvalidSalt,
+		}, {
+			Name:       "will error with a length of zero",
+			Passphrase: []byte("correct horse battery staple"),
+			Salt:       sequence(0, 16),
+			Error:      derive.ErrInvalidLength,
+		}, {
+			Name:       "will error with a length beyond the maximum",
+			Passphrase: []byte("correct horse battery staple"),
+			Salt:       sequence(0, 16),
+			Length:     derive.MaxLength + 1,
+			Error:      derive.ErrInvalidLength,
+		},
+	}
+
+	for _, test := range tests {
+		key, err := derive.KeyWithInfo(test.Passphrase, test.Salt, test.Info, test.Length)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Expected, hex.EncodeToString(key), test.Name)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase := []byte("correct horse battery staple")
+	salt := sequence(0, 16)
+
+	key, err := derive.Key(passphrase, salt, 32)
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Len(key, 32)
+
+	withInfo, err := derive.KeyWithInfo(passphrase, salt, []byte(derive.Info), 32)
+	assert.NoError(err)
+	assert.Equal(withInfo, key, "expected Key to use Info")
+
+	again, err := derive.Key(passphrase, salt, 32)
+	assert.NoError(err)
+	assert.Equal(key, again, "expected the same key from the same passphrase and salt")
+
+	otherSalt, err := derive.Key(passphrase, sequence(1, 17), 32)
+	assert.NoError(err)
+	assert.False(bytes.Equal(key, otherSalt), "expected a different key from a different salt")
+
+	otherInfo, err := derive.KeyWithInfo(passphrase, salt, []byte("disk encryption"), 32)
+	assert.NoError(err)
+	assert.False(bytes.Equal(key, otherInfo), "expected a different key from a different info")
+
+	longer, err := derive.Key(passphrase, salt, 64)
+	assert.NoError(err)
+	assert.Equal(key, longer[:32], "expected a longer key to extend the shorter key")
+}
@@ -0,0 +1,106 @@
+package diceware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// ErrInvalidDiceCount represents the error given when the number of dice
+	// rolls given is unable to be split into whole words
+	ErrInvalidDiceCount = errors.New("invalid number of dice rolls given")
+	// ErrInvalidDiceRoll represents the error given when a dice roll is outside
+	// of the range of the sides of the dice
+	ErrInvalidDiceRoll = errors.New("invalid dice roll given")
+	// ErrInvalidSeparator represents the error given when a passphrase is split
+	// with an empty separator
+	ErrInvalidSeparator = errors.New("invalid empty separator given")
+	// ErrUnknownWord represents the error given when a word of a passphrase is
+	// not found within the wordlist
+	ErrUnknownWord = errors.New("word not found within the wordlist")
+)
+
+// FromDiceRolls returns a string.
+// Implements the logic required to convert the results of physical dice into
+// a passphrase, where every `wl.Rolls()` consecutive rolls produce a single
+// word.  The words are joined with the `DefaultSeparator`.
+func FromDiceRolls(wl Wordlist, rolls []int) (string, error) {
+	if wl == nil {
+		return "", ErrInvalidWordlist
+	}
+
+	perWord := wl.Rolls()
+	if perWord < 1 || len(rolls) == 0 || len(rolls)%perWord != 0 {
+		return "", fmt.Errorf("%w: %d is not a multiple of %d", ErrInvalidDiceCount, len(rolls), perWord)
+	}
+
+	words := make([]string, 0, len(rolls)/perWord)
+	for i := 0; i < len(rolls); i += perWord {
+		word, err := WordFromDiceRolls(wl, rolls[i:i+perWord])
+		if err != nil {
+			return "", err
+		}
+
+		words = append(words, word)
+	}
+
+	return strings.Join(words, DefaultSeparator), nil
+}
+
+// WordFromDiceRolls returns a string.
+// Implements the logic required to convert the results of physical dice into
+// a single word, where the number of rolls must match `wl.Rolls()`.  An error
+// wrapping `wordlist.ErrRollRejected` is returned when the wordlist has no word
+// for the rolls, such as the rolls past the last word of a `wordlist.Slice`,
+// and the dice must be rolled again.
+func WordFromDiceRolls(wl Wordlist, rolls []int) (string, error) {
+	if wl == nil {
+		return "", ErrInvalidWordlist
+	}
+
+	if len(rolls) != wl.Rolls() {
+		return "", fmt.Errorf("%w: %d given, %d required", ErrInvalidDiceCount, len(rolls), wl.Rolls())
+	}
+
+	sides := int(wl.SidesOfDice().Int64())
+	for _, roll := range rolls {
+		if roll < 1 || roll > sides {
+			return "", fmt.Errorf("%w: %d is not between 1 and %d", ErrInvalidDiceRoll, roll, sides)
+		}
+	}
+
+	rollValue := rollValueOf(rolls, sides)
+
+	return fetchWord(wl, rollValue)
+}
+
+// ToRolls returns a slice of int slices.
+// Implements the logic required to map each word of the passphrase back to
+// the dice rolls that produce it from the wordlist, allowing a passphrase to
+// be written down as numbers or verified against a paper wordlist.
+func ToRolls(passphrase, separator string, wl Wordlist) ([][]int, error) {
+	if wl == nil {
+		return nil, ErrInvalidWordlist
+	}
+
+	if separator == "" {
+		return nil, ErrInvalidSeparator
+	}
+
+	index := wordlist.Index(wl)
+	words := strings.Split(passphrase, separator)
+	rolls := make([][]int, len(words))
+	for i, word := range words {
+		rollValue, found := index.RollFor(word)
+		if !found {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownWord, word)
+		}
+
+		rolls[i] = diceOf(rollValue, wl.Rolls(), int(wl.SidesOfDice().Int64()))
+	}
+
+	return rolls, nil
+}
@@ -0,0 +1,200 @@
+package diceware_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromDiceRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist diceware.Wordlist
+		Rolls    []int
+		Value    string
+		Error    error
+	}{
+		{
+			Name:  "will error with a nil wordlist",
+			Rolls: []int{1, 1, 1, 1, 1},
+			Error: diceware.ErrInvalidWordlist,
+		}, {
+			Name:     "will error without any rolls",
+			Wordlist: wordlist.EFFLong,
+			Error:    diceware.ErrInvalidDiceCount,
+		}, {
+			Name:     "will error with a partial word of rolls",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    []int{1, 1, 1, 1, 1, 6, 6},
+			Error:    diceware.ErrInvalidDiceCount,
+		}, {
+			Name:     "will error with a roll larger than the sides of the dice",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    []int{1, 1, 1, 1, 7},
+			Error:    diceware.ErrInvalidDiceRoll,
+		}, {
+			Name:     "will error with a roll of zero",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    []int{0, 1, 1, 1, 1},
+			Error:    diceware.ErrInvalidDiceRoll,
+		}, {
+			Name:     "will error with a roll missing from the wordlist",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{1: "test"}),
+			Rolls:    []int{2},
+			Error:    diceware.ErrInvalidWordFetched,
+		}, {
+			Name:     "will convert the rolls of a single word",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    []int{1, 1, 1, 1, 1},
+			Value:    "abacus",
+		}, {
+			Name:     "will convert the rolls of several words",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    []int{1, 1, 1, 1, 1, 6, 6, 6, 6, 6},
+			Value:    "abacus zoom",
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.FromDiceRolls(test.Wordlist, test.Rolls)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Value, passphrase, test.Name)
+		}
+	}
+}
+
+func TestWordFromDiceRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := diceware.WordFromDiceRolls(wordlist.EFFLong, []int{1, 1, 1, 1})
+	assert.ErrorIs(err, diceware.ErrInvalidDiceCount)
+
+	word, err := diceware.WordFromDiceRolls(wordlist.EFFLong, []int{6, 6, 6, 6, 6})
+	if assert.NoError(err) {
+		assert.Equal("zoom", word)
+	}
+
+	// a missing word is reported through the CheckedWordlist interface
+	_, err = diceware.WordFromDiceRolls(wordlist.NewMap(1, 6, map[int]string{1: "acid"}), []int{2})
+	assert.ErrorIs(err, diceware.ErrInvalidWordFetched)
+	assert.ErrorIs(err, wordlist.ErrWordNotFound)
+
+	// the rolls past the last word of a Slice must be rolled again
+	_, err = diceware.WordFromDiceRolls(wordSlice(1025), []int{6, 6, 6, 6})
+	assert.ErrorIs(err, wordlist.ErrRollRejected)
+}
+
+func TestToRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Separator  string
+		Wordlist   diceware.Wordlist
+		Rolls      [][]int
+		Error      error
+	}{
+		{
+			Name:       "will error with a nil wordlist",
+			Passphrase: "acid zoom",
+			Separator:  " ",
+			Error:      diceware.ErrInvalidWordlist,
+		}, {
+			Name:       "will error with an empty separator",
+			Passphrase: "acid zoom",
+			Wordlist:   wordlist.EFFLong,
+			Error:      diceware.ErrInvalidSeparator,
+		}, {
+			Name:       "will error with a word missing from the wordlist",
+			Passphrase: "acid diceware",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+			Error:      diceware.ErrUnknownWord,
+		}, {
+			Name:       "will map each word back to its rolls",
+			Passphrase: "acid-zoom-yodel",
+			Separator:  "-",
+			Wordlist:   wordlist.EFFLong,
+			Rolls:      [][]int{{1, 1, 1, 6, 6}, {6, 6, 6, 6, 6}, {6, 6, 6, 2, 3}},
+		},
+	}
+
+	for _, test := range tests {
+		rolls, err := diceware.ToRolls(test.Passphrase, test.Separator, test.Wordlist)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Rolls, rolls, test.Name)
+		}
+	}
+
+	// every generated passphrase is able to be converted back into its rolls
+	passphrase, err := diceware.RollWords(6, " ", wordlist.EFFLong)
+	if !assert.NoError(err) {
+		return
+	}
+
+	rolls, err := diceware.ToRolls(passphrase, " ", wordlist.EFFLong)
+	if !assert.NoError(err) {
+		return
+	}
+
+	flattened := []int{}
+	for _, word := range rolls {
+		flattened = append(flattened, word...)
+	}
+
+	rebuilt, err := diceware.FromDiceRolls(wordlist.EFFLong, flattened)
+	if assert.NoError(err) {
+		assert.Equal(passphrase, rebuilt)
+	}
+}
+
+func TestLargeWordlistRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	// a 65,536 word wordlist rolled with 4 16-sided dice
+	words := make(map[int]string, 65536)
+	for i := 0; i < 65536; i++ {
+		words[wordlist.RollValueForIndex(i, 4, 16)] = fmt.Sprintf("word%d", i)
+	}
+
+	large := wordlist.NewMap(4, 16, words)
+
+	word, err := diceware.WordFromDiceRolls(large, []int{16, 1, 1, 16})
+	if assert.NoError(err) {
+		assert.Equal(fmt.Sprintf("word%d", 15*16*16*16+15), word)
+	}
+
+	rolls, err := diceware.ToRolls("word0 word65535", " ", large)
+	if assert.NoError(err) {
+		assert.Equal([][]int{{1, 1, 1, 1}, {16, 16, 16, 16}}, rolls)
+	}
+
+	// both the indexed and the simulated dice rolls must fetch words
+	for _, wl := range []diceware.Wordlist{large, struct{ diceware.Wordlist }{large}} {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount: 4,
+			Separator: " ",
+			Wordlist:  wl,
+		})
+		if assert.NoError(err) {
+			assert.Len(passphrase.Words, 4)
+			assert.InDelta(64, passphrase.EntropyBits, 0.0001)
+		}
+	}
+}
@@ -1,12 +1,11 @@
 package diceware
 
 import (
-	"crypto/rand"
+	"context"
 	"errors"
 	"fmt"
-	"math"
+	"iter"
 	"math/big"
-	"strings"
 
 	"github.com/everlastingbeta/diceware/wordlist"
 )
@@ -24,7 +23,8 @@ var (
 // be utilized within the diceware implementation.
 type Wordlist interface {
 	// FetchWord describes the logic to fetch a word from the word list with the
-	// given dice roll value
+	// given dice roll value, whose format is described by
+	// `wordlist.RollPlace`
 	FetchWord(int) string
 
 	// Rolls describes the number of dice that should be rolled to retrieve an
@@ -35,21 +35,189 @@ type Wordlist interface {
 	SidesOfDice() *big.Int
 }
 
-// rollWord returns a string.
+// CheckedWordlist defines the optional method a Wordlist can implement in
+// order to report why a word was unable to be fetched, which is preferred
+// over FetchWord whenever it is available.
+type CheckedWordlist interface {
+	Wordlist
+
+	// FetchWordE describes the logic to fetch a word from the word list with
+	// the given dice roll value, returning an error explaining why no word was
+	// found, such as `wordlist.ErrRollOutOfRange` or `wordlist.ErrWordNotFound`
+	FetchWordE(int) (string, error)
+}
+
+// NamedWordlist defines the optional method a Wordlist can implement in order
+// to be identified within a generated Passphrase.
+type NamedWordlist interface {
+	Wordlist
+
+	// Name describes the identifier of the wordlist
+	Name() string
+}
+
+// IndexedWordlist defines the optional methods a Wordlist can implement in
+// order to have a single uniformly distributed index sampled, rather than
+// simulating a roll of each die.  The index of every word must match the
+// position of its roll value within the ascending roll values of the dice.
+type IndexedWordlist interface {
+	// Len describes the number of words that can be sampled
+	Len() int
+
+	// WordAt describes the logic to fetch a word from the word list with the
+	// given index within the range of [0, Len())
+	WordAt(int) string
+}
+
+// RollIndex returns an int and a string.
+// Implements the logic required to sample a single uniformly distributed index
+// from the given wordlist, returning both the index and the word found at it.
+// When the given RandomSource is nil, `CryptoRandomSource` is used.
+func RollIndex(wl IndexedWordlist, rs RandomSource) (int, string, error) {
+	if wl == nil {
+		return 0, "", ErrInvalidWordlist
+	}
+
+	if rs == nil {
+		rs = CryptoRandomSource{}
+	}
+
+	return rollIndex(rs, wl)
+}
+
+// rollIndex returns an int and a string.
+// Implements the logic to sample a single uniformly distributed index from the
+// wordlist, returning both the index and the word found at it.
+func rollIndex(rs RandomSource, wl IndexedWordlist) (int, string, error) {
+	size := wl.Len()
+	if size < 1 {
+		return 0, "", fmt.Errorf("%w: wordlist is empty", ErrInvalidWordFetched)
+	}
+
+	index, err := randomInt(rs, size)
+	if err != nil {
+		return 0, "", err
+	}
+
+	word := wl.WordAt(index)
+	if len(word) == 0 {
+		return 0, "", fmt.Errorf("%w for index: %d", ErrInvalidWordFetched, index)
+	}
+
+	return index, word, nil
+}
+
+// RollWord returns a string.
+// Implements the logic required to roll a single word from the given wordlist.
+func RollWord(wl Wordlist) (string, error) {
+	return RollWordContext(context.Background(), wl)
+}
+
+// RollWordContext returns a string.
+// Implements the logic required to roll a single word from the given wordlist,
+// aborting between rolls when the given context is cancelled or its deadline
+// expires.
+func RollWordContext(ctx context.Context, wl Wordlist) (string, error) {
+	if wl == nil {
+		return "", ErrInvalidWordlist
+	}
+
+	word, _, err := rollWord(ctx, CryptoRandomSource{}, wl)
+	return word, err
+}
+
+// RollWordSeq returns an iter.Seq2 of strings and errors.
+// Implements the logic required to stream an endless sequence of single words
+// rolled from the given wordlist, useful for usernames, codenames and other
+// identifiers.  The sequence stops whenever the caller breaks out of the range
+// loop or after the first error has been yielded.  When the given RandomSource
+// is nil, `CryptoRandomSource` is used.
+func RollWordSeq(wl Wordlist, rs RandomSource) iter.Seq2[string, error] {
+	if rs == nil {
+		rs = CryptoRandomSource{}
+	}
+
+	return func(yield func(string, error) bool) {
+		if wl == nil {
+			yield("", ErrInvalidWordlist)
+			return
+		}
+
+		for {
+			word, _, err := rollWord(context.Background(), rs, wl)
+			if !yield(word, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// rollWord returns a string and an int.
 // Implements the logic that will roll a die for the required amount of Rolls
-// and then retrieves that word from the wordlist associated with the roll value.
-func rollWord(wordlist Wordlist) (string, error) {
-	rollValue := 0
-	for i := wordlist.Rolls(); i > 0; i-- {
-		roll, err := rand.Int(rand.Reader, wordlist.SidesOfDice())
+// and then retrieves that word from the wordlist associated with the roll value,
+// returning both the word and the roll value.  The dice are rolled again
+// whenever the wordlist rejects the roll value with `wordlist.ErrRollRejected`.
+// Wordlists implementing IndexedWordlist have a single index sampled instead.
+func rollWord(ctx context.Context, rs RandomSource, wl Wordlist) (string, int, error) {
+	sides := int(wl.SidesOfDice().Int64())
+	if indexed, ok := wl.(IndexedWordlist); ok {
+		if err := ctx.Err(); err != nil {
+			return "", 0, err
+		}
+
+		index, word, err := rollIndex(rs, indexed)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return word, wordlist.RollValueForIndex(index, wl.Rolls(), sides), nil
+	}
+
+	dice := make([]int, wl.Rolls())
+	for {
+		for i := range dice {
+			if err := ctx.Err(); err != nil {
+				return "", 0, err
+			}
+
+			roll, err := randomInt(rs, sides)
+			if err != nil {
+				return "", 0, err
+			}
+
+			dice[i] = roll + 1
+		}
+
+		rollValue := rollValueOf(dice, sides)
+
+		word, err := fetchWord(wl, rollValue)
+		if errors.Is(err, wordlist.ErrRollRejected) {
+			continue
+		}
+
+		if err != nil {
+			return "", 0, err
+		}
+
+		return word, rollValue, nil
+	}
+}
+
+// fetchWord returns a string.
+// Implements the logic to fetch the word of the roll value from the wordlist,
+// through FetchWordE when the wordlist implements CheckedWordlist, returning
+// an error wrapping `ErrInvalidWordFetched` when no word was found.
+func fetchWord(wl Wordlist, rollValue int) (string, error) {
+	if checked, ok := wl.(CheckedWordlist); ok {
+		word, err := checked.FetchWordE(rollValue)
 		if err != nil {
-			return "", err
+			return "", fmt.Errorf("%w: %w", ErrInvalidWordFetched, err)
 		}
 
-		rollValue += int(math.Pow(10, float64(i-1))) * int(roll.Int64()+1)
+		return word, nil
 	}
 
-	word := wordlist.FetchWord(rollValue)
+	word := wl.FetchWord(rollValue)
 	if len(word) == 0 {
 		return "", fmt.Errorf("%w for roll value: %d", ErrInvalidWordFetched, rollValue)
 	}
@@ -69,51 +237,28 @@ func rollWord(wordlist Wordlist) (string, error) {
 // passphrase will be modified.  If no enhanceEntropy value is passed in, then
 // it will default to false.
 func RollWords(wordCount int, separator string, wl Wordlist, enhanceEntropy ...bool) (string, error) {
-	if wl == nil {
-		return "", ErrInvalidWordlist
+	opts := PassphraseOptions{
+		WordCount: wordCount,
+		Separator: separator,
+		Wordlist:  wl,
 	}
 
-	if len(enhanceEntropy) == 0 {
-		enhanceEntropy = append(enhanceEntropy, false)
+	if len(enhanceEntropy) > 0 {
+		opts.EnhanceEntropy = enhanceEntropy[0]
 	}
 
-	words := make([]string, wordCount)
-	for i := range words {
-		word, err := rollWord(wl)
-		if err != nil {
-			return "", err
-		}
-
-		words[i] = word
-	}
-
-	if enhanceEntropy[0] {
-		transformedWords, err := rand.Int(rand.Reader, big.NewInt(int64(len(words))))
-		if err != nil {
-			return "", err
-		}
-
-		for i := 0; i < int(transformedWords.Int64())+1; {
-			character, err := rollWord(wordlist.ExtraEntropy)
-			if err != nil {
-				return "", err
-			}
-
-			if strings.Contains(separator, character) {
-				continue
-			}
-
-			characterPosition, err := rand.Int(rand.Reader, big.NewInt(int64(len(words[i]))))
-			if err != nil {
-				return "", err
-			}
+	return RollWordsContext(context.Background(), opts)
+}
 
-			left := words[i][0 : characterPosition.Int64()+1]
-			right := words[i][characterPosition.Int64()+1 : len(words[i])]
-			words[i] = left + character + right
-			i++
-		}
+// RollWordsContext returns a string.
+// Implements the logic required to generate a passphrase from the given
+// options, aborting between rolls when the given context is cancelled or its
+// deadline expires.
+func RollWordsContext(ctx context.Context, opts PassphraseOptions) (string, error) {
+	passphrase, err := GeneratePassphraseContext(ctx, opts)
+	if err != nil {
+		return "", err
 	}
 
-	return strings.Join(words, separator), nil
+	return passphrase.String(), nil
 }
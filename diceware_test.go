@@ -1,8 +1,12 @@
 package diceware_test
 
 import (
+	"context"
+	"fmt"
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/everlastingbeta/diceware"
 	"github.com/everlastingbeta/diceware/wordlist"
@@ -54,11 +58,6 @@ func TestRollWords(t *testing.T) {
 			Separator: " ",
 			WordCount: 5,
 			Wordlist:  validWordlistMap,
-		}, {
-			Name:      "Rolling several words with the original wordlist",
-			Separator: "_",
-			WordCount: 8,
-			Wordlist:  wordlist.Original,
 		}, {
 			Name:      "Rolling several words with the EFF long wordlist",
 			Separator: ":",
@@ -89,3 +88,182 @@ func TestRollWords(t *testing.T) {
 		}
 	}
 }
+
+func TestRollWord(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := diceware.RollWord(nil)
+	assert.ErrorIs(err, diceware.ErrInvalidWordlist)
+
+	word, err := diceware.RollWord(wordlist.EFFLong)
+	if assert.NoError(err) {
+		assert.NotEmpty(word)
+	}
+}
+
+// diceOnlyWordlist is a CheckedWordlist hiding the IndexedWordlist methods of
+// the wrapped wordlist, so that each of its dice are rolled.
+type diceOnlyWordlist struct {
+	wl *wordlist.Slice
+}
+
+func (d diceOnlyWordlist) FetchWord(rollValue int) string { return d.wl.FetchWord(rollValue) }
+
+func (d diceOnlyWordlist) FetchWordE(rollValue int) (string, error) {
+	return d.wl.FetchWordE(rollValue)
+}
+
+func (d diceOnlyWordlist) Rolls() int { return d.wl.Rolls() }
+
+func (d diceOnlyWordlist) SidesOfDice() *big.Int { return d.wl.SidesOfDice() }
+
+// wordSlice returns a Slice with the given number of distinct words.
+func wordSlice(count int) *wordlist.Slice {
+	words := make([]string, count)
+	for i := range words {
+		words[i] = fmt.Sprintf("word%05d", i)
+	}
+
+	wl, err := wordlist.FromSlice(words)
+	if err != nil {
+		panic(err)
+	}
+
+	return wl
+}
+
+func TestRollWordRerollsRejectedRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	// 1025 words are keyed with 4 6-sided dice, rejecting every roll past 1025
+	wl := diceOnlyWordlist{wl: wordSlice(1025)}
+	rs := &sequenceRandomSource{values: []int64{5, 5, 5, 5, 0, 0, 0, 1}}
+
+	for word, err := range diceware.RollWordSeq(wl, rs) {
+		if assert.NoError(err) {
+			assert.Equal("word00001", word)
+		}
+
+		break
+	}
+
+	assert.Equal(8, rs.index)
+}
+
+func TestRollWordSeq(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist diceware.Wordlist
+		Words    int
+		Error    error
+	}{
+		{
+			Name:  "will yield a single error with a nil wordlist",
+			Error: diceware.ErrInvalidWordlist,
+		}, {
+			Name:     "will yield a single error when a word is unable to be fetched",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{}),
+			Error:    diceware.ErrInvalidWordFetched,
+		}, {
+			Name:     "will yield words until the caller stops",
+			Wordlist: wordlist.EFFLong,
+			Words:    50,
+		},
+	}
+
+	for _, test := range tests {
+		words := []string{}
+		errs := 0
+		for word, err := range diceware.RollWordSeq(test.Wordlist, nil) {
+			if err != nil {
+				assert.ErrorIs(err, test.Error, test.Name)
+				errs++
+
+				continue
+			}
+
+			assert.NotEmpty(word, test.Name)
+			words = append(words, word)
+			if len(words) == test.Words {
+				break
+			}
+		}
+
+		assert.Len(words, test.Words, test.Name)
+		if test.Error != nil {
+			assert.Equal(1, errs, test.Name)
+		}
+	}
+}
+
+func TestRollWordsContext(t *testing.T) {
+	assert := assert.New(t)
+
+	opts := diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	tests := []struct {
+		Name    string
+		Context context.Context
+		Error   error
+	}{
+		{
+			Name:    "will generate a passphrase with an active context",
+			Context: context.Background(),
+		}, {
+			Name:    "will abort with a cancelled context",
+			Context: cancelled,
+			Error:   context.Canceled,
+		}, {
+			Name:    "will abort with an expired context",
+			Context: expired,
+			Error:   context.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.RollWordsContext(test.Context, opts)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			assert.Empty(passphrase, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Len(strings.Split(passphrase, " "), 6, test.Name)
+		}
+
+		_, err = diceware.RollWordContext(test.Context, opts.Wordlist)
+		assert.NoError(err, test.Name)
+	}
+}
+
+func TestRollIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	_, _, err := diceware.RollIndex(nil, nil)
+	assert.ErrorIs(err, diceware.ErrInvalidWordlist)
+
+	_, _, err = diceware.RollIndex(wordlist.NewMap(0, 6, map[int]string{}), nil)
+	assert.ErrorIs(err, diceware.ErrInvalidWordFetched)
+
+	_, _, err = diceware.RollIndex(wordlist.NewMap(1, 6, map[int]string{}), nil)
+	assert.ErrorIs(err, diceware.ErrInvalidWordFetched)
+
+	index, word, err := diceware.RollIndex(wordlist.EFFLong, diceware.CryptoRandomSource{})
+	if assert.NoError(err) {
+		assert.True(index >= 0 && index < wordlist.EFFLong.Len())
+		assert.Equal(wordlist.EFFLong.WordAt(index), word)
+	}
+}
@@ -0,0 +1,99 @@
+// Package dicewareexpvar publishes statistics of the passphrases generated by
+// diceware through expvar, for services wanting observability without
+// Prometheus.
+//
+// The statistics are published as the "diceware" variable, holding the
+// counters passphrases_generated, words_rolled and rng_errors, which is
+// served at /debug/vars by `expvar.Handler` and, once expvar is imported, by
+// `http.DefaultServeMux`.  They are kept in their own package, as importing
+// expvar registers /debug/vars on `http.DefaultServeMux` for the whole
+// program.
+package dicewareexpvar
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+// Name represents the name of the expvar variable the statistics are
+// published as.
+const Name = "diceware"
+
+// Stats defines the diceware.WordInstrumentation counting the generated
+// passphrases, the words rolled for them and the failures of the
+// RandomSource, shared by every passphrase generated with WithExpvar.
+type Stats struct {
+	// generated represents the counter of the generated passphrases.
+	generated *expvar.Int
+
+	// words represents the counter of the words rolled for the generated
+	// passphrases.
+	words *expvar.Int
+
+	// rngErrors represents the counter of the failures of the RandomSource.
+	rngErrors *expvar.Int
+}
+
+var _ diceware.WordInstrumentation = (*Stats)(nil)
+
+var (
+	// publishOnce guards the publishing of the statistics, which expvar only
+	// allows once.
+	publishOnce sync.Once
+
+	// stats represents the published statistics.
+	stats *Stats
+)
+
+// Publish returns a *Stats.
+// Implements the logic to publish the statistics as the Name expvar variable
+// the first time it is called, returning the same Stats every time.
+func Publish() *Stats {
+	publishOnce.Do(func() {
+		stats = &Stats{
+			generated: new(expvar.Int),
+			words:     new(expvar.Int),
+			rngErrors: new(expvar.Int),
+		}
+
+		vars := new(expvar.Map)
+		vars.Set("passphrases_generated", stats.generated)
+		vars.Set("words_rolled", stats.words)
+		vars.Set("rng_errors", stats.rngErrors)
+		expvar.Publish(Name, vars)
+	})
+
+	return stats
+}
+
+// WithExpvar returns a diceware.Option that sets whether the passphrases are
+// counted by the published Stats, alongside any Instrumentation given before
+// it.  When disabled, the options are left unchanged.
+func WithExpvar(enabled bool) diceware.Option {
+	return func(opts *diceware.PassphraseOptions) {
+		if enabled {
+			opts.Instrumentation = diceware.JoinInstrumentation(opts.Instrumentation, Publish())
+		}
+	}
+}
+
+// PassphraseGenerated implements the logic for the diceware.Instrumentation
+// interface, counting the passphrase.
+func (s *Stats) PassphraseGenerated(time.Duration) {
+	s.generated.Add(1)
+}
+
+// EntropySourceError implements the logic for the diceware.Instrumentation
+// interface, counting the failure of the RandomSource.
+func (s *Stats) EntropySourceError(error) {
+	s.rngErrors.Add(1)
+}
+
+// WordsRolled implements the logic for the diceware.WordInstrumentation
+// interface, counting the words rolled for the passphrase.
+func (s *Stats) WordsRolled(n int) {
+	s.words.Add(int64(n))
+}
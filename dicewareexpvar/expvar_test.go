@@ -0,0 +1,110 @@
+package dicewareexpvar_test
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/dicewareexpvar"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingRandomSource is a RandomSource that always fails.
+type failingRandomSource struct{}
+
+func (failingRandomSource) GetRandom(*big.Int) (*big.Int, error) {
+	return nil, errors.New("entropy source failure")
+}
+
+// countingInstrumentation is an Instrumentation counting the generated
+// passphrases.
+type countingInstrumentation struct {
+	generated atomic.Int64
+}
+
+func (ci *countingInstrumentation) PassphraseGenerated(time.Duration) {
+	ci.generated.Add(1)
+}
+
+func (ci *countingInstrumentation) EntropySourceError(error) {}
+
+// counters returns the published counters, keyed by their names.
+func counters(t *testing.T) map[string]int64 {
+	t.Helper()
+
+	published := expvar.Get(dicewareexpvar.Name)
+	if published == nil {
+		return nil
+	}
+
+	values := map[string]int64{}
+	if err := json.Unmarshal([]byte(published.String()), &values); err != nil {
+		t.Fatal(err)
+	}
+
+	return values
+}
+
+func TestWithExpvar(t *testing.T) {
+	assert := assert.New(t)
+
+	disabled, err := diceware.New(dicewareexpvar.WithExpvar(false))
+	if assert.NoError(err) {
+		assert.Nil(disabled.Options().Instrumentation, "expected nothing to be counted while disabled")
+	}
+
+	generator, err := diceware.New(diceware.WithWordCount(4), dicewareexpvar.WithExpvar(true))
+	if !assert.NoError(err) {
+		return
+	}
+
+	before := counters(t)
+
+	_, err = generator.GenerateN(3)
+	assert.NoError(err)
+
+	_, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:       4,
+		Wordlist:        wordlist.EFFLong,
+		RandomSource:    failingRandomSource{},
+		Instrumentation: dicewareexpvar.Publish(),
+	})
+	assert.Error(err)
+
+	after := counters(t)
+	assert.Equal(int64(3), after["passphrases_generated"]-before["passphrases_generated"])
+	assert.Equal(int64(12), after["words_rolled"]-before["words_rolled"])
+	assert.Equal(int64(1), after["rng_errors"]-before["rng_errors"])
+}
+
+func TestWithExpvarJoined(t *testing.T) {
+	assert := assert.New(t)
+
+	other := &countingInstrumentation{}
+	generator, err := diceware.New(diceware.WithInstrumentation(other), dicewareexpvar.WithExpvar(true))
+	if !assert.NoError(err) {
+		return
+	}
+
+	before := counters(t)
+
+	_, err = generator.Generate()
+	assert.NoError(err)
+
+	after := counters(t)
+	assert.Equal(int64(1), after["passphrases_generated"]-before["passphrases_generated"])
+	assert.Equal(int64(1), other.generated.Load(), "expected the Instrumentation given before to be kept")
+}
+
+func TestPublish(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Same(dicewareexpvar.Publish(), dicewareexpvar.Publish())
+	assert.NotNil(expvar.Get(dicewareexpvar.Name))
+}
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: diceware.proto
+
+package dicewaregrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PassphraseOptions holds the options of the Generator a request is able to
+// change, each of which keeps the option of the Generator when it is not set.
+type PassphraseOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// words represents the number of words within the passphrase, between 1 and
+	// 64.
+	Words int32 `protobuf:"varint,1,opt,name=words,proto3" json:"words,omitempty"`
+	// wordlist represents the name of the registered wordlist the words are rolled
+	// from, as listed by ListWordlists.
+	Wordlist string `protobuf:"bytes,2,opt,name=wordlist,proto3" json:"wordlist,omitempty"`
+	// separator represents the character(s) placed between the words, at most 8
+	// characters long.
+	Separator     string `protobuf:"bytes,3,opt,name=separator,proto3" json:"separator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassphraseOptions) Reset() {
+	*x = PassphraseOptions{}
+	mi := &file_diceware_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassphraseOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassphraseOptions) ProtoMessage() {}
+
+func (x *PassphraseOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassphraseOptions.ProtoReflect.Descriptor instead.
+func (*PassphraseOptions) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PassphraseOptions) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+func (x *PassphraseOptions) GetWordlist() string {
+	if x != nil {
+		return x.Wordlist
+	}
+	return ""
+}
+
+func (x *PassphraseOptions) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+// Passphrase holds a generated passphrase.
+type Passphrase struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passphrase represents the generated passphrase.
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// words represents each of the words within the passphrase.
+	Words []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	// wordlist represents the name of the wordlist the words were rolled from.
+	Wordlist string `protobuf:"bytes,3,opt,name=wordlist,proto3" json:"wordlist,omitempty"`
+	// entropy_bits represents the entropy in bits of the passphrase.
+	EntropyBits   float64 `protobuf:"fixed64,4,opt,name=entropy_bits,json=entropyBits,proto3" json:"entropy_bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Passphrase) Reset() {
+	*x = Passphrase{}
+	mi := &file_diceware_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passphrase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passphrase) ProtoMessage() {}
+
+func (x *Passphrase) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passphrase.ProtoReflect.Descriptor instead.
+func (*Passphrase) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Passphrase) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *Passphrase) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Passphrase) GetWordlist() string {
+	if x != nil {
+		return x.Wordlist
+	}
+	return ""
+}
+
+func (x *Passphrase) GetEntropyBits() float64 {
+	if x != nil {
+		return x.EntropyBits
+	}
+	return 0
+}
+
+// Wordlist holds the description of a registered wordlist.
+type Wordlist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name represents the name the wordlist is requested with.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// rolls represents the number of dice rolled for each word.
+	Rolls int32 `protobuf:"varint,2,opt,name=rolls,proto3" json:"rolls,omitempty"`
+	// sides represents the number of sides of each of the dice.
+	Sides int32 `protobuf:"varint,3,opt,name=sides,proto3" json:"sides,omitempty"`
+	// entropy_bits represents the entropy in bits of each word rolled from the
+	// wordlist.
+	EntropyBits   float64 `protobuf:"fixed64,4,opt,name=entropy_bits,json=entropyBits,proto3" json:"entropy_bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wordlist) Reset() {
+	*x = Wordlist{}
+	mi := &file_diceware_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wordlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wordlist) ProtoMessage() {}
+
+func (x *Wordlist) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wordlist.ProtoReflect.Descriptor instead.
+func (*Wordlist) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Wordlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Wordlist) GetRolls() int32 {
+	if x != nil {
+		return x.Rolls
+	}
+	return 0
+}
+
+func (x *Wordlist) GetSides() int32 {
+	if x != nil {
+		return x.Sides
+	}
+	return 0
+}
+
+func (x *Wordlist) GetEntropyBits() float64 {
+	if x != nil {
+		return x.EntropyBits
+	}
+	return 0
+}
+
+type GeneratePassphraseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// options represents the options of the Generator changed for the request.
+	Options       *PassphraseOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePassphraseRequest) Reset() {
+	*x = GeneratePassphraseRequest{}
+	mi := &file_diceware_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePassphraseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePassphraseRequest) ProtoMessage() {}
+
+func (x *GeneratePassphraseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePassphraseRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePassphraseRequest) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GeneratePassphraseRequest) GetOptions() *PassphraseOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GeneratePassphraseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passphrase represents the generated passphrase.
+	Passphrase    *Passphrase `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePassphraseResponse) Reset() {
+	*x = GeneratePassphraseResponse{}
+	mi := &file_diceware_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePassphraseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePassphraseResponse) ProtoMessage() {}
+
+func (x *GeneratePassphraseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePassphraseResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePassphraseResponse) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GeneratePassphraseResponse) GetPassphrase() *Passphrase {
+	if x != nil {
+		return x.Passphrase
+	}
+	return nil
+}
+
+type GenerateBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// count represents the number of passphrases to generate, between 1 and 100.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// options represents the options of the Generator changed for the request.
+	Options       *PassphraseOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateBatchRequest) Reset() {
+	*x = GenerateBatchRequest{}
+	mi := &file_diceware_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateBatchRequest) ProtoMessage() {}
+
+func (x *GenerateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateBatchRequest.ProtoReflect.Descriptor instead.
+func (*GenerateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenerateBatchRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GenerateBatchRequest) GetOptions() *PassphraseOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GenerateBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passphrases represents the generated passphrases.
+	Passphrases   []*Passphrase `protobuf:"bytes,1,rep,name=passphrases,proto3" json:"passphrases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateBatchResponse) Reset() {
+	*x = GenerateBatchResponse{}
+	mi := &file_diceware_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateBatchResponse) ProtoMessage() {}
+
+func (x *GenerateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateBatchResponse.ProtoReflect.Descriptor instead.
+func (*GenerateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GenerateBatchResponse) GetPassphrases() []*Passphrase {
+	if x != nil {
+		return x.Passphrases
+	}
+	return nil
+}
+
+type ListWordlistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWordlistsRequest) Reset() {
+	*x = ListWordlistsRequest{}
+	mi := &file_diceware_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWordlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWordlistsRequest) ProtoMessage() {}
+
+func (x *ListWordlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWordlistsRequest.ProtoReflect.Descriptor instead.
+func (*ListWordlistsRequest) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{7}
+}
+
+type ListWordlistsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// wordlists represents the wordlists a request is able to name, ordered by their names.
+	Wordlists     []*Wordlist `protobuf:"bytes,1,rep,name=wordlists,proto3" json:"wordlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWordlistsResponse) Reset() {
+	*x = ListWordlistsResponse{}
+	mi := &file_diceware_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWordlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWordlistsResponse) ProtoMessage() {}
+
+func (x *ListWordlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diceware_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWordlistsResponse.ProtoReflect.Descriptor instead.
+func (*ListWordlistsResponse) Descriptor() ([]byte, []int) {
+	return file_diceware_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWordlistsResponse) GetWordlists() []*Wordlist {
+	if x != nil {
+		return x.Wordlists
+	}
+	return nil
+}
+
+var File_diceware_proto protoreflect.FileDescriptor
+
+const file_diceware_proto_rawDesc = "" +
+	"\n" +
+	"\x0ediceware.proto\x12\vdiceware.v1\"c\n" +
+	"\x11PassphraseOptions\x12\x14\n" +
+	"\x05words\x18\x01 \x01(\x05R\x05words\x12\x1a\n" +
+	"\bwordlist\x18\x02 \x01(\tR\bwordlist\x12\x1c\n" +
+	"\tseparator\x18\x03 \x01(\tR\tseparator\"\x81\x01\n" +
+	"\n" +
+	"Passphrase\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x01 \x01(\tR\n" +
+	"passphrase\x12\x14\n" +
+	"\x05words\x18\x02 \x03(\tR\x05words\x12\x1a\n" +
+	"\bwordlist\x18\x03 \x01(\tR\bwordlist\x12!\n" +
+	"\fentropy_bits\x18\x04 \x01(\x01R\ventropyBits\"m\n" +
+	"\bWordlist\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05rolls\x18\x02 \x01(\x05R\x05rolls\x12\x14\n" +
+	"\x05sides\x18\x03 \x01(\x05R\x05sides\x12!\n" +
+	"\fentropy_bits\x18\x04 \x01(\x01R\ventropyBits\"U\n" +
+	"\x19GeneratePassphraseRequest\x128\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1e.diceware.v1.PassphraseOptionsR\aoptions\"U\n" +
+	"\x1aGeneratePassphraseResponse\x127\n" +
+	"\n" +
+	"passphrase\x18\x01 \x01(\v2\x17.diceware.v1.PassphraseR\n" +
+	"passphrase\"f\n" +
+	"\x14GenerateBatchRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x128\n" +
+	"\aoptions\x18\x02 \x01(\v2\x1e.diceware.v1.PassphraseOptionsR\aoptions\"R\n" +
+	"\x15GenerateBatchResponse\x129\n" +
+	"\vpassphrases\x18\x01 \x03(\v2\x17.diceware.v1.PassphraseR\vpassphrases\"\x16\n" +
+	"\x14ListWordlistsRequest\"L\n" +
+	"\x15ListWordlistsResponse\x123\n" +
+	"\twordlists\x18\x01 \x03(\v2\x15.diceware.v1.WordlistR\twordlists2\xa1\x02\n" +
+	"\bDiceware\x12e\n" +
+	"\x12GeneratePassphrase\x12&.diceware.v1.GeneratePassphraseRequest\x1a'.diceware.v1.GeneratePassphraseResponse\x12V\n" +
+	"\rGenerateBatch\x12!.diceware.v1.GenerateBatchRequest\x1a\".diceware.v1.GenerateBatchResponse\x12V\n" +
+	"\rListWordlists\x12!.diceware.v1.ListWordlistsRequest\x1a\".diceware.v1.ListWordlistsResponseB2Z0github.com/everlastingbeta/diceware/dicewaregrpcb\x06proto3"
+
+var (
+	file_diceware_proto_rawDescOnce sync.Once
+	file_diceware_proto_rawDescData []byte
+)
+
+func file_diceware_proto_rawDescGZIP() []byte {
+	file_diceware_proto_rawDescOnce.Do(func() {
+		file_diceware_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_diceware_proto_rawDesc), len(file_diceware_proto_rawDesc)))
+	})
+	return file_diceware_proto_rawDescData
+}
+
+var file_diceware_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_diceware_proto_goTypes = []any{
+	(*PassphraseOptions)(nil),          // 0: diceware.v1.PassphraseOptions
+	(*Passphrase)(nil),                 // 1: diceware.v1.Passphrase
+	(*Wordlist)(nil),                   // 2: diceware.v1.Wordlist
+	(*GeneratePassphraseRequest)(nil),  // 3: diceware.v1.GeneratePassphraseRequest
+	(*GeneratePassphraseResponse)(nil), // 4: diceware.v1.GeneratePassphraseResponse
+	(*GenerateBatchRequest)(nil),       // 5: diceware.v1.GenerateBatchRequest
+	(*GenerateBatchResponse)(nil),      // 6: diceware.v1.GenerateBatchResponse
+	(*ListWordlistsRequest)(nil),       // 7: diceware.v1.ListWordlistsRequest
+	(*ListWordlistsResponse)(nil),      // 8: diceware.v1.ListWordlistsResponse
+}
+var file_diceware_proto_depIdxs = []int32{
+	0, // 0: diceware.v1.GeneratePassphraseRequest.options:type_name -> diceware.v1.PassphraseOptions
+	1, // 1: diceware.v1.GeneratePassphraseResponse.passphrase:type_name -> diceware.v1.Passphrase
+	0, // 2: diceware.v1.GenerateBatchRequest.options:type_name -> diceware.v1.PassphraseOptions
+	1, // 3: diceware.v1.GenerateBatchResponse.passphrases:type_name -> diceware.v1.Passphrase
+	2, // 4: diceware.v1.ListWordlistsResponse.wordlists:type_name -> diceware.v1.Wordlist
+	3, // 5: diceware.v1.Diceware.GeneratePassphrase:input_type -> diceware.v1.GeneratePassphraseRequest
+	5, // 6: diceware.v1.Diceware.GenerateBatch:input_type -> diceware.v1.GenerateBatchRequest
+	7, // 7: diceware.v1.Diceware.ListWordlists:input_type -> diceware.v1.ListWordlistsRequest
+	4, // 8: diceware.v1.Diceware.GeneratePassphrase:output_type -> diceware.v1.GeneratePassphraseResponse
+	6, // 9: diceware.v1.Diceware.GenerateBatch:output_type -> diceware.v1.GenerateBatchResponse
+	8, // 10: diceware.v1.Diceware.ListWordlists:output_type -> diceware.v1.ListWordlistsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_diceware_proto_init() }
+func file_diceware_proto_init() {
+	if File_diceware_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_diceware_proto_rawDesc), len(file_diceware_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_diceware_proto_goTypes,
+		DependencyIndexes: file_diceware_proto_depIdxs,
+		MessageInfos:      file_diceware_proto_msgTypes,
+	}.Build()
+	File_diceware_proto = out.File
+	file_diceware_proto_goTypes = nil
+	file_diceware_proto_depIdxs = nil
+}
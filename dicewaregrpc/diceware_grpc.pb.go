@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: diceware.proto
+
+package dicewaregrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Diceware_GeneratePassphrase_FullMethodName = "/diceware.v1.Diceware/GeneratePassphrase"
+	Diceware_GenerateBatch_FullMethodName      = "/diceware.v1.Diceware/GenerateBatch"
+	Diceware_ListWordlists_FullMethodName      = "/diceware.v1.Diceware/ListWordlists"
+)
+
+// DicewareClient is the client API for Diceware service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Diceware serves the diceware passphrases of a Generator.
+type DicewareClient interface {
+	// GeneratePassphrase generates a single passphrase.
+	GeneratePassphrase(ctx context.Context, in *GeneratePassphraseRequest, opts ...grpc.CallOption) (*GeneratePassphraseResponse, error)
+	// GenerateBatch generates several passphrases with the same options.
+	GenerateBatch(ctx context.Context, in *GenerateBatchRequest, opts ...grpc.CallOption) (*GenerateBatchResponse, error)
+	// ListWordlists lists the registered wordlists a request is able to name.
+	ListWordlists(ctx context.Context, in *ListWordlistsRequest, opts ...grpc.CallOption) (*ListWordlistsResponse, error)
+}
+
+type dicewareClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDicewareClient(cc grpc.ClientConnInterface) DicewareClient {
+	return &dicewareClient{cc}
+}
+
+func (c *dicewareClient) GeneratePassphrase(ctx context.Context, in *GeneratePassphraseRequest, opts ...grpc.CallOption) (*GeneratePassphraseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePassphraseResponse)
+	err := c.cc.Invoke(ctx, Diceware_GeneratePassphrase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dicewareClient) GenerateBatch(ctx context.Context, in *GenerateBatchRequest, opts ...grpc.CallOption) (*GenerateBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateBatchResponse)
+	err := c.cc.Invoke(ctx, Diceware_GenerateBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dicewareClient) ListWordlists(ctx context.Context, in *ListWordlistsRequest, opts ...grpc.CallOption) (*ListWordlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWordlistsResponse)
+	err := c.cc.Invoke(ctx, Diceware_ListWordlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DicewareServer is the server API for Diceware service.
+// All implementations must embed UnimplementedDicewareServer
+// for forward compatibility.
+//
+// Diceware serves the diceware passphrases of a Generator.
+type DicewareServer interface {
+	// GeneratePassphrase generates a single passphrase.
+	GeneratePassphrase(context.Context, *GeneratePassphraseRequest) (*GeneratePassphraseResponse, error)
+	// GenerateBatch generates several passphrases with the same options.
+	GenerateBatch(context.Context, *GenerateBatchRequest) (*GenerateBatchResponse, error)
+	// ListWordlists lists the registered wordlists a request is able to name.
+	ListWordlists(context.Context, *ListWordlistsRequest) (*ListWordlistsResponse, error)
+	mustEmbedUnimplementedDicewareServer()
+}
+
+// UnimplementedDicewareServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDicewareServer struct{}
+
+func (UnimplementedDicewareServer) GeneratePassphrase(context.Context, *GeneratePassphraseRequest) (*GeneratePassphraseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GeneratePassphrase not implemented")
+}
+func (UnimplementedDicewareServer) GenerateBatch(context.Context, *GenerateBatchRequest) (*GenerateBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateBatch not implemented")
+}
+func (UnimplementedDicewareServer) ListWordlists(context.Context, *ListWordlistsRequest) (*ListWordlistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWordlists not implemented")
+}
+func (UnimplementedDicewareServer) mustEmbedUnimplementedDicewareServer() {}
+func (UnimplementedDicewareServer) testEmbeddedByValue()                  {}
+
+// UnsafeDicewareServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DicewareServer will
+// result in compilation errors.
+type UnsafeDicewareServer interface {
+	mustEmbedUnimplementedDicewareServer()
+}
+
+func RegisterDicewareServer(s grpc.ServiceRegistrar, srv DicewareServer) {
+	// If the following call panics, it indicates UnimplementedDicewareServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Diceware_ServiceDesc, srv)
+}
+
+func _Diceware_GeneratePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DicewareServer).GeneratePassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Diceware_GeneratePassphrase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DicewareServer).GeneratePassphrase(ctx, req.(*GeneratePassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Diceware_GenerateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DicewareServer).GenerateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Diceware_GenerateBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DicewareServer).GenerateBatch(ctx, req.(*GenerateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Diceware_ListWordlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWordlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DicewareServer).ListWordlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Diceware_ListWordlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DicewareServer).ListWordlists(ctx, req.(*ListWordlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Diceware_ServiceDesc is the grpc.ServiceDesc for Diceware service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Diceware_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "diceware.v1.Diceware",
+	HandlerType: (*DicewareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GeneratePassphrase",
+			Handler:    _Diceware_GeneratePassphrase_Handler,
+		},
+		{
+			MethodName: "GenerateBatch",
+			Handler:    _Diceware_GenerateBatch_Handler,
+		},
+		{
+			MethodName: "ListWordlists",
+			Handler:    _Diceware_ListWordlists_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "diceware.proto",
+}
@@ -0,0 +1,193 @@
+// Package dicewaregrpc serves diceware passphrases over gRPC, as described by
+// the Diceware service of diceware.proto, so that services written in other
+// languages are able to use the same wordlists and generation.
+//
+// It is its own module, keeping gRPC out of the dependencies of the diceware
+// module itself.
+package dicewaregrpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative diceware.proto
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxWords represents the largest number of words a request is able to ask
+	// for.
+	MaxWords = diceware.MaxOverrideWords
+	// MaxSeparatorLength represents the largest number of characters a request
+	// is able to give as the separator.
+	MaxSeparatorLength = diceware.MaxOverrideSeparatorLength
+	// MaxBatchSize represents the largest number of passphrases a single
+	// GenerateBatch request is able to ask for.
+	MaxBatchSize = 100
+)
+
+// Server defines the DicewareServer serving the passphrases of a Generator.
+type Server struct {
+	UnimplementedDicewareServer
+
+	// generator represents the Generator used when a request does not change
+	// any of its options.
+	generator *diceware.Generator
+
+	// wordlists represents the names of the registered wordlists a request is
+	// able to select, where empty allows every registered wordlist.
+	wordlists []string
+}
+
+// ServerOption defines a function that modifies the Server created by
+// `NewServer`.
+type ServerOption func(*Server)
+
+// WithWordlists returns a ServerOption that restricts the wordlists a request
+// is able to select to the registered wordlists of the given names.
+func WithWordlists(names ...string) ServerOption {
+	return func(s *Server) {
+		s.wordlists = slices.Clone(names)
+	}
+}
+
+// NewServer returns an initialized Server object.
+// Implements the logic to serve the Diceware service with the given Generator,
+// which is registered with a gRPC server through RegisterDicewareServer.  The
+// number of words, the registered wordlist and the separator are able to be
+// changed for a request with its PassphraseOptions, keeping every other option
+// of the Generator.  Requests asking for fewer words than are needed to meet
+// the MinEntropyBits of the Generator fail with InvalidArgument, and every
+// registered wordlist is able to be selected unless restricted with
+// `WithWordlists`.
+func NewServer(gen *diceware.Generator, options ...ServerOption) *Server {
+	s := &Server{generator: gen}
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// GeneratePassphrase returns a *GeneratePassphraseResponse.
+// It implements the logic for the DicewareServer interface which generates a
+// single passphrase, failing with InvalidArgument for invalid options.
+func (s *Server) GeneratePassphrase(
+	ctx context.Context, req *GeneratePassphraseRequest,
+) (*GeneratePassphraseResponse, error) {
+	generator, err := s.requestGenerator(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := generate(ctx, generator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GeneratePassphraseResponse{Passphrase: passphrase}, nil
+}
+
+// GenerateBatch returns a *GenerateBatchResponse.
+// It implements the logic for the DicewareServer interface which generates the
+// requested number of passphrases with the same options, failing with
+// InvalidArgument for a count outside of [1, MaxBatchSize] or invalid options.
+func (s *Server) GenerateBatch(ctx context.Context, req *GenerateBatchRequest) (*GenerateBatchResponse, error) {
+	count := int(req.GetCount())
+	if count < 1 || count > MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", MaxBatchSize)
+	}
+
+	generator, err := s.requestGenerator(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	passphrases := make([]*Passphrase, 0, count)
+	for i := 0; i < count; i++ {
+		passphrase, err := generate(ctx, generator)
+		if err != nil {
+			return nil, err
+		}
+
+		passphrases = append(passphrases, passphrase)
+	}
+
+	return &GenerateBatchResponse{Passphrases: passphrases}, nil
+}
+
+// ListWordlists returns a *ListWordlistsResponse.
+// It implements the logic for the DicewareServer interface which describes
+// every wordlist a request is able to select, ordered by their names.
+func (s *Server) ListWordlists(context.Context, *ListWordlistsRequest) (*ListWordlistsResponse, error) {
+	names := wordlist.Names()
+	if len(s.wordlists) > 0 {
+		names = slices.Sorted(slices.Values(s.wordlists))
+	}
+
+	wordlists := make([]*Wordlist, 0, len(names))
+	for _, name := range names {
+		wl, err := wordlist.Get(name)
+		if err != nil {
+			continue
+		}
+
+		wordlists = append(wordlists, &Wordlist{
+			Name:        name,
+			Rolls:       int32(wl.Rolls()),
+			Sides:       int32(wl.SidesOfDice().Int64()),
+			EntropyBits: diceware.WordEntropy(wl),
+		})
+	}
+
+	return &ListWordlistsResponse{Wordlists: wordlists}, nil
+}
+
+// requestGenerator returns a Generator.
+// Implements the logic to apply the options of a request to the options of the
+// Generator through `diceware.Generator.Override`, returning the Generator
+// itself when none are set.
+func (s *Server) requestGenerator(options *PassphraseOptions) (*diceware.Generator, error) {
+	generator, err := s.generator.Override(diceware.Override{
+		Words:     int(options.GetWords()),
+		Wordlist:  options.GetWordlist(),
+		Separator: options.GetSeparator(),
+	}, s.wordlists...)
+	if err != nil {
+		var overrideErr *diceware.OverrideError
+		if errors.As(err, &overrideErr) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s %s", overrideErr.Field, overrideErr.Reason)
+		}
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return generator, nil
+}
+
+// generate returns a *Passphrase.
+// Implements the logic to generate a passphrase with the Generator, reporting
+// a cancelled request with the status of its context and any other failure as
+// Internal without its details.
+func generate(ctx context.Context, generator *diceware.Generator) (*Passphrase, error) {
+	passphrase, err := generator.GeneratePassphraseContext(ctx)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+
+		return nil, status.Error(codes.Internal, "unable to generate a passphrase")
+	}
+
+	return &Passphrase{
+		Passphrase:  passphrase.String(),
+		Words:       passphrase.Words,
+		Wordlist:    passphrase.Wordlist,
+		EntropyBits: passphrase.EntropyBits,
+	}, nil
+}
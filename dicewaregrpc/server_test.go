@@ -0,0 +1,321 @@
+package dicewaregrpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/dicewaregrpc"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testShort represents a wordlist of 4 dice with 6 sides registered for the
+// tests, so that they are able to select a wordlist other than the EFF long
+// wordlist by name when built with the diceware_minimal tag.
+var testShort = newTestShort()
+
+// newTestShort returns a Map.
+// Implements the logic to fill a wordlist of 4 dice with 6 sides with
+// numbered words.
+func newTestShort() *wordlist.Map {
+	words := make(map[int]string, 1296)
+	for i := 0; i < 1296; i++ {
+		words[wordlist.RollValueForIndex(i, 4, 6)] = fmt.Sprintf("word%d", i)
+	}
+
+	return wordlist.NewNamedMap("test-short", 4, 6, words)
+}
+
+// TestMain registers testShort before running the tests.
+func TestMain(m *testing.M) {
+	if err := wordlist.Register(testShort.Name(), testShort); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// newClient returns a DicewareClient.
+// Implements the logic to serve a Server of the given Generator and options
+// over an in-memory connection, stopping it once the test is done.
+func newClient(
+	t *testing.T, gen *diceware.Generator, options ...dicewaregrpc.ServerOption,
+) dicewaregrpc.DicewareClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	dicewaregrpc.RegisterDicewareServer(server, dicewaregrpc.NewServer(gen, options...))
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return dicewaregrpc.NewDicewareClient(conn)
+}
+
+func TestServerGeneratePassphrase(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	client := newClient(t, generator)
+
+	tests := []struct {
+		Name     string
+		Options  *dicewaregrpc.PassphraseOptions
+		Words    int
+		Sep      string
+		Wordlist *wordlist.Map
+		Code     codes.Code
+		Error    string
+	}{
+		{
+			Name:     "will generate a passphrase of the Generator",
+			Words:    6,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will apply the options of the request",
+			Options:  &dicewaregrpc.PassphraseOptions{Words: 4, Wordlist: "test-short", Separator: "."},
+			Words:    4,
+			Sep:      ".",
+			Wordlist: testShort,
+		}, {
+			Name:     "will keep the options of the Generator which are not set",
+			Options:  &dicewaregrpc.PassphraseOptions{Separator: "_"},
+			Words:    6,
+			Sep:      "_",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:    "will reject too many words",
+			Options: &dicewaregrpc.PassphraseOptions{Words: 65},
+			Code:    codes.InvalidArgument,
+			Error:   "words must be between 1 and 64",
+		}, {
+			Name:    "will reject a negative number of words",
+			Options: &dicewaregrpc.PassphraseOptions{Words: -1},
+			Code:    codes.InvalidArgument,
+			Error:   "words must be between 1 and 64",
+		}, {
+			Name:    "will reject an unknown wordlist",
+			Options: &dicewaregrpc.PassphraseOptions{Wordlist: "../../etc/passwd"},
+			Code:    codes.InvalidArgument,
+			Error:   "wordlist must be one of",
+		}, {
+			Name:    "will reject a long separator",
+			Options: &dicewaregrpc.PassphraseOptions{Separator: "123456789"},
+			Code:    codes.InvalidArgument,
+			Error:   "separator must be at most 8 characters",
+		},
+	}
+
+	for _, test := range tests {
+		response, err := client.GeneratePassphrase(context.Background(),
+			&dicewaregrpc.GeneratePassphraseRequest{Options: test.Options})
+		if test.Error != "" {
+			assert.Equal(test.Code, status.Code(err), test.Name)
+			assert.Contains(status.Convert(err).Message(), test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		passphrase := response.GetPassphrase()
+		assert.Len(passphrase.GetWords(), test.Words, test.Name)
+		assert.Equal(test.Wordlist.Name(), passphrase.GetWordlist(), test.Name)
+		assert.InDelta(float64(test.Words)*diceware.WordEntropy(test.Wordlist), passphrase.GetEntropyBits(), 0.01,
+			test.Name)
+		assert.Equal(strings.Join(passphrase.GetWords(), test.Sep), passphrase.GetPassphrase(), test.Name)
+
+		for _, word := range passphrase.GetWords() {
+			_, found := wordlist.Index(test.Wordlist).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
+
+func TestServerGenerateBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	client := newClient(t, generator)
+
+	tests := []struct {
+		Name    string
+		Count   int32
+		Options *dicewaregrpc.PassphraseOptions
+		Words   int
+		Code    codes.Code
+	}{
+		{
+			Name:  "will generate the requested number of passphrases",
+			Count: 5,
+			Words: 6,
+		}, {
+			Name:    "will apply the options of the request to every passphrase",
+			Count:   3,
+			Options: &dicewaregrpc.PassphraseOptions{Words: 2},
+			Words:   2,
+		}, {
+			Name:  "will generate the largest batch",
+			Count: dicewaregrpc.MaxBatchSize,
+			Words: 6,
+		}, {
+			Name:  "will reject an empty batch",
+			Count: 0,
+			Code:  codes.InvalidArgument,
+		}, {
+			Name:  "will reject too large a batch",
+			Count: dicewaregrpc.MaxBatchSize + 1,
+			Code:  codes.InvalidArgument,
+		}, {
+			Name:    "will reject invalid options",
+			Count:   1,
+			Options: &dicewaregrpc.PassphraseOptions{Wordlist: "unknown"},
+			Code:    codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		response, err := client.GenerateBatch(context.Background(),
+			&dicewaregrpc.GenerateBatchRequest{Count: test.Count, Options: test.Options})
+		if test.Code != codes.OK {
+			assert.Equal(test.Code, status.Code(err), test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Len(response.GetPassphrases(), int(test.Count), test.Name)
+		for _, passphrase := range response.GetPassphrases() {
+			assert.Len(passphrase.GetWords(), test.Words, test.Name)
+		}
+	}
+}
+
+func TestServerListWordlists(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{WordCount: 6, Wordlist: wordlist.EFFLong})
+	if !assert.NoError(err) {
+		return
+	}
+
+	response, err := newClient(t, generator).ListWordlists(context.Background(), &dicewaregrpc.ListWordlistsRequest{})
+	if !assert.NoError(err) {
+		return
+	}
+
+	names := []string{}
+	for _, wl := range response.GetWordlists() {
+		names = append(names, wl.GetName())
+
+		if wl.GetName() == wordlist.EFFLong.Name() {
+			assert.Equal(int32(5), wl.GetRolls())
+			assert.Equal(int32(6), wl.GetSides())
+			assert.InDelta(diceware.WordEntropy(wordlist.EFFLong), wl.GetEntropyBits(), 0.01)
+		}
+	}
+
+	assert.Equal(wordlist.Names(), names)
+}
+
+func TestServerMinEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		MinEntropyBits: 64,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	client := newClient(t, generator, dicewaregrpc.WithWordlists("eff-long"))
+
+	tests := []struct {
+		Name    string
+		Options *dicewaregrpc.PassphraseOptions
+		Words   int
+		Error   string
+	}{
+		{
+			Name:  "will meet the minimum entropy of the Generator",
+			Words: 5,
+		}, {
+			Name:    "will accept more words than the minimum entropy needs",
+			Options: &dicewaregrpc.PassphraseOptions{Words: 7},
+			Words:   7,
+		}, {
+			Name:    "will reject fewer words than the minimum entropy needs",
+			Options: &dicewaregrpc.PassphraseOptions{Words: 1},
+			Error:   "words must be at least 5 to provide 64 bits of entropy",
+		}, {
+			Name:    "will reject a registered wordlist that is not allowed",
+			Options: &dicewaregrpc.PassphraseOptions{Wordlist: "original"},
+			Error:   "wordlist must be one of: eff-long",
+		},
+	}
+
+	for _, test := range tests {
+		response, err := client.GeneratePassphrase(context.Background(),
+			&dicewaregrpc.GeneratePassphraseRequest{Options: test.Options})
+		if test.Error != "" {
+			assert.Equal(codes.InvalidArgument, status.Code(err), test.Name)
+			assert.Equal(test.Error, status.Convert(err).Message(), test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Len(response.GetPassphrase().GetWords(), test.Words, test.Name)
+			assert.GreaterOrEqual(response.GetPassphrase().GetEntropyBits(), 64.0, test.Name)
+		}
+	}
+
+	response, err := client.ListWordlists(context.Background(), &dicewaregrpc.ListWordlistsRequest{})
+	if assert.NoError(err) && assert.Len(response.GetWordlists(), 1) {
+		assert.Equal("eff-long", response.GetWordlists()[0].GetName())
+	}
+}
@@ -0,0 +1,193 @@
+// Package dicewarehash hashes passphrases for storage with Argon2id (RFC 9106),
+// so that the passphrases generated for accounts are able to be stored and
+// verified without keeping the passphrases themselves.
+//
+// Hashes are encoded in the PHC string format used by the reference
+// implementation of Argon2, e.g.
+//
+//	$argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>
+//
+// where the salt and key are unpadded standard base64, so that they are able
+// to be verified by any other Argon2 implementation.
+package dicewarehash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// MaxMemory represents the largest memory in KiB a hash is able to be
+	// created or verified with, 1 GiB, which stops a tampered hash from
+	// exhausting the memory of the service verifying it.
+	MaxMemory = 1 << 20
+	// MaxTime represents the largest number of passes a hash is able to be
+	// created or verified with, which stops a tampered hash from occupying the
+	// service verifying it.
+	MaxTime = 16
+)
+
+var (
+	// ErrInvalidParams represents the error given when a hash is requested
+	// with parameters that Argon2id is unable to use
+	ErrInvalidParams = errors.New("invalid Argon2id parameters given")
+	// ErrInvalidHash represents the error given when a hash is not an encoded
+	// Argon2id hash
+	ErrInvalidHash = errors.New("invalid encoded Argon2id hash given")
+	// ErrIncompatibleVersion represents the error given when a hash was created
+	// by a different version of Argon2
+	ErrIncompatibleVersion = errors.New("incompatible Argon2 version")
+)
+
+// Params defines the parameters a passphrase is hashed with.
+type Params struct {
+	// Time represents the number of passes made over the memory, at most
+	// MaxTime.
+	Time uint32
+
+	// Memory represents the memory in KiB used by each hash, at most
+	// MaxMemory.
+	Memory uint32
+
+	// Threads represents the number of lanes the memory is split into, and
+	// the number of goroutines computing them.
+	Threads uint8
+
+	// SaltLength represents the length in bytes of the random salt of each
+	// hash, at least 8.
+	SaltLength uint32
+
+	// KeyLength represents the length in bytes of the hash, at least 4.
+	KeyLength uint32
+}
+
+// DefaultParams returns a Params.
+// Implements the logic to give the parameters used by `Hash`, which are the
+// second recommended option of RFC 9106: 3 passes over 64 MiB of memory with 4
+// lanes, a 16 byte salt and a 32 byte hash.
+func DefaultParams() Params {
+	return Params{
+		Time:       3,
+		Memory:     64 << 10,
+		Threads:    4,
+		SaltLength: 16,
+		KeyLength:  32,
+	}
+}
+
+// validate returns an error.
+// Implements the logic to verify that Argon2id is able to use the parameters.
+func (p Params) validate() error {
+	if p.Time < 1 || p.Time > MaxTime || p.Threads < 1 || p.Memory < 8*uint32(p.Threads) || p.Memory > MaxMemory ||
+		p.SaltLength < 8 || p.KeyLength < 4 {
+		return ErrInvalidParams
+	}
+
+	return nil
+}
+
+// Hash returns a string.
+// Implements the logic to hash the passphrase with Argon2id and the
+// DefaultParams, encoding the parameters, salt and hash together.
+func Hash(passphrase string) (string, error) {
+	return HashWithParams(passphrase, DefaultParams())
+}
+
+// HashWithParams returns a string.
+// Implements the logic to hash the passphrase with Argon2id, the given
+// parameters and a random salt read from `crypto/rand`, encoding the
+// parameters, salt and hash together.
+func HashWithParams(passphrase string, p Params) (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(passphrase), salt, p.Time, p.Memory, p.Threads, p.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify returns a bool.
+// Implements the logic to hash the passphrase with the parameters and salt of
+// the encoded hash, and compare the result to the hash in constant time.  An
+// error is only returned when the encoded hash is unable to be used, not when
+// the passphrase does not match.
+func Verify(passphrase, encoded string) (bool, error) {
+	p, salt, key, err := decode(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey([]byte(passphrase), salt, p.Time, p.Memory, p.Threads, p.KeyLength)
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// NeedsRehash returns a bool.
+// Implements the logic to check whether the encoded hash was created with
+// parameters other than the given ones, so that the passphrase is hashed
+// again with them once it has been verified.
+func NeedsRehash(encoded string, p Params) (bool, error) {
+	current, _, _, err := decode(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	return current != p, nil
+}
+
+// decode returns a Params and 2 slices of bytes.
+// Implements the logic to read the parameters, salt and hash of an encoded
+// Argon2id hash, rejecting the parameters above MaxTime or MaxMemory before
+// any hash is computed with them.
+func decode(encoded string) (Params, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || parts[2] != fmt.Sprintf("v=%d", version) {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	if version != argon2.Version {
+		return Params{}, nil, nil, fmt.Errorf("%w: %d", ErrIncompatibleVersion, version)
+	}
+
+	var p Params
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads)
+	if err != nil || parts[3] != fmt.Sprintf("m=%d,t=%d,p=%d", p.Memory, p.Time, p.Threads) {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.Strict().DecodeString(parts[4])
+	if err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	key, err := base64.RawStdEncoding.Strict().DecodeString(parts[5])
+	if err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	if err := p.validate(); err != nil {
+		return Params{}, nil, nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	return p, salt, key, nil
+}
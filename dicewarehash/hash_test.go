@@ -0,0 +1,185 @@
+package dicewarehash_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/dicewarehash"
+	"github.com/stretchr/testify/assert"
+)
+
+// fastParams are the parameters used to keep the tests quick.
+var fastParams = dicewarehash.Params{Time: 1, Memory: 64, Threads: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHash(t *testing.T) {
+	assert := assert.New(t)
+
+	encoded, err := dicewarehash.Hash("correct horse battery staple")
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.True(strings.HasPrefix(encoded, "$argon2id$v=19$m=65536,t=3,p=4$"))
+
+	matched, err := dicewarehash.Verify("correct horse battery staple", encoded)
+	assert.NoError(err)
+	assert.True(matched)
+
+	again, err := dicewarehash.Hash("correct horse battery staple")
+	assert.NoError(err)
+	assert.NotEqual(encoded, again, "expected a random salt for every hash")
+}
+
+func TestHashWithParams(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name   string
+		Params dicewarehash.Params
+		Prefix string
+		Error  error
+	}{
+		{
+			Name:   "will encode the parameters",
+			Params: fastParams,
+			Prefix: "$argon2id$v=19$m=64,t=1,p=1$",
+		}, {
+			Name:   "will error without any passes",
+			Params: dicewarehash.Params{Memory: 64, Threads: 1, SaltLength: 16, KeyLength: 32},
+			Error:  dicewarehash.ErrInvalidParams,
+		}, {
+			Name:   "will error with less than 8 KiB of memory for each lane",
+			Params: dicewarehash.Params{Time: 1, Memory: 31, Threads: 4, SaltLength: 16, KeyLength: 32},
+			Error:  dicewarehash.ErrInvalidParams,
+		}, {
+			Name:   "will error with more than the maximum memory",
+			Params: dicewarehash.Params{Time: 1, Memory: dicewarehash.MaxMemory + 1, Threads: 1, SaltLength: 16, KeyLength: 32},
+			Error:  dicewarehash.ErrInvalidParams,
+		}, {
+			Name:   "will error with more than the maximum passes",
+			Params: dicewarehash.Params{Time: dicewarehash.MaxTime + 1, Memory: 64, Threads: 1, SaltLength: 16, KeyLength: 32},
+			Error:  dicewarehash.ErrInvalidParams,
+		}, {
+			Name:   "will error with a short salt",
+			Params: dicewarehash.Params{Time: 1, Memory: 64, Threads: 1, SaltLength: 7, KeyLength: 32},
+			Error:  dicewarehash.ErrInvalidParams,
+		},
+	}
+
+	for _, test := range tests {
+		encoded, err := dicewarehash.HashWithParams("correct horse battery staple", test.Params)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.True(strings.HasPrefix(encoded, test.Prefix), test.Name)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	encoded, err := dicewarehash.HashWithParams("correct horse battery staple", fastParams)
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Encoded    string
+		Matched    bool
+		Error      error
+	}{
+		{
+			Name:       "will match the passphrase of the hash",
+			Passphrase: "correct horse battery staple",
+			Encoded:    encoded,
+			Matched:    true,
+		}, {
+			Name:       "will not match another passphrase",
+			Passphrase: "correct horse battery stapler",
+			Encoded:    encoded,
+		}, {
+			Name:       "will match the hash of the Argon2 reference implementation",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Matched:    true,
+		}, {
+			Name:       "will error with a hash of another algorithm",
+			Passphrase: "password",
+			Encoded:    "$argon2i$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidHash,
+		}, {
+			Name:       "will error with a hash of another version",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=16$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrIncompatibleVersion,
+		}, {
+			Name:       "will error with malformed parameters",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=65536,t=2,p=1,x=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidHash,
+		}, {
+			Name:       "will error with more than the maximum memory",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=4294967295,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidParams,
+		}, {
+			Name:       "will error with just more than the maximum memory",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=1048577,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidParams,
+		}, {
+			Name:       "will error with more than the maximum passes",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=65536,t=4294967295,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidParams,
+		}, {
+			Name:       "will error with a salt which is not base64",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ!$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+			Error:      dicewarehash.ErrInvalidHash,
+		}, {
+			Name:       "will error with a missing hash",
+			Passphrase: "password",
+			Encoded:    "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ",
+			Error:      dicewarehash.ErrInvalidHash,
+		},
+	}
+
+	for _, test := range tests {
+		matched, err := dicewarehash.Verify(test.Passphrase, test.Encoded)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Matched, matched, test.Name)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	assert := assert.New(t)
+
+	encoded, err := dicewarehash.HashWithParams("correct horse battery staple", fastParams)
+	if !assert.NoError(err) {
+		return
+	}
+
+	rehash, err := dicewarehash.NeedsRehash(encoded, fastParams)
+	assert.NoError(err)
+	assert.False(rehash)
+
+	rehash, err = dicewarehash.NeedsRehash(encoded, dicewarehash.DefaultParams())
+	assert.NoError(err)
+	assert.True(rehash)
+
+	_, err = dicewarehash.NeedsRehash("$argon2id$", dicewarehash.DefaultParams())
+	assert.ErrorIs(err, dicewarehash.ErrInvalidHash)
+}
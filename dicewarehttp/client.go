@@ -0,0 +1,162 @@
+package dicewarehttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxResponseSize represents the largest response body in bytes that the
+// Client will read.
+const maxResponseSize = 1 << 20
+
+// ErrInvalidBaseURL represents the error given when a Client is used without
+// an absolute HTTP or HTTPS base URL
+var ErrInvalidBaseURL = errors.New("invalid base URL, must be an absolute http or https URL")
+
+// StatusError defines the error given when the service responds to a request
+// with anything other than a passphrase, retaining the status of the
+// response.
+type StatusError struct {
+	// StatusCode represents the HTTP status code of the response.
+	StatusCode int
+
+	// Message represents the description of the failure given by the service.
+	Message string
+}
+
+// Error returns a string.
+// It implements the logic for the error interface which describes the status
+// of the response and the failure given by the service.
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("passphrase request failed with status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("passphrase request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// PassphraseRequest defines the changes a request makes to the options of the
+// Generator serving it, where each zero value keeps the option of the
+// Generator.
+type PassphraseRequest struct {
+	// Words represents the number of words within the passphrase, between 1
+	// and MaxWords.
+	Words int
+
+	// Wordlist represents the name of the registered wordlist the words are
+	// rolled from.
+	Wordlist string
+
+	// Separator represents the character(s) placed between the words, at most
+	// MaxSeparatorLength characters long.
+	Separator string
+}
+
+// Client defines the caller of a service serving the handler of NewHandler, as
+// described by the OpenAPI document.  A Client is safe for concurrent use.
+type Client struct {
+	// BaseURL represents the URL the handler is served from, which
+	// PassphrasePath is joined to, e.g. "https://diceware.example.com".
+	BaseURL string
+
+	// HTTPClient represents the HTTP client used to call the service.  When
+	// nil, `http.DefaultClient` is used.
+	HTTPClient *http.Client
+}
+
+// Passphrase returns a Response.
+// Implements the logic to request a passphrase from the service with the
+// changes to its options given by the request.  A response other than
+// 200 OK is returned as a *StatusError holding the failure given by the
+// service.
+func (c *Client) Passphrase(ctx context.Context, req PassphraseRequest) (*Response, error) {
+	endpoint, err := c.endpoint(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, &StatusError{StatusCode: response.StatusCode, Message: errorMessage(response, body)}
+	}
+
+	var passphrase Response
+	if err := json.Unmarshal(body, &passphrase); err != nil {
+		return nil, fmt.Errorf("invalid passphrase response: %w", err)
+	}
+
+	return &passphrase, nil
+}
+
+// endpoint returns a string.
+// Implements the logic to join PassphrasePath to the base URL, along with the
+// query parameters of the request.
+func (c *Client) endpoint(req PassphraseRequest) (string, error) {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return "", ErrInvalidBaseURL
+	}
+
+	query := url.Values{"format": {"json"}}
+	if req.Words != 0 {
+		query.Set("words", strconv.Itoa(req.Words))
+	}
+
+	if req.Wordlist != "" {
+		query.Set("list", req.Wordlist)
+	}
+
+	if req.Separator != "" {
+		query.Set("sep", req.Separator)
+	}
+
+	endpoint := base.JoinPath(PassphrasePath)
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint.String(), nil
+}
+
+// errorMessage returns a string.
+// Implements the logic to read the failure given by the service from the body
+// of a response, as an ErrorResponse or as plain text.
+func errorMessage(response *http.Response, body []byte) string {
+	mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		var failure ErrorResponse
+		if err := json.Unmarshal(body, &failure); err == nil {
+			return failure.Error
+		}
+	}
+
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,102 @@
+package dicewarehttp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/dicewarehttp"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPassphrase(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	server := httptest.NewServer(dicewarehttp.NewHandler(generator))
+	defer server.Close()
+
+	plainText := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+	}))
+	defer plainText.Close()
+
+	tests := []struct {
+		Name     string
+		BaseURL  string
+		Request  dicewarehttp.PassphraseRequest
+		Words    int
+		Sep      string
+		Wordlist *wordlist.Map
+		Status   int
+		Error    string
+	}{
+		{
+			Name:     "will request a passphrase of the Generator",
+			BaseURL:  server.URL,
+			Words:    6,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will request the changes to the options",
+			BaseURL:  server.URL + "/",
+			Request:  dicewarehttp.PassphraseRequest{Words: 4, Wordlist: "test-short", Separator: "&"},
+			Words:    4,
+			Sep:      "&",
+			Wordlist: testShort,
+		}, {
+			Name:    "will return the failure given by the service",
+			BaseURL: server.URL,
+			Request: dicewarehttp.PassphraseRequest{Words: 65},
+			Status:  http.StatusBadRequest,
+			Error:   "passphrase request failed with status 400: invalid query parameter: words must be between 1 and 64",
+		}, {
+			Name:    "will return a failure given as plain text",
+			BaseURL: plainText.URL,
+			Status:  http.StatusServiceUnavailable,
+			Error:   "passphrase request failed with status 503: service unavailable",
+		}, {
+			Name:    "will reject a relative base URL",
+			BaseURL: "diceware.example.com",
+			Error:   dicewarehttp.ErrInvalidBaseURL.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		client := &dicewarehttp.Client{BaseURL: test.BaseURL}
+
+		response, err := client.Passphrase(context.Background(), test.Request)
+		if test.Error != "" {
+			assert.EqualError(err, test.Error, test.Name)
+
+			var statusErr *dicewarehttp.StatusError
+			if test.Status != 0 && assert.ErrorAs(err, &statusErr, test.Name) {
+				assert.Equal(test.Status, statusErr.StatusCode, test.Name)
+			}
+
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Len(response.Words, test.Words, test.Name)
+		assert.Equal(strings.Join(response.Words, test.Sep), response.Passphrase, test.Name)
+		assert.Equal(test.Wordlist.Name(), response.Wordlist, test.Name)
+		assert.InDelta(float64(test.Words)*diceware.WordEntropy(test.Wordlist), response.EntropyBits, 0.01,
+			test.Name)
+	}
+}
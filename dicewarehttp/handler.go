@@ -0,0 +1,241 @@
+// Package dicewarehttp serves diceware passphrases over HTTP, as a reference
+// implementation of a passphrase microservice, along with the OpenAPI
+// document describing it and a Client calling it.
+package dicewarehttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/everlastingbeta/diceware"
+)
+
+const (
+	// MaxWords represents the largest number of words a request is able to ask
+	// for.
+	MaxWords = diceware.MaxOverrideWords
+	// MaxSeparatorLength represents the largest number of characters a request
+	// is able to give as the separator.
+	MaxSeparatorLength = diceware.MaxOverrideSeparatorLength
+	// PassphrasePath represents the path the passphrases are served from.
+	PassphrasePath = "/passphrase"
+)
+
+var (
+	// ErrUnknownParameter represents the error given when a request holds a
+	// query parameter that is not accepted
+	ErrUnknownParameter = errors.New("unknown query parameter")
+	// ErrInvalidParameter represents the error given when a query parameter of
+	// a request holds an invalid value
+	ErrInvalidParameter = errors.New("invalid query parameter")
+)
+
+// parameterNames represents the query parameter of each field of a
+// diceware.Override.
+var parameterNames = map[string]string{
+	"words":     "words",
+	"wordlist":  "list",
+	"separator": "sep",
+}
+
+// Response defines the JSON object returned for each passphrase.
+type Response struct {
+	// Passphrase represents the generated passphrase.
+	Passphrase string `json:"passphrase"`
+
+	// Words represents each of the words within the passphrase.
+	Words []string `json:"words"`
+
+	// Wordlist represents the name of the wordlist the words were rolled from.
+	Wordlist string `json:"wordlist,omitempty"`
+
+	// EntropyBits represents the entropy in bits of the passphrase.
+	EntropyBits float64 `json:"entropy_bits"`
+}
+
+// ErrorResponse defines the JSON object returned when a request fails.
+type ErrorResponse struct {
+	// Error represents the description of the failure.
+	Error string `json:"error"`
+}
+
+// handler defines the http.Handler serving the passphrases of a Generator.
+type handler struct {
+	// generator represents the Generator used when a request does not change
+	// any of its options.
+	generator *diceware.Generator
+
+	// wordlists represents the names of the registered wordlists the list
+	// query parameter is able to select, where empty allows every registered
+	// wordlist.
+	wordlists []string
+}
+
+// HandlerOption defines a function that modifies the handler created by
+// `NewHandler`.
+type HandlerOption func(*handler)
+
+// WithWordlists returns a HandlerOption that restricts the list query
+// parameter to the registered wordlists of the given names.
+func WithWordlists(names ...string) HandlerOption {
+	return func(h *handler) {
+		h.wordlists = slices.Clone(names)
+	}
+}
+
+// NewHandler returns an http.Handler.
+// Implements the logic to serve `GET /passphrase`, generating a passphrase
+// with the given Generator for every request.  The number of words, the
+// registered wordlist and the separator are able to be changed for a request
+// with the words, list and sep query parameters, e.g.
+// "/passphrase?words=6&list=eff-long&sep=-", keeping every other option of the
+// Generator.  The passphrase is written as a Response when the request
+// accepts "application/json" or gives format=json, and as plain text
+// otherwise.  Requests with unknown or invalid query parameters are rejected
+// with 400 Bad Request, as are requests asking for fewer words than are needed
+// to meet the MinEntropyBits of the Generator, and every response forbids
+// caching.  Every registered wordlist is able to be selected by a request
+// unless restricted with `WithWordlists`.
+func NewHandler(gen *diceware.Generator, options ...HandlerOption) http.Handler {
+	h := &handler{generator: gen}
+	for _, option := range options {
+		option(h)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET "+PassphrasePath, h)
+
+	return mux
+}
+
+// ServeHTTP implements the logic for the http.Handler interface, writing a
+// passphrase generated for the request.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	header := w.Header()
+	header.Set("Cache-Control", "no-store")
+	header.Set("Pragma", "no-cache")
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	asJSON := acceptsJSON(r)
+
+	generator, err := h.requestGenerator(r)
+	if err != nil {
+		writeError(w, asJSON, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	passphrase, err := generator.GeneratePassphraseContext(r.Context())
+	if err != nil {
+		writeError(w, asJSON, http.StatusInternalServerError, "unable to generate a passphrase")
+		return
+	}
+
+	if !asJSON {
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = fmt.Fprintln(w, passphrase.String())
+		return
+	}
+
+	header.Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Response{
+		Passphrase:  passphrase.String(),
+		Words:       passphrase.Words,
+		Wordlist:    passphrase.Wordlist,
+		EntropyBits: passphrase.EntropyBits,
+	})
+}
+
+// requestGenerator returns a Generator.
+// Implements the logic to apply the query parameters of the request to the
+// options of the Generator through `diceware.Generator.Override`, returning
+// the Generator itself when none are given.
+func (h *handler) requestGenerator(r *http.Request) (*diceware.Generator, error) {
+	query := r.URL.Query()
+	for name, values := range query {
+		switch name {
+		case "words", "list", "sep", "format":
+		default:
+			return nil, fmt.Errorf("%w %q", ErrUnknownParameter, name)
+		}
+
+		if len(values) != 1 {
+			return nil, fmt.Errorf("%w: %s given more than once", ErrInvalidParameter, name)
+		}
+	}
+
+	if format := query.Get("format"); format != "" && format != "json" && format != "text" {
+		return nil, fmt.Errorf("%w: format must be json or text", ErrInvalidParameter)
+	}
+
+	override := diceware.Override{Wordlist: query.Get("list"), Separator: query.Get("sep")}
+	if query.Has("words") {
+		words, err := strconv.Atoi(query.Get("words"))
+		if err != nil || words < 1 {
+			return nil, fmt.Errorf("%w: words must be between 1 and %d", ErrInvalidParameter, MaxWords)
+		}
+
+		override.Words = words
+	}
+
+	if query.Has("list") && override.Wordlist == "" {
+		return nil, fmt.Errorf("%w: list must not be empty", ErrInvalidParameter)
+	}
+
+	if query.Has("sep") && override.Separator == "" {
+		return nil, fmt.Errorf("%w: sep must not be empty", ErrInvalidParameter)
+	}
+
+	generator, err := h.generator.Override(override, h.wordlists...)
+	if err != nil {
+		var overrideErr *diceware.OverrideError
+		if errors.As(err, &overrideErr) {
+			return nil, fmt.Errorf("%w: %s %s", ErrInvalidParameter, parameterNames[overrideErr.Field],
+				overrideErr.Reason)
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+
+	return generator, nil
+}
+
+// acceptsJSON returns a bool.
+// Implements the logic to check whether the response to the request should be
+// JSON, which is asked for by format=json or an Accept header naming
+// "application/json".
+func acceptsJSON(r *http.Request) bool {
+	switch r.URL.Query().Get("format") {
+	case "json":
+		return true
+	case "text":
+		return false
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted)); err == nil &&
+			mediaType == "application/json" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeError implements the logic to write the failure of a request with the
+// given status, as an ErrorResponse or as plain text.
+func writeError(w http.ResponseWriter, asJSON bool, status int, message string) {
+	if !asJSON {
+		http.Error(w, message, status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+}
@@ -0,0 +1,283 @@
+package dicewarehttp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/dicewarehttp"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// testShort represents a wordlist of 4 dice with 6 sides registered for the
+// tests, so that they are able to select a wordlist other than the EFF long
+// wordlist by name when built with the diceware_minimal tag.
+var testShort = newTestShort()
+
+// newTestShort returns a Map.
+// Implements the logic to fill a wordlist of 4 dice with 6 sides with
+// numbered words.
+func newTestShort() *wordlist.Map {
+	words := make(map[int]string, 1296)
+	for i := 0; i < 1296; i++ {
+		words[wordlist.RollValueForIndex(i, 4, 6)] = fmt.Sprintf("word%d", i)
+	}
+
+	return wordlist.NewNamedMap("test-short", 4, 6, words)
+}
+
+// TestMain registers testShort before running the tests.
+func TestMain(m *testing.M) {
+	if err := wordlist.Register(testShort.Name(), testShort); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestNewHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	handler := dicewarehttp.NewHandler(generator)
+
+	tests := []struct {
+		Name        string
+		Method      string
+		Target      string
+		Accept      string
+		Status      int
+		ContentType string
+		Words       int
+		Sep         string
+		Wordlist    *wordlist.Map
+		Error       string
+	}{
+		{
+			Name:        "will write a passphrase of the Generator as plain text",
+			Target:      "/passphrase",
+			Status:      http.StatusOK,
+			ContentType: "text/plain; charset=utf-8",
+			Words:       6,
+			Sep:         " ",
+			Wordlist:    wordlist.EFFLong,
+		}, {
+			Name:        "will apply the query parameters",
+			Target:      "/passphrase?words=4&list=test-short&sep=.",
+			Status:      http.StatusOK,
+			ContentType: "text/plain; charset=utf-8",
+			Words:       4,
+			Sep:         ".",
+			Wordlist:    testShort,
+		}, {
+			Name:        "will write JSON when accepted",
+			Target:      "/passphrase?words=3",
+			Accept:      "text/html, application/json;q=0.9",
+			Status:      http.StatusOK,
+			ContentType: "application/json",
+			Words:       3,
+			Sep:         " ",
+			Wordlist:    wordlist.EFFLong,
+		}, {
+			Name:        "will write JSON when asked for by the format",
+			Target:      "/passphrase?format=json&sep=_",
+			Status:      http.StatusOK,
+			ContentType: "application/json",
+			Words:       6,
+			Sep:         "_",
+			Wordlist:    wordlist.EFFLong,
+		}, {
+			Name:        "will reject too many words",
+			Target:      "/passphrase?words=65&format=json",
+			Status:      http.StatusBadRequest,
+			ContentType: "application/json",
+			Error:       "invalid query parameter: words must be between 1 and 64",
+		}, {
+			Name:        "will reject an unknown wordlist",
+			Target:      "/passphrase?list=../../etc/passwd",
+			Status:      http.StatusBadRequest,
+			ContentType: "text/plain; charset=utf-8",
+			Error:       "invalid query parameter: list must be one of",
+		}, {
+			Name:        "will reject a long separator",
+			Target:      "/passphrase?sep=123456789",
+			Status:      http.StatusBadRequest,
+			ContentType: "text/plain; charset=utf-8",
+			Error:       "invalid query parameter: sep must be at most 8 characters",
+		}, {
+			Name:        "will reject an empty separator",
+			Target:      "/passphrase?sep=",
+			Status:      http.StatusBadRequest,
+			ContentType: "text/plain; charset=utf-8",
+			Error:       "invalid query parameter: sep must not be empty",
+		}, {
+			Name:        "will reject an unknown query parameter",
+			Target:      "/passphrase?capitalize=true",
+			Status:      http.StatusBadRequest,
+			ContentType: "text/plain; charset=utf-8",
+			Error:       `unknown query parameter "capitalize"`,
+		}, {
+			Name:        "will reject a repeated query parameter",
+			Target:      "/passphrase?words=3&words=4",
+			Status:      http.StatusBadRequest,
+			ContentType: "text/plain; charset=utf-8",
+			Error:       "words given more than once",
+		}, {
+			Name:   "will reject other methods",
+			Method: http.MethodPost,
+			Target: "/passphrase",
+			Status: http.StatusMethodNotAllowed,
+		}, {
+			Name:   "will not serve other paths",
+			Target: "/",
+			Status: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		method := test.Method
+		if method == "" {
+			method = http.MethodGet
+		}
+
+		request := httptest.NewRequest(method, test.Target, http.NoBody)
+		if test.Accept != "" {
+			request.Header.Set("Accept", test.Accept)
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(test.Status, recorder.Code, test.Name)
+		if test.ContentType == "" {
+			continue
+		}
+
+		assert.Equal(test.ContentType, recorder.Header().Get("Content-Type"), test.Name)
+		assert.Equal("no-store", recorder.Header().Get("Cache-Control"), test.Name)
+		assert.Equal("nosniff", recorder.Header().Get("X-Content-Type-Options"), test.Name)
+
+		body := recorder.Body.String()
+		if test.Error != "" {
+			assert.Contains(body, test.Error, test.Name)
+			continue
+		}
+
+		passphrase := strings.TrimSuffix(body, "\n")
+		if test.ContentType == "application/json" {
+			var response dicewarehttp.Response
+			if !assert.NoError(json.Unmarshal(recorder.Body.Bytes(), &response), test.Name) {
+				continue
+			}
+
+			assert.Len(response.Words, test.Words, test.Name)
+			assert.Equal(test.Wordlist.Name(), response.Wordlist, test.Name)
+			assert.InDelta(float64(test.Words)*diceware.WordEntropy(test.Wordlist), response.EntropyBits, 0.01,
+				test.Name)
+			passphrase = response.Passphrase
+		}
+
+		words := strings.Split(passphrase, test.Sep)
+		assert.Len(words, test.Words, test.Name)
+		for _, word := range words {
+			_, found := wordlist.Index(test.Wordlist).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
+
+func TestNewHandlerMinEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		MinEntropyBits: 64,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	handler := dicewarehttp.NewHandler(generator, dicewarehttp.WithWordlists("eff-long", "test-short"))
+
+	tests := []struct {
+		Name   string
+		Target string
+		Status int
+		Words  int
+		Error  string
+	}{
+		{
+			Name:   "will meet the minimum entropy of the Generator",
+			Target: "/passphrase",
+			Status: http.StatusOK,
+			Words:  5,
+		}, {
+			Name:   "will accept more words than the minimum entropy needs",
+			Target: "/passphrase?words=8",
+			Status: http.StatusOK,
+			Words:  8,
+		}, {
+			Name:   "will raise the words of an allowed wordlist to the minimum entropy",
+			Target: "/passphrase?list=test-short",
+			Status: http.StatusOK,
+			Words:  7,
+		}, {
+			Name:   "will reject fewer words than the minimum entropy needs",
+			Target: "/passphrase?words=4",
+			Status: http.StatusBadRequest,
+			Error:  "invalid query parameter: words must be at least 5 to provide 64 bits of entropy",
+		}, {
+			Name:   "will reject a wordlist falling below the minimum entropy",
+			Target: "/passphrase?words=5&list=test-short",
+			Status: http.StatusBadRequest,
+			Error:  "invalid query parameter: words must be at least 7 to provide 64 bits of entropy",
+		}, {
+			Name:   "will reject a registered wordlist that is not allowed",
+			Target: "/passphrase?list=original",
+			Status: http.StatusBadRequest,
+			Error:  "invalid query parameter: list must be one of: eff-long, test-short",
+		},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodGet, test.Target, http.NoBody)
+		request.Header.Set("Accept", "application/json")
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		if !assert.Equal(test.Status, recorder.Code, test.Name) {
+			continue
+		}
+
+		if test.Error != "" {
+			var response dicewarehttp.ErrorResponse
+			assert.NoError(json.Unmarshal(recorder.Body.Bytes(), &response), test.Name)
+			assert.Equal(test.Error, response.Error, test.Name)
+			continue
+		}
+
+		var response dicewarehttp.Response
+		if !assert.NoError(json.Unmarshal(recorder.Body.Bytes(), &response), test.Name) {
+			continue
+		}
+
+		assert.Len(response.Words, test.Words, test.Name)
+		assert.GreaterOrEqual(response.EntropyBits, 64.0, test.Name)
+	}
+}
@@ -0,0 +1,20 @@
+package dicewarehttp
+
+import (
+	_ "embed"
+	"slices"
+)
+
+// openAPI represents the OpenAPI 3 document describing the handler of
+// NewHandler.
+//
+//go:embed openapi.json
+var openAPI []byte
+
+// OpenAPI returns a slice of bytes.
+// Implements the logic to give a copy of the OpenAPI 3 document, in JSON,
+// describing the handler of NewHandler, so that a service is able to publish
+// it alongside the handler.
+func OpenAPI() []byte {
+	return slices.Clone(openAPI)
+}
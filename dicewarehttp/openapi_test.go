@@ -0,0 +1,103 @@
+package dicewarehttp_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/dicewarehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// openAPIDocument is the part of the OpenAPI document compared against the
+// handler.
+type openAPIDocument struct {
+	OpenAPI string `json:"openapi"`
+	Paths   map[string]map[string]struct {
+		Parameters []struct {
+			Name   string `json:"name"`
+			In     string `json:"in"`
+			Schema struct {
+				Maximum   int `json:"maximum"`
+				MaxLength int `json:"maxLength"`
+			} `json:"schema"`
+		} `json:"parameters"`
+	} `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+func TestOpenAPI(t *testing.T) {
+	assert := assert.New(t)
+
+	var document openAPIDocument
+	if !assert.NoError(json.Unmarshal(dicewarehttp.OpenAPI(), &document)) {
+		return
+	}
+
+	assert.True(strings.HasPrefix(document.OpenAPI, "3."))
+
+	operation, found := document.Paths[dicewarehttp.PassphrasePath]["get"]
+	if !assert.True(found) {
+		return
+	}
+
+	names := []string{}
+	for _, parameter := range operation.Parameters {
+		names = append(names, parameter.Name)
+		assert.Equal("query", parameter.In, parameter.Name)
+
+		switch parameter.Name {
+		case "words":
+			assert.Equal(dicewarehttp.MaxWords, parameter.Schema.Maximum)
+		case "sep":
+			assert.Equal(dicewarehttp.MaxSeparatorLength, parameter.Schema.MaxLength)
+		}
+	}
+
+	assert.Equal([]string{"words", "list", "sep", "format"}, names)
+
+	tests := []struct {
+		Name   string
+		Schema string
+		Type   reflect.Type
+	}{
+		{
+			Name:   "will describe every field of the Response",
+			Schema: "Response",
+			Type:   reflect.TypeOf(dicewarehttp.Response{}),
+		}, {
+			Name:   "will describe every field of the ErrorResponse",
+			Schema: "ErrorResponse",
+			Type:   reflect.TypeOf(dicewarehttp.ErrorResponse{}),
+		},
+	}
+
+	for _, test := range tests {
+		fields := []string{}
+		for i := 0; i < test.Type.NumField(); i++ {
+			name, _, _ := strings.Cut(test.Type.Field(i).Tag.Get("json"), ",")
+			fields = append(fields, name)
+		}
+
+		properties := []string{}
+		for property := range document.Components.Schemas[test.Schema].Properties {
+			properties = append(properties, property)
+		}
+
+		assert.ElementsMatch(fields, properties, test.Name)
+	}
+}
+
+func TestOpenAPICopy(t *testing.T) {
+	assert := assert.New(t)
+
+	document := dicewarehttp.OpenAPI()
+	document[0] = 'x'
+
+	assert.Equal(byte('{'), dicewarehttp.OpenAPI()[0])
+}
@@ -0,0 +1,109 @@
+//go:build js && wasm
+
+package dicewarejs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"syscall/js"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// ErrInvalidArgument represents the error given when a function is called with
+// an argument of the wrong type
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// NewObject returns a js.Value.
+// Implements the logic to create a JavaScript object holding the rollWords and
+// wordlists functions, which is commonly published as a global, e.g.
+// `js.Global().Set("diceware", dicewarejs.NewObject())`.  The functions are
+// never released, so the object should only be created once.
+func NewObject() js.Value {
+	object := js.Global().Get("Object").New()
+	object.Set("rollWords", js.FuncOf(rollWords))
+	object.Set("wordlists", js.FuncOf(wordlists))
+
+	return object
+}
+
+// rollWords returns an any.
+// Implements the logic of rollWords(wordCount, separator, wordlist?,
+// enhanceEntropy?), returning the passphrase rolled with `diceware.RollWords`
+// or an Error describing why it was unable to be rolled.
+func rollWords(_ js.Value, args []js.Value) any {
+	if len(args) < 2 || len(args) > 4 {
+		return jsError(fmt.Errorf("%w: rollWords accepts between 2 and 4 arguments, given %d",
+			ErrInvalidArgument, len(args)))
+	}
+
+	if args[0].Type() != js.TypeNumber || args[0].Float() != math.Trunc(args[0].Float()) {
+		return jsError(fmt.Errorf("%w: wordCount must be a whole number", ErrInvalidArgument))
+	}
+
+	if args[1].Type() != js.TypeString {
+		return jsError(fmt.Errorf("%w: separator must be a string", ErrInvalidArgument))
+	}
+
+	name := wordlist.EFFLong.Name()
+	if len(args) > 2 && !args[2].IsUndefined() {
+		if args[2].Type() != js.TypeString {
+			return jsError(fmt.Errorf("%w: wordlist must be a string", ErrInvalidArgument))
+		}
+
+		name = args[2].String()
+	}
+
+	enhanceEntropy := false
+	if len(args) > 3 && !args[3].IsUndefined() {
+		if args[3].Type() != js.TypeBoolean {
+			return jsError(fmt.Errorf("%w: enhanceEntropy must be a boolean", ErrInvalidArgument))
+		}
+
+		enhanceEntropy = args[3].Bool()
+	}
+
+	wl, err := wordlist.Get(name)
+	if err != nil {
+		return jsError(err)
+	}
+
+	passphrase, err := diceware.RollWords(args[0].Int(), args[1].String(), wl, enhanceEntropy)
+	if err != nil {
+		return jsError(err)
+	}
+
+	return passphrase
+}
+
+// wordlists returns an any.
+// Implements the logic of wordlists(), returning an array describing each
+// built-in wordlist, ordered by their names.
+func wordlists(js.Value, []js.Value) any {
+	names := wordlist.Names()
+	descriptions := make([]any, 0, len(names))
+	for _, name := range names {
+		wl, err := wordlist.Get(name)
+		if err != nil {
+			continue
+		}
+
+		descriptions = append(descriptions, map[string]any{
+			"name":        name,
+			"rolls":       wl.Rolls(),
+			"sides":       int(wl.SidesOfDice().Int64()),
+			"entropyBits": diceware.WordEntropy(wl),
+		})
+	}
+
+	return descriptions
+}
+
+// jsError returns a js.Value.
+// Implements the logic to create a JavaScript Error with the message of the
+// given error.
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
@@ -0,0 +1,121 @@
+//go:build js && wasm
+
+package dicewarejs_test
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/dicewarejs"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollWords(t *testing.T) {
+	assert := assert.New(t)
+
+	object := dicewarejs.NewObject()
+
+	tests := []struct {
+		Name     string
+		Args     []any
+		Words    int
+		Sep      string
+		Wordlist *wordlist.Map
+		Error    string
+	}{
+		{
+			Name:     "will roll words from the EFF long wordlist by default",
+			Args:     []any{6, " "},
+			Words:    6,
+			Sep:      " ",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will roll words from the named wordlist",
+			Args:     []any{4, ".", "eff-short"},
+			Words:    4,
+			Sep:      ".",
+			Wordlist: wordlist.EFFShort,
+		}, {
+			Name:     "will accept an undefined wordlist",
+			Args:     []any{3, "-", js.Undefined(), false},
+			Words:    3,
+			Sep:      "-",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:  "will reject too few arguments",
+			Args:  []any{6},
+			Error: "rollWords accepts between 2 and 4 arguments, given 1",
+		}, {
+			Name:  "will reject a word count which is not a number",
+			Args:  []any{"6", " "},
+			Error: "wordCount must be a whole number",
+		}, {
+			Name:  "will reject a fractional word count",
+			Args:  []any{1.5, " "},
+			Error: "wordCount must be a whole number",
+		}, {
+			Name:  "will reject a separator which is not a string",
+			Args:  []any{6, 1},
+			Error: "separator must be a string",
+		}, {
+			Name:  "will reject an enhanceEntropy which is not a boolean",
+			Args:  []any{6, " ", "eff-long", "yes"},
+			Error: "enhanceEntropy must be a boolean",
+		}, {
+			Name:  "will reject an unknown wordlist",
+			Args:  []any{6, " ", "unknown"},
+			Error: `"unknown"`,
+		}, {
+			Name:  "will reject an invalid word count",
+			Args:  []any{0, " "},
+			Error: "invalid word count given",
+		},
+	}
+
+	for _, test := range tests {
+		result := object.Call("rollWords", test.Args...)
+		if test.Error != "" {
+			if assert.True(result.InstanceOf(js.Global().Get("Error")), test.Name) {
+				assert.Contains(result.Get("message").String(), test.Error, test.Name)
+			}
+
+			continue
+		}
+
+		if !assert.Equal(js.TypeString, result.Type(), test.Name) {
+			continue
+		}
+
+		words := strings.Split(result.String(), test.Sep)
+		assert.Len(words, test.Words, test.Name)
+		for _, word := range words {
+			_, found := wordlist.Index(test.Wordlist).RollFor(word)
+			assert.True(found, test.Name)
+		}
+	}
+}
+
+func TestWordlists(t *testing.T) {
+	assert := assert.New(t)
+
+	result := dicewarejs.NewObject().Call("wordlists")
+	if !assert.True(result.InstanceOf(js.Global().Get("Array"))) {
+		return
+	}
+
+	names := []string{}
+	for i := 0; i < result.Length(); i++ {
+		description := result.Index(i)
+		names = append(names, description.Get("name").String())
+
+		if description.Get("name").String() == wordlist.EFFLong.Name() {
+			assert.Equal(5, description.Get("rolls").Int())
+			assert.Equal(6, description.Get("sides").Int())
+			assert.InDelta(12.92, description.Get("entropyBits").Float(), 0.01)
+		}
+	}
+
+	assert.Equal(wordlist.Names(), names)
+}
@@ -0,0 +1,16 @@
+// Package dicewarejs exposes diceware to JavaScript when compiled to
+// WebAssembly with GOOS=js and GOARCH=wasm, so that browser based tools roll
+// passphrases from the same wordlists, with the same algorithm, as the Go
+// services they sit alongside.
+//
+// The object returned by NewObject holds the functions:
+//
+//	rollWords(wordCount, separator, wordlist?, enhanceEntropy?)
+//	wordlists()
+//
+// rollWords returns the passphrase as a string, rolling from the built-in
+// wordlist of the given name, or the EFF long wordlist when it is not given.
+// wordlists returns an array describing each built-in wordlist with its name,
+// rolls, sides and entropyBits.  Any failure, such as invalid arguments or an
+// unknown wordlist, is returned as a JavaScript Error rather than thrown.
+package dicewarejs
@@ -0,0 +1,94 @@
+// Package dicewareprometheus records the issuance of diceware passphrases as
+// Prometheus metrics, through the diceware.Instrumentation hooks.
+//
+// It is its own module, keeping the Prometheus client out of the dependencies
+// of the diceware module itself.
+package dicewareprometheus
+
+import (
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics defines the diceware.Instrumentation recording the generated
+// passphrases, the failures of the RandomSource and the time taken to
+// generate each passphrase as the metrics:
+//
+//	diceware_passphrases_generated_total
+//	diceware_entropy_source_errors_total
+//	diceware_generation_duration_seconds
+type Metrics struct {
+	// generated represents the counter of the generated passphrases.
+	generated prometheus.Counter
+
+	// entropyErrors represents the counter of the failures of the RandomSource.
+	entropyErrors prometheus.Counter
+
+	// duration represents the histogram of the time taken to generate each
+	// passphrase.
+	duration prometheus.Histogram
+}
+
+var _ diceware.Instrumentation = (*Metrics)(nil)
+
+// NewMetrics returns an initialized Metrics object, or an error when its
+// metrics are unable to be registered with the given Registerer.
+// Implements the logic to create the metrics and register them, where a nil
+// Registerer registers them with `prometheus.DefaultRegisterer`.  The Metrics
+// are given to the generation of passphrases with
+// `diceware.WithInstrumentation` or `PassphraseOptions.Instrumentation`.
+func NewMetrics(reg prometheus.Registerer) (*Metrics, error) {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	metrics := &Metrics{
+		generated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "diceware",
+			Name:      "passphrases_generated_total",
+			Help:      "Number of diceware passphrases generated.",
+		}),
+		entropyErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "diceware",
+			Name:      "entropy_source_errors_total",
+			Help:      "Number of failures of the random source while generating diceware passphrases.",
+		}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "diceware",
+			Name:      "generation_duration_seconds",
+			Help:      "Time taken to generate each diceware passphrase.",
+			Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 10),
+		}),
+	}
+
+	registered := []prometheus.Collector{}
+	for _, collector := range []prometheus.Collector{metrics.generated, metrics.entropyErrors, metrics.duration} {
+		if err := reg.Register(collector); err != nil {
+			// remove the metrics registered so far, so that it is able to be retried
+			for _, registeredCollector := range registered {
+				reg.Unregister(registeredCollector)
+			}
+
+			return nil, err
+		}
+
+		registered = append(registered, collector)
+	}
+
+	return metrics, nil
+}
+
+// PassphraseGenerated implements the logic for the diceware.Instrumentation
+// interface, counting the passphrase and observing the time it took.
+func (m *Metrics) PassphraseGenerated(duration time.Duration) {
+	m.generated.Inc()
+	m.duration.Observe(duration.Seconds())
+}
+
+// EntropySourceError implements the logic for the diceware.Instrumentation
+// interface, counting the failure of the RandomSource.
+func (m *Metrics) EntropySourceError(error) {
+	m.entropyErrors.Inc()
+}
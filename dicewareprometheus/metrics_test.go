@@ -0,0 +1,88 @@
+package dicewareprometheus_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/dicewareprometheus"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingRandomSource is a RandomSource that always fails.
+type failingRandomSource struct{}
+
+func (failingRandomSource) GetRandom(*big.Int) (*big.Int, error) {
+	return nil, errors.New("entropy source failure")
+}
+
+func TestNewMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := prometheus.NewPedanticRegistry()
+	metrics, err := dicewareprometheus.NewMetrics(registry)
+	if !assert.NoError(err) {
+		return
+	}
+
+	generator, err := diceware.New(diceware.WithWordlist(wordlist.EFFLong), diceware.WithInstrumentation(metrics))
+	if !assert.NoError(err) {
+		return
+	}
+
+	_, err = generator.GenerateN(3)
+	assert.NoError(err)
+
+	_, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:       4,
+		Wordlist:        wordlist.EFFLong,
+		RandomSource:    failingRandomSource{},
+		Instrumentation: metrics,
+	})
+	assert.Error(err)
+
+	assert.NoError(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP diceware_entropy_source_errors_total Number of failures of the random source while generating diceware passphrases.
+# TYPE diceware_entropy_source_errors_total counter
+diceware_entropy_source_errors_total 1
+# HELP diceware_passphrases_generated_total Number of diceware passphrases generated.
+# TYPE diceware_passphrases_generated_total counter
+diceware_passphrases_generated_total 3
+`), "diceware_entropy_source_errors_total", "diceware_passphrases_generated_total"))
+
+	count, err := testutil.GatherAndCount(registry, "diceware_generation_duration_seconds")
+	assert.NoError(err)
+	assert.Equal(1, count)
+
+	lint, err := testutil.GatherAndLint(registry)
+	assert.NoError(err)
+	assert.Empty(lint)
+}
+
+func TestNewMetricsRegistered(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := prometheus.NewRegistry()
+	metrics, err := dicewareprometheus.NewMetrics(registry)
+	if !assert.NoError(err) {
+		return
+	}
+
+	_, err = dicewareprometheus.NewMetrics(registry)
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	assert.ErrorAs(err, &alreadyRegistered)
+
+	// the metrics registered first are kept
+	metrics.PassphraseGenerated(time.Millisecond)
+	assert.Equal(1.0, testutil.ToFloat64(alreadyRegistered.ExistingCollector))
+
+	count, err := testutil.GatherAndCount(registry)
+	assert.NoError(err)
+	assert.Equal(3, count)
+}
@@ -0,0 +1,169 @@
+//go:build !diceware_minimal && !diceware_no_eff_short
+
+package diceware_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEFFShortEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.InDelta(10.3399, diceware.WordEntropy(wordlist.EFFShort), 0.0001,
+		"will return the entropy of the EFF short wordlist")
+	assert.Equal(7, diceware.SuggestWordCount(wordlist.EFFShort, 64),
+		"will require more words from a smaller wordlist")
+
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      2,
+		Wordlist:       wordlist.EFFShort,
+		MinEntropyBits: 90,
+	})
+	if assert.NoError(err, "will raise a smaller word count") {
+		assert.Len(passphrase.Words, 9)
+		assert.GreaterOrEqual(passphrase.EntropyBits, 90.0)
+	}
+}
+
+func TestEFFShortDiceRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase, err := diceware.FromDiceRolls(wordlist.EFFShort, []int{1, 1, 1, 1, 6, 6, 6, 6})
+	if assert.NoError(err, "will convert the rolls of several words") {
+		assert.Equal("acid zoom", passphrase)
+	}
+
+	_, err = diceware.WordFromDiceRolls(wordlist.EFFShort, []int{1, 1, 1})
+	assert.ErrorIs(err, diceware.ErrInvalidDiceCount)
+
+	word, err := diceware.WordFromDiceRolls(wordlist.EFFShort, []int{6, 6, 6, 6})
+	if assert.NoError(err) {
+		assert.Equal("zoom", word)
+	}
+}
+
+func TestEFFShortToRolls(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Separator  string
+		Rolls      [][]int
+		Error      error
+	}{
+		{
+			Name:       "will error with an empty separator",
+			Passphrase: "acid zoom",
+			Error:      diceware.ErrInvalidSeparator,
+		}, {
+			Name:       "will error with a word missing from the wordlist",
+			Passphrase: "acid diceware",
+			Separator:  " ",
+			Error:      diceware.ErrUnknownWord,
+		}, {
+			Name:       "will map each word back to its rolls",
+			Passphrase: "acid-zoom-yodel",
+			Separator:  "-",
+			Rolls:      [][]int{{1, 1, 1, 1}, {6, 6, 6, 6}, {6, 6, 5, 3}},
+		},
+	}
+
+	for _, test := range tests {
+		rolls, err := diceware.ToRolls(test.Passphrase, test.Separator, wordlist.EFFShort)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Rolls, rolls, test.Name)
+		}
+	}
+}
+
+func TestEFFShortCheckSeparator(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(diceware.CheckSeparator(wordlist.EFFShort, " "), "will accept a separator missing from the words")
+	assert.NoError(diceware.CheckSeparator(wordlist.EFFShort, ""), "will accept an empty separator")
+
+	err := diceware.CheckSeparator(wordlist.EFFShort, "-")
+	assert.Equal(&diceware.SeparatorCollisionError{Separator: "-", Words: []string{"yo-yo"}}, err,
+		"will report the words containing the separator")
+
+	err = diceware.CheckSeparator(wordlist.EFFShort, "oo")
+	assert.ErrorIs(err, diceware.ErrSeparatorCollision, "will report the words containing a multi character separator")
+}
+
+func TestEFFShortStrengthReport(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Bits    float64
+	}{
+		{
+			Name: "will report the entropy of the random choices made for every passphrase",
+			Options: diceware.PassphraseOptions{
+				WordCount:        4,
+				Wordlist:         wordlist.EFFShort,
+				Capitalization:   diceware.CapitalizationRandomWord,
+				IncludeDigits:    true,
+				RandomSeparators: true,
+				SeparatorSet:     []string{"!", "-", ".", "_", "+", "=", "1", "2"},
+			},
+			Bits: 4*10.3399 + 2 + math.Log2(8) + 3*3,
+		}, {
+			Name: "will report the entropy of the digits and symbols of a pattern",
+			Options: diceware.PassphraseOptions{
+				Wordlist:           wordlist.EFFShort,
+				Pattern:            "W-W-DS",
+				EnhancementCharset: []string{"!", "?"},
+			},
+			Bits: 2*10.3399 + math.Log2(10) + 1,
+		},
+	}
+
+	for _, test := range tests {
+		strength, err := diceware.StrengthReport(test.Options)
+		if assert.NoError(err, test.Name) {
+			assert.InDelta(test.Bits, strength.EntropyBits, 0.001, test.Name)
+		}
+	}
+}
+
+func TestEFFShortToList(t *testing.T) {
+	assert := assert.New(t)
+
+	// a List that already is a Wordlist is used as it is
+	assert.Same(wordlist.EFFShort, diceware.FromList(wordlist.EFFShort))
+
+	tests := []struct {
+		Name     string
+		Wordlist diceware.Wordlist
+	}{
+		{
+			Name:     "will return an IndexedWordlist as it is",
+			Wordlist: wordlist.EFFShort,
+		}, {
+			Name:     "will adapt a Wordlist without indexes",
+			Wordlist: struct{ diceware.Wordlist }{wordlist.EFFShort},
+		},
+	}
+
+	for _, test := range tests {
+		list := diceware.ToList(test.Wordlist)
+		assert.Equal(1296, list.Len(), test.Name)
+		assert.Equal("acid", list.WordAt(0), test.Name)
+		assert.Equal("zoom", list.WordAt(1295), test.Name)
+	}
+
+	assert.Equal("", diceware.ToList(struct{ diceware.Wordlist }{wordlist.EFFShort}).WordAt(1296))
+}
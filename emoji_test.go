@@ -0,0 +1,35 @@
+//go:build !diceware_minimal && !diceware_no_emoji
+
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmojiCapitalization(t *testing.T) {
+	assert := assert.New(t)
+
+	// capitalizing words without letters provides no entropy
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      4,
+		Separator:      " ",
+		Wordlist:       wordlist.Emoji,
+		Capitalization: diceware.CapitalizationRandomWord,
+	})
+	if assert.NoError(err) {
+		assert.InDelta(4*diceware.WordEntropy(wordlist.Emoji), passphrase.EntropyBits, 0.0001)
+	}
+
+	strength, err := diceware.StrengthReport(diceware.PassphraseOptions{
+		WordCount:      4,
+		Wordlist:       wordlist.Emoji,
+		Capitalization: diceware.CapitalizationRandomWord,
+	})
+	if assert.NoError(err) {
+		assert.InDelta(4*7.7549, strength.EntropyBits, 0.001)
+	}
+}
@@ -0,0 +1,162 @@
+package diceware
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// enhanceWords returns an error.
+// Implements the logic to insert a random character rolled from the characters
+// wordlist into a random number of the given words, at minimum 1, skipping any
+// character contained within the separator.  The enhanced words are chosen
+// uniformly at random, so that their positions are as unpredictable as the
+// characters themselves.  Each insertion is recorded within the transcript.
+func enhanceWords(
+	ctx context.Context, rs RandomSource, words []string, separator string, characters Wordlist, transcript *Transcript,
+) error {
+	transformedWords, err := randomInt(rs, len(words))
+	if err != nil {
+		return err
+	}
+
+	positions, err := choosePositions(rs, len(words), transformedWords+1)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range positions {
+		character, err := rollCharacter(ctx, rs, characters, separator)
+		if err != nil {
+			return err
+		}
+
+		var offset int
+		words[i], offset, err = insertCharacter(words[i], character, rs)
+		if err != nil {
+			return err
+		}
+
+		transcript.recordInsertion(i, offset, character)
+	}
+
+	return nil
+}
+
+// rollCharacter returns a string.
+// Implements the logic to roll characters until one is found that is not
+// contained within the separator.
+func rollCharacter(ctx context.Context, rs RandomSource, characters Wordlist, separator string) (string, error) {
+	for {
+		character, _, err := rollWord(ctx, rs, characters)
+		if err != nil {
+			return "", err
+		}
+
+		if !strings.Contains(separator, character) {
+			return character, nil
+		}
+	}
+}
+
+// choosePositions returns a slice of ints.
+// Implements the logic to choose count distinct positions within [0, n)
+// uniformly at random, using a partial Fisher-Yates shuffle.
+func choosePositions(rs RandomSource, n, count int) ([]int, error) {
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = i
+	}
+
+	for i := 0; i < count; i++ {
+		j, err := randomInt(rs, n-i)
+		if err != nil {
+			return nil, err
+		}
+
+		positions[i], positions[i+j] = positions[i+j], positions[i]
+	}
+
+	return positions[:count], nil
+}
+
+// charsetWordlist returns a Wordlist.
+// Implements the logic to convert a set of characters into a single die
+// wordlist, with a side for each of the characters.
+func charsetWordlist(charset []string) Wordlist {
+	words := make(map[int]string, len(charset))
+	for i, character := range charset {
+		words[i+1] = character
+	}
+
+	return wordlist.NewMap(1, len(charset), words)
+}
+
+// insertDigit returns a float64.
+// Implements the logic to insert a single random digit into a randomly chosen
+// word, skipping any digit contained within the separator, returning the
+// entropy in bits added by the digit.  The insertion is recorded within the
+// transcript.
+func insertDigit(
+	ctx context.Context, rs RandomSource, words []string, separator string, transcript *Transcript,
+) (float64, error) {
+	digit, err := rollCharacter(ctx, rs, wordlist.Digits, separator)
+	if err != nil {
+		return 0, err
+	}
+
+	index, err := randomInt(rs, len(words))
+	if err != nil {
+		return 0, err
+	}
+
+	var offset int
+	words[index], offset, err = insertCharacter(words[index], digit, rs)
+	if err != nil {
+		return 0, err
+	}
+
+	transcript.recordInsertion(index, offset, digit)
+
+	return digitEntropy(separator), nil
+}
+
+// digitEntropy returns a float64.
+// Implements the logic to calculate the entropy in bits of a digit rolled by
+// insertDigit, which is chosen from only the digits not contained within the
+// separator.
+func digitEntropy(separator string) float64 {
+	usable := 0
+	for i := 0; i < 10; i++ {
+		if !strings.Contains(separator, strconv.Itoa(i)) {
+			usable++
+		}
+	}
+
+	if usable == 0 {
+		return 0
+	}
+
+	return math.Log2(float64(usable))
+}
+
+// insertCharacter returns a string and an int.
+// Implements the logic to insert the character at a random position after the
+// first character of the word, returning the byte offset it was inserted at.
+// The positions are between the user perceived characters of the word, so a
+// multi-byte character, or one built from several runes, is never split.
+func insertCharacter(word, character string, rs RandomSource) (string, int, error) {
+	offsets := append(clusterOffsets(word), len(word))
+
+	position, err := randomInt(rs, len(offsets)-1)
+	if err != nil {
+		return "", 0, err
+	}
+
+	offset := offsets[position+1]
+
+	return word[:offset] + character + word[offset:], offset, nil
+}
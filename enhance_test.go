@@ -0,0 +1,198 @@
+package diceware_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncludeDigits(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 2, map[int]string{
+		1: "correct",
+		2: "horse",
+	})
+
+	// rolls the words "correct" and "horse", followed by the digit 7 being
+	// inserted into the second word after the third character.
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:     2,
+		Separator:     " ",
+		Wordlist:      words,
+		IncludeDigits: true,
+		RandomSource:  &sequenceRandomSource{values: []int64{0, 1, 7, 1, 2}},
+	})
+	if assert.NoError(err) {
+		assert.Equal("correct hor7se", passphrase.String())
+		assert.InDelta(2+3.3219, passphrase.EntropyBits, 0.0001)
+	}
+
+	for i := 0; i < 50; i++ {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:     4,
+			Separator:     " ",
+			Wordlist:      wordlist.EFFLong,
+			IncludeDigits: true,
+		})
+		if !assert.NoError(err) {
+			return
+		}
+
+		digits := 0
+		for _, r := range passphrase.String() {
+			if unicode.IsDigit(r) {
+				digits++
+			}
+		}
+
+		assert.Equal(1, digits, "a single digit should be inserted")
+	}
+}
+
+func TestIncludeDigitsSeparator(t *testing.T) {
+	assert := assert.New(t)
+
+	for i := 0; i < 50; i++ {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:     4,
+			Separator:     "13579",
+			Wordlist:      wordlist.EFFLong,
+			IncludeDigits: true,
+		})
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.False(strings.ContainsAny(strings.Join(passphrase.Words, ""), "13579"),
+			"the separator digits should be skipped")
+		assert.InDelta(4*12.9248+math.Log2(5), passphrase.EntropyBits, 0.0001)
+	}
+
+	_, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:        4,
+		Wordlist:         wordlist.EFFLong,
+		IncludeDigits:    true,
+		RandomSeparators: true,
+	})
+	assert.ErrorIs(err, diceware.ErrInvalidDigits)
+}
+
+func TestEnhancementCharset(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 3, map[int]string{
+		1: "correct",
+		2: "horse",
+		3: "battery",
+	})
+
+	tests := []struct {
+		Name    string
+		Charset []string
+		Error   error
+	}{
+		{
+			Name:    "will error with an empty charset",
+			Charset: []string{},
+			Error:   diceware.ErrInvalidEnhancementCharset,
+		}, {
+			Name:    "will error with an empty character",
+			Charset: []string{"!", ""},
+			Error:   diceware.ErrInvalidEnhancementCharset,
+		}, {
+			Name:    "will error with a repeated character",
+			Charset: []string{"!", "#", "!"},
+			Error:   diceware.ErrInvalidEnhancementCharset,
+		}, {
+			Name:    "will error when every character is within the separator",
+			Charset: []string{"-", "+"},
+			Error:   diceware.ErrInvalidEnhancementCharset,
+		}, {
+			Name:    "will only insert characters from the charset",
+			Charset: []string{"!", "-", "#"},
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:          6,
+			Separator:          "+-",
+			Wordlist:           words,
+			EnhanceEntropy:     true,
+			EnhancementCharset: test.Charset,
+		})
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		inserted := ""
+		for _, word := range passphrase.Words {
+			for _, r := range word {
+				if !unicode.IsLower(r) {
+					inserted += string(r)
+				}
+			}
+		}
+
+		assert.NotEmpty(inserted, test.Name)
+		assert.NotContains(inserted, "-", test.Name)
+		assert.Empty(strings.Trim(inserted, "!#"), test.Name)
+	}
+}
+
+func TestEnhanceEntropyPositions(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 3, map[int]string{
+		1: "correct",
+		2: "horse",
+		3: "battery",
+	})
+
+	// rolls the three words, then enhances a single word chosen at the last
+	// position with the "~" character inserted after the first character.
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      3,
+		Separator:      " ",
+		Wordlist:       words,
+		EnhanceEntropy: true,
+		RandomSource:   &sequenceRandomSource{values: []int64{0, 1, 2, 0, 2, 0, 0}},
+	})
+	if assert.NoError(err) {
+		assert.Equal("correct horse b~attery", passphrase.String())
+	}
+
+	// across many passphrases every position is enhanced at some point, not
+	// only the first words
+	enhanced := make([]bool, 3)
+	for i := 0; i < 200; i++ {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:      3,
+			Separator:      " ",
+			Wordlist:       words,
+			EnhanceEntropy: true,
+		})
+		if !assert.NoError(err) {
+			return
+		}
+
+		for position, word := range passphrase.Words {
+			if word != "correct" && word != "horse" && word != "battery" {
+				enhanced[position] = true
+			}
+		}
+	}
+
+	assert.Equal([]bool{true, true, true}, enhanced)
+}
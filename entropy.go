@@ -0,0 +1,52 @@
+package diceware
+
+import (
+	"math"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// WordEntropy returns a float64.
+// Implements the logic to calculate the entropy in bits provided by a single
+// word rolled from the given wordlist.  The entropy of wordlists implementing
+// `wordlist.Sizer` or IndexedWordlist is calculated from their number of
+// words, so any rejected roll values, such as those of a `wordlist.Slice`, are
+// excluded.
+func WordEntropy(wl Wordlist) float64 {
+	if wl == nil || wl.Rolls() < 1 || wl.SidesOfDice().Sign() <= 0 {
+		return 0
+	}
+
+	if sized, ok := wl.(wordlist.Sizer); ok && sized.Length() > 0 {
+		return math.Log2(float64(sized.Length()))
+	}
+
+	if indexed, ok := wl.(IndexedWordlist); ok && indexed.Len() > 0 {
+		return math.Log2(float64(indexed.Len()))
+	}
+
+	sides, _ := wl.SidesOfDice().Float64()
+
+	return float64(wl.Rolls()) * math.Log2(sides)
+}
+
+// SuggestWordCount returns an int.
+// Implements the logic to calculate the smallest number of words rolled from
+// the given wordlist that provides at least the given entropy in bits.  Zero
+// is returned when the wordlist is unable to provide any entropy.
+func SuggestWordCount(wl Wordlist, bits float64) int {
+	return wordsForEntropy(WordEntropy(wl), bits)
+}
+
+// wordsForEntropy returns an int.
+// Implements the logic to calculate the smallest number of words, each
+// providing perWord bits of entropy, that provides at least the given bits.
+func wordsForEntropy(perWord, bits float64) int {
+	if perWord <= 0 || bits <= 0 {
+		return 0
+	}
+
+	// the tolerance avoids an extra word when the division is off by a rounding
+	// error, e.g. requesting exactly the entropy of 6 EFF long words.
+	return int(math.Ceil(bits/perWord - 1e-9))
+}
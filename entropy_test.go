@@ -0,0 +1,88 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist diceware.Wordlist
+		Value    float64
+	}{
+		{
+			Name:  "will return zero for a nil wordlist",
+			Value: 0,
+		}, {
+			Name:     "will return the entropy of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Value:    12.9248,
+		}, {
+			Name:     "will return the entropy of a smaller wordlist",
+			Wordlist: wordSlice(1296),
+			Value:    10.3399,
+		}, {
+			Name:     "will exclude the rejected roll values of a slice",
+			Wordlist: wordSlice(5000),
+			Value:    12.2877,
+		}, {
+			Name:     "will exclude the missing roll values of a map",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{1: "one", 2: "two", 3: "three", 4: "four"}),
+			Value:    2,
+		}, {
+			Name:     "will return zero for a wordlist without any dice",
+			Wordlist: wordlist.NewMap(0, 6, map[int]string{}),
+			Value:    0,
+		},
+	}
+
+	for _, test := range tests {
+		assert.InDelta(test.Value, diceware.WordEntropy(test.Wordlist), 0.0001, test.Name)
+	}
+}
+
+func TestSuggestWordCount(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Bits     float64
+		Wordlist diceware.Wordlist
+		Value    int
+	}{
+		{
+			Name:  "will return zero for a nil wordlist",
+			Bits:  64,
+			Value: 0,
+		}, {
+			Name:     "will return zero for zero bits",
+			Wordlist: wordlist.EFFLong,
+			Value:    0,
+		}, {
+			Name:     "will round up to the next whole word",
+			Bits:     64,
+			Wordlist: wordlist.EFFLong,
+			Value:    5,
+		}, {
+			Name:     "will not add a word when the bits are met exactly",
+			Bits:     6 * diceware.WordEntropy(wordlist.EFFLong),
+			Wordlist: wordlist.EFFLong,
+			Value:    6,
+		}, {
+			Name:     "will require more words from a smaller wordlist",
+			Bits:     64,
+			Wordlist: wordSlice(1296),
+			Value:    7,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, diceware.SuggestWordCount(test.Wordlist, test.Bits), test.Name)
+	}
+}
@@ -0,0 +1,137 @@
+package diceware
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// ErrInvalidCount represents the error given when a negative number of
+// passphrases is requested from a Generator
+var ErrInvalidCount = errors.New("invalid negative passphrase count given")
+
+// Generator defines a reusable passphrase generator.  The options given to the
+// Generator are validated a single time on creation, allowing for passphrases
+// to be generated repeatedly without the cost of rebuilding the configuration.
+//
+// A Generator is immutable once created and is safe for concurrent use by
+// multiple goroutines, provided that its RandomSource and ExcludeFunc are as
+// well.  Every RandomSource within this package is safe for concurrent use.
+type Generator struct {
+	// opts represents the validated configuration used for every passphrase.
+	opts PassphraseOptions
+
+	// plan represents the words planned from the configuration, which is shared
+	// by every passphrase.
+	plan wordPlan
+}
+
+// NewGenerator returns an initialized Generator object, or an error when the
+// given options are unable to generate a passphrase.  The options are copied,
+// so later changes to any of the slices given do not affect the Generator.
+func NewGenerator(opts PassphraseOptions) (*Generator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.clone()
+
+	plan, err := opts.planWords()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Generator{opts: opts, plan: plan}, nil
+}
+
+// Options returns a PassphraseOptions.
+// Implements the logic to retrieve a copy of the configuration the Generator
+// was created with.
+func (g *Generator) Options() PassphraseOptions {
+	return g.opts.clone()
+}
+
+// Generate returns a string.
+// Implements the logic to generate a single passphrase from the stored
+// configuration.
+func (g *Generator) Generate() (string, error) {
+	return g.GenerateContext(context.Background())
+}
+
+// GenerateContext returns a string.
+// Implements the logic to generate a single passphrase from the stored
+// configuration, aborting between rolls when the given context is cancelled or
+// its deadline expires.
+func (g *Generator) GenerateContext(ctx context.Context) (string, error) {
+	passphrase, err := g.roll(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return passphrase.String(), nil
+}
+
+// GeneratePassphrase returns a Passphrase.
+// Implements the logic to generate a single structured passphrase from the
+// stored configuration.
+func (g *Generator) GeneratePassphrase() (*Passphrase, error) {
+	return g.roll(context.Background())
+}
+
+// GeneratePassphraseContext returns a Passphrase.
+// Implements the logic to generate a single structured passphrase from the
+// stored configuration, aborting between rolls when the given context is
+// cancelled or its deadline expires.
+func (g *Generator) GeneratePassphraseContext(ctx context.Context) (*Passphrase, error) {
+	return g.roll(ctx)
+}
+
+// roll returns a Passphrase.
+// Implements the logic to generate a single structured passphrase from the
+// stored configuration and the words planned from it.
+func (g *Generator) roll(ctx context.Context) (*Passphrase, error) {
+	return rollPlannedPassphrase(ctx, g.opts, g.plan)
+}
+
+// GenerateN returns a slice of strings.
+// Implements the logic to generate n passphrases from the stored
+// configuration.
+func (g *Generator) GenerateN(n int) ([]string, error) {
+	if n < 0 {
+		return nil, ErrInvalidCount
+	}
+
+	passphrases := make([]string, n)
+	for i := range passphrases {
+		passphrase, err := g.Generate()
+		if err != nil {
+			return nil, err
+		}
+
+		passphrases[i] = passphrase
+	}
+
+	return passphrases, nil
+}
+
+// Passphrases returns an iter.Seq2 of strings and errors.
+// Implements the logic to stream an endless sequence of passphrases from the
+// stored configuration, stopping whenever the caller breaks out of the range
+// loop or after the first error has been yielded.
+func (g *Generator) Passphrases() iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for {
+			passphrase, err := g.Generate()
+			if !yield(passphrase, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// GenerateWord returns a string.
+// Implements the logic to roll a single word from the stored wordlist.
+func (g *Generator) GenerateWord() (string, error) {
+	word, _, err := rollWord(context.Background(), g.opts.randomSource(), g.opts.Wordlist)
+	return word, err
+}
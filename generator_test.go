@@ -0,0 +1,206 @@
+package diceware_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGenerator(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{WordCount: 6})
+	assert.ErrorIs(err, diceware.ErrInvalidWordlist)
+	assert.Nil(generator)
+
+	opts := diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: "-",
+		Wordlist:  wordlist.EFFLong,
+	}
+
+	generator, err = diceware.NewGenerator(opts)
+	if assert.NoError(err) {
+		assert.Equal(opts, generator.Options())
+	}
+}
+
+func TestGeneratorGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount:      7,
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		EnhanceEntropy: true,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	passphrase, err := generator.Generate()
+	if assert.NoError(err) {
+		assert.Len(strings.Split(passphrase, " "), 7)
+	}
+
+	word, err := generator.GenerateWord()
+	if assert.NoError(err) {
+		assert.NotEmpty(word)
+		assert.NotContains(word, " ")
+	}
+
+	structured, err := generator.GeneratePassphraseContext(context.Background())
+	if assert.NoError(err) {
+		assert.Len(structured.Words, 7)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = generator.GeneratePassphraseContext(ctx)
+	assert.ErrorIs(err, context.Canceled)
+}
+
+func TestGeneratorGenerateN(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 4,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name  string
+		Count int
+		Error error
+	}{
+		{
+			Name:  "will error with a negative count",
+			Count: -1,
+			Error: diceware.ErrInvalidCount,
+		}, {
+			Name:  "will return an empty slice with a count of zero",
+			Count: 0,
+		}, {
+			Name:  "will return the requested number of passphrases",
+			Count: 5,
+		},
+	}
+
+	for _, test := range tests {
+		passphrases, err := generator.GenerateN(test.Count)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Len(passphrases, test.Count, test.Name)
+			for _, passphrase := range passphrases {
+				assert.Len(strings.Split(passphrase, " "), 4, test.Name)
+			}
+		}
+	}
+}
+
+func TestGeneratorPassphrases(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.New(diceware.WithWordCount(3))
+	if !assert.NoError(err) {
+		return
+	}
+
+	passphrases := []string{}
+	for passphrase, err := range generator.Passphrases() {
+		if !assert.NoError(err) {
+			return
+		}
+
+		passphrases = append(passphrases, passphrase)
+		if len(passphrases) == 10 {
+			break
+		}
+	}
+
+	assert.Len(passphrases, 10)
+
+	// an invalid wordlist will yield a single error and then stop
+	invalid, err := diceware.New(diceware.WithWordlist(wordlist.NewMap(1, 6, map[int]string{})))
+	if !assert.NoError(err) {
+		return
+	}
+
+	errs := 0
+	for _, err := range invalid.Passphrases() {
+		assert.ErrorIs(err, diceware.ErrInvalidWordFetched)
+		errs++
+	}
+
+	assert.Equal(1, errs)
+}
+
+func TestGeneratorConcurrentUse(t *testing.T) {
+	assert := assert.New(t)
+
+	rs, err := diceware.NewSeededRandomSource([]byte("correct horse battery staple"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	exclude := []string{"acid"}
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount:     6,
+		Separator:     " ",
+		Wordlist:      wordlist.EFFLong,
+		ExcludeWords:  exclude,
+		MinWordLength: 4,
+		RandomSource:  rs,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	// changes made to the given options must not reach the Generator
+	exclude[0] = "zoom"
+	assert.Equal([]string{"acid"}, generator.Options().ExcludeWords)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 25; j++ {
+				passphrase, err := generator.Generate()
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				for _, word := range strings.Split(passphrase, " ") {
+					if word == "acid" || len(word) < 4 {
+						errs <- diceware.ErrInvalidWordFetched
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(err)
+	}
+}
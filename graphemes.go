@@ -0,0 +1,90 @@
+package diceware
+
+import "unicode"
+
+const (
+	// zeroWidthJoiner represents the character joining emoji into a single
+	// user perceived character, e.g. a family.
+	zeroWidthJoiner = '\u200d'
+
+	// regionalIndicatorA represents the first of the 26 regional indicators,
+	// which are paired into flags.
+	regionalIndicatorA = '\U0001f1e6'
+
+	// regionalIndicatorZ represents the last of the 26 regional indicators.
+	regionalIndicatorZ = '\U0001f1ff'
+)
+
+// clusterOffsets returns a slice of ints.
+// Implements the logic to find the byte offset at which each user perceived
+// character of the text starts, keeping combining marks, variation
+// selectors, emoji modifiers, emoji joined with a zero width joiner and flags
+// within a single character.  This approximates the grapheme clusters of
+// Unicode text segmentation without any dependencies, which is sufficient to
+// never split the characters of words and separators.
+func clusterOffsets(text string) []int {
+	offsets := []int{}
+
+	var previous rune
+	indicators := 0
+	for offset, r := range text {
+		extends := offset > 0 && (unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+			r == zeroWidthJoiner || previous == zeroWidthJoiner || isEmojiModifier(r) ||
+			(isRegionalIndicator(r) && indicators%2 == 1))
+
+		if isRegionalIndicator(r) {
+			indicators++
+		} else {
+			indicators = 0
+		}
+
+		if !extends {
+			offsets = append(offsets, offset)
+		}
+
+		previous = r
+	}
+
+	return offsets
+}
+
+// characterCount returns an int.
+// Implements the logic to count the user perceived characters of the text.
+func characterCount(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	return len(clusterOffsets(text))
+}
+
+// characters returns a slice of strings.
+// Implements the logic to split the text into its user perceived characters.
+func characters(text string) []string {
+	offsets := clusterOffsets(text)
+	split := make([]string, len(offsets))
+	for i, offset := range offsets {
+		end := len(text)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+
+		split[i] = text[offset:end]
+	}
+
+	return split
+}
+
+// isEmojiModifier returns a bool.
+// Implements the logic to check whether the rune is one of the skin tone
+// modifiers applied to the preceding emoji.
+func isEmojiModifier(r rune) bool {
+	return r >= '\U0001f3fb' && r <= '\U0001f3ff'
+}
+
+// isRegionalIndicator returns a bool.
+// Implements the logic to check whether the rune is one of the regional
+// indicators paired into flags.
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorA && r <= regionalIndicatorZ
+}
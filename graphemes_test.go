@@ -0,0 +1,98 @@
+package diceware_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiRuneCharacters(t *testing.T) {
+	assert := assert.New(t)
+
+	// a thumbs up with a skin tone, a decomposed é and a family of emoji
+	thumbsUp := "\U0001f44d\U0001f3fd"
+	decomposed := "e\u0301"
+	family := "\U0001f468\u200d\U0001f469\u200d\U0001f467"
+
+	words := wordlist.NewMap(1, 3, map[int]string{
+		1: thumbsUp + thumbsUp,
+		2: "caf" + decomposed,
+		3: family + "x",
+	})
+
+	for i := 0; i < 50; i++ {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:      3,
+			Separator:      "🎲",
+			Wordlist:       words,
+			EnhanceEntropy: true,
+			IncludeDigits:  true,
+		})
+		if !assert.NoError(err) {
+			return
+		}
+
+		phrase := passphrase.String()
+		assert.True(utf8.ValidString(phrase))
+
+		// removing the inserted ASCII digits and symbols must leave the words
+		// intact, as no character was inserted within a multi-rune character
+		for _, word := range strings.Split(phrase, "🎲") {
+			stripped := strings.Map(func(r rune) rune {
+				if r < utf8.RuneSelf && !unicode.IsLetter(r) {
+					return -1
+				}
+
+				return r
+			}, word)
+
+			assert.Contains([]string{thumbsUp + thumbsUp, "caf" + decomposed, family + "x"}, stripped, word)
+		}
+
+		valid, err := diceware.Verify(phrase, "🎲", words)
+		assert.NoError(err)
+		assert.True(valid, phrase)
+	}
+
+	tests := []struct {
+		Name      string
+		MinLength int
+		MaxLength int
+		Words     []string
+	}{
+		{
+			Name:      "will count an emoji with a skin tone as a single character",
+			MaxLength: 2,
+			Words:     []string{thumbsUp + thumbsUp, family + "x"},
+		}, {
+			Name:      "will count a letter with a combining mark as a single character",
+			MinLength: 4,
+			Words:     []string{"caf" + decomposed},
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:     10,
+			Separator:     " ",
+			Wordlist:      words,
+			MinWordLength: test.MinLength,
+			MaxWordLength: test.MaxLength,
+		})
+		if assert.NoError(err, test.Name) {
+			for _, word := range passphrase.Words {
+				assert.Contains(test.Words, word, test.Name)
+			}
+		}
+	}
+
+	rolls, err := diceware.ToRolls(thumbsUp+thumbsUp+"🎲"+family+"x", "🎲", words)
+	if assert.NoError(err) {
+		assert.Equal([][]int{{1}, {3}}, rolls)
+	}
+}
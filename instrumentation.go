@@ -0,0 +1,142 @@
+package diceware
+
+import (
+	"math/big"
+	"time"
+)
+
+// Instrumentation defines the hooks the generation of passphrases reports to,
+// so that the issuance of passphrases is able to be monitored.  The hooks are
+// called from every goroutine generating passphrases, so an Instrumentation
+// must be safe for concurrent use, and should return quickly.
+type Instrumentation interface {
+	// PassphraseGenerated describes the logic to record a passphrase that was
+	// generated, along with the time it took to generate
+	PassphraseGenerated(duration time.Duration)
+
+	// EntropySourceError describes the logic to record a failure of the
+	// RandomSource, which fails the passphrase being generated
+	EntropySourceError(err error)
+}
+
+// WordInstrumentation defines the optional method an Instrumentation can
+// implement to record the number of words rolled for each generated
+// passphrase.
+type WordInstrumentation interface {
+	Instrumentation
+
+	// WordsRolled describes the logic to record the number of words rolled
+	// for a passphrase that was generated, leaving out any checksum word
+	WordsRolled(n int)
+}
+
+// joinedInstrumentation defines an Instrumentation reporting to each of the
+// Instrumentations it joins.
+type joinedInstrumentation []Instrumentation
+
+// JoinInstrumentation returns an Instrumentation.
+// Implements the logic to report to every one of the given Instrumentations,
+// in the order they were given, skipping any nil Instrumentation, so that
+// several are able to be given to `WithInstrumentation`.  Nil is returned when
+// every Instrumentation is nil.
+func JoinInstrumentation(insts ...Instrumentation) Instrumentation {
+	joined := joinedInstrumentation{}
+	for _, inst := range insts {
+		if inst != nil {
+			joined = append(joined, inst)
+		}
+	}
+
+	if len(joined) == 0 {
+		return nil
+	}
+
+	return joined
+}
+
+// PassphraseGenerated implements the logic for the Instrumentation interface,
+// reporting the passphrase to each of the joined Instrumentations.
+func (ji joinedInstrumentation) PassphraseGenerated(duration time.Duration) {
+	for _, inst := range ji {
+		inst.PassphraseGenerated(duration)
+	}
+}
+
+// EntropySourceError implements the logic for the Instrumentation interface,
+// reporting the failure to each of the joined Instrumentations.
+func (ji joinedInstrumentation) EntropySourceError(err error) {
+	for _, inst := range ji {
+		inst.EntropySourceError(err)
+	}
+}
+
+// WordsRolled implements the logic for the WordInstrumentation interface,
+// reporting the words to each of the joined Instrumentations implementing
+// WordInstrumentation.
+func (ji joinedInstrumentation) WordsRolled(n int) {
+	for _, inst := range ji {
+		if wordInst, ok := inst.(WordInstrumentation); ok {
+			wordInst.WordsRolled(n)
+		}
+	}
+}
+
+// instrumentedSource defines a RandomSource reporting every failure of the
+// RandomSource it wraps to an Instrumentation.
+type instrumentedSource struct {
+	// source represents the RandomSource the random numbers are pulled from.
+	source RandomSource
+
+	// inst represents the Instrumentation the failures are reported to.
+	inst Instrumentation
+}
+
+// instrumentedSource64 defines an instrumentedSource wrapping a
+// RandomSource64, so that the wrapped RandomSource64 is still preferred.
+type instrumentedSource64 struct {
+	instrumentedSource
+
+	// source64 represents the RandomSource64 the random numbers are pulled
+	// from.
+	source64 RandomSource64
+}
+
+// instrumentSource returns a RandomSource.
+// Implements the logic to wrap the RandomSource so that its failures are
+// reported to the Instrumentation, keeping its `RandomSource64` implementation.
+func instrumentSource(rs RandomSource, inst Instrumentation) RandomSource {
+	if rs64, ok := rs.(RandomSource64); ok {
+		return instrumentedSource64{
+			instrumentedSource: instrumentedSource{source: rs, inst: inst},
+			source64:           rs64,
+		}
+	}
+
+	return instrumentedSource{source: rs, inst: inst}
+}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the RandomSource interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// wrapped RandomSource, reporting its failure.
+func (rs instrumentedSource) GetRandom(max *big.Int) (*big.Int, error) {
+	value, err := rs.source.GetRandom(max)
+	if err != nil {
+		rs.inst.EntropySourceError(err)
+	}
+
+	return value, err
+}
+
+// GetRandom64 returns an int64.
+// It implements the logic for the RandomSource64 interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// wrapped RandomSource64, reporting its failure.
+func (rs instrumentedSource64) GetRandom64(max int64) (int64, error) {
+	value, err := rs.source64.GetRandom64(max)
+	if err != nil {
+		rs.inst.EntropySourceError(err)
+	}
+
+	return value, err
+}
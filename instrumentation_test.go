@@ -0,0 +1,188 @@
+package diceware_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingInstrumentation is an Instrumentation keeping everything reported
+// to it.
+type recordingInstrumentation struct {
+	mu        sync.Mutex
+	durations []time.Duration
+	errs      []error
+	words     []int
+}
+
+func (ri *recordingInstrumentation) PassphraseGenerated(duration time.Duration) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	ri.durations = append(ri.durations, duration)
+}
+
+func (ri *recordingInstrumentation) EntropySourceError(err error) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	ri.errs = append(ri.errs, err)
+}
+
+func (ri *recordingInstrumentation) WordsRolled(n int) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	ri.words = append(ri.words, n)
+}
+
+// plainInstrumentation is an Instrumentation without WordsRolled.
+type plainInstrumentation struct {
+	generated int
+}
+
+func (pi *plainInstrumentation) PassphraseGenerated(time.Duration) {
+	pi.generated++
+}
+
+func (pi *plainInstrumentation) EntropySourceError(error) {}
+
+func TestInstrumentation(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name         string
+		RandomSource diceware.RandomSource
+		Generate     func(opts diceware.PassphraseOptions) error
+		Generated    int
+		Errors       int
+	}{
+		{
+			Name: "will report a generated passphrase",
+			Generate: func(opts diceware.PassphraseOptions) error {
+				_, err := diceware.GeneratePassphrase(opts)
+				return err
+			},
+			Generated: 1,
+		}, {
+			Name:         "will report a passphrase generated from a RandomSource without GetRandom64",
+			RandomSource: bigIntOnlyRandomSource{},
+			Generate: func(opts diceware.PassphraseOptions) error {
+				_, err := diceware.GeneratePassphrase(opts)
+				return err
+			},
+			Generated: 1,
+		}, {
+			Name: "will report every passphrase of a Generator",
+			Generate: func(opts diceware.PassphraseOptions) error {
+				generator, err := diceware.NewGenerator(opts)
+				if err != nil {
+					return err
+				}
+
+				_, err = generator.GenerateN(3)
+				return err
+			},
+			Generated: 3,
+		}, {
+			Name: "will report every passphrase of a parallel batch",
+			Generate: func(opts diceware.PassphraseOptions) error {
+				_, err := diceware.RollWordsBatchParallel(opts, 10, 4)
+				return err
+			},
+			Generated: 10,
+		}, {
+			Name:         "will report a failure of the RandomSource",
+			RandomSource: failingRandomSource{},
+			Generate: func(opts diceware.PassphraseOptions) error {
+				_, err := diceware.GeneratePassphrase(opts)
+				if err == nil {
+					t.Error("expected the RandomSource to fail")
+				}
+
+				return nil
+			},
+			Errors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		inst := &recordingInstrumentation{}
+		err := test.Generate(diceware.PassphraseOptions{
+			WordCount:       4,
+			Separator:       " ",
+			Wordlist:        wordlist.EFFLong,
+			RandomSource:    test.RandomSource,
+			Instrumentation: inst,
+		})
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Len(inst.durations, test.Generated, test.Name)
+		assert.Len(inst.errs, test.Errors, test.Name)
+		assert.Len(inst.words, test.Generated, test.Name)
+		for _, words := range inst.words {
+			assert.Equal(4, words, test.Name)
+		}
+
+		for _, err := range inst.errs {
+			assert.ErrorIs(err, errEntropy, test.Name)
+		}
+	}
+}
+
+func TestWithInstrumentation(t *testing.T) {
+	assert := assert.New(t)
+
+	inst := &recordingInstrumentation{}
+	generator, err := diceware.New(diceware.WithInstrumentation(inst))
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal(inst, generator.Options().Instrumentation)
+
+	_, err = generator.Generate()
+	assert.NoError(err)
+	assert.Len(inst.durations, 1)
+}
+
+func TestJoinInstrumentation(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(diceware.JoinInstrumentation())
+	assert.Nil(diceware.JoinInstrumentation(nil, nil))
+
+	recording := &recordingInstrumentation{}
+	plain := &plainInstrumentation{}
+
+	_, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:       3,
+		Separator:       " ",
+		Wordlist:        wordlist.EFFLong,
+		Checksum:        true,
+		Instrumentation: diceware.JoinInstrumentation(recording, nil, plain),
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Len(recording.durations, 1)
+	assert.Equal([]int{3}, recording.words, "expected the checksum word to be left out")
+	assert.Equal(1, plain.generated)
+
+	_, err = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:       3,
+		Wordlist:        wordlist.EFFLong,
+		RandomSource:    failingRandomSource{},
+		Instrumentation: diceware.JoinInstrumentation(recording, plain),
+	})
+	assert.Error(err)
+	assert.Len(recording.errs, 1)
+	assert.Equal(1, plain.generated)
+}
@@ -0,0 +1,74 @@
+package diceware
+
+import "errors"
+
+// ErrInvalidJoinStyle represents the error given when a passphrase is
+// requested with an unknown join style, or a join style combined with options
+// that also decide how the words are joined or capitalized
+var ErrInvalidJoinStyle = errors.New("invalid join style given")
+
+// JoinStyle defines the styles in which the words of a passphrase can be
+// joined, keeping the boundaries between the words visible when separators
+// are disallowed.
+type JoinStyle int
+
+const (
+	// JoinSeparator joins the words with the Separator, leaving them as they
+	// are within the wordlist.
+	JoinSeparator JoinStyle = iota
+	// JoinCamelCase joins the words without a separator, upper casing the first
+	// letter of every word, e.g. "CorrectHorseBattery".
+	JoinCamelCase
+	// JoinLowerCamelCase joins the words without a separator, upper casing the
+	// first letter of every word except the first, e.g. "correctHorseBattery".
+	JoinLowerCamelCase
+	// JoinSnakeCase joins the words with an underscore, e.g.
+	// "correct_horse_battery".
+	JoinSnakeCase
+	// JoinKebabCase joins the words with a hyphen, e.g. "correct-horse-battery".
+	JoinKebabCase
+)
+
+// validateJoinStyle returns an error.
+// Implements the logic to verify that the join style is known, and that any
+// style other than JoinSeparator is the only option deciding how the words
+// are joined and capitalized.
+func (opts PassphraseOptions) validateJoinStyle() error {
+	if opts.JoinStyle < JoinSeparator || opts.JoinStyle > JoinKebabCase {
+		return ErrInvalidJoinStyle
+	}
+
+	if opts.JoinStyle != JoinSeparator &&
+		(opts.Capitalization != CapitalizationNone || opts.RandomSeparators || opts.Pattern != "") {
+		return ErrInvalidJoinStyle
+	}
+
+	return nil
+}
+
+// separator returns a string.
+// Implements the logic to select the separator placed between the words,
+// which is decided by the join style unless it is JoinSeparator.
+func (opts PassphraseOptions) separator() string {
+	switch opts.JoinStyle {
+	case JoinCamelCase, JoinLowerCamelCase:
+		return ""
+	case JoinSnakeCase:
+		return "_"
+	case JoinKebabCase:
+		return "-"
+	case JoinSeparator:
+	}
+
+	return opts.Separator
+}
+
+// styleWords implements the logic to capitalize the given words according to
+// the join style, which adds no entropy as every word is treated the same.
+func styleWords(words []string, style JoinStyle) {
+	for i, word := range words {
+		if style == JoinCamelCase || (style == JoinLowerCamelCase && i > 0) {
+			words[i] = titleWord(word)
+		}
+	}
+}
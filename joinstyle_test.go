@@ -0,0 +1,81 @@
+package diceware_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinStyle(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 4, map[int]string{
+		1: "correct",
+		2: "horse",
+		3: "battery",
+		4: "staple",
+	})
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Pattern *regexp.Regexp
+		Error   error
+	}{
+		{
+			Name:    "will join the words with the separator",
+			Options: diceware.PassphraseOptions{Separator: "+"},
+			Pattern: regexp.MustCompile(`^[a-z]+(\+[a-z]+){3}$`),
+		}, {
+			Name:    "will join the words in camel case",
+			Options: diceware.PassphraseOptions{Separator: "+", JoinStyle: diceware.JoinCamelCase},
+			Pattern: regexp.MustCompile(`^([A-Z][a-z]+){4}$`),
+		}, {
+			Name:    "will join the words in lower camel case",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinLowerCamelCase},
+			Pattern: regexp.MustCompile(`^[a-z]+([A-Z][a-z]+){3}$`),
+		}, {
+			Name:    "will join the words in snake case",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinSnakeCase},
+			Pattern: regexp.MustCompile(`^[a-z]+(_[a-z]+){3}$`),
+		}, {
+			Name:    "will join the words in kebab case",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinKebabCase},
+			Pattern: regexp.MustCompile(`^[a-z]+(-[a-z]+){3}$`),
+		}, {
+			Name:    "will error with an unknown join style",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinStyle(-1)},
+			Error:   diceware.ErrInvalidJoinStyle,
+		}, {
+			Name: "will error when combined with a capitalization",
+			Options: diceware.PassphraseOptions{
+				JoinStyle:      diceware.JoinCamelCase,
+				Capitalization: diceware.CapitalizationAllUpper,
+			},
+			Error: diceware.ErrInvalidJoinStyle,
+		}, {
+			Name:    "will error when combined with random separators",
+			Options: diceware.PassphraseOptions{JoinStyle: diceware.JoinSnakeCase, RandomSeparators: true},
+			Error:   diceware.ErrInvalidJoinStyle,
+		},
+	}
+
+	for _, test := range tests {
+		test.Options.WordCount = 4
+		test.Options.Wordlist = words
+
+		passphrase, err := diceware.GeneratePassphrase(test.Options)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Regexp(test.Pattern, passphrase.String(), test.Name)
+			assert.InDelta(8, passphrase.EntropyBits, 0.0001, test.Name)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package diceware
+
+// leetSubstitutions represents the leet equivalent of each letter that can be
+// substituted.
+var leetSubstitutions = map[rune]rune{
+	'a': '4',
+	'b': '8',
+	'e': '3',
+	'g': '9',
+	'i': '1',
+	'o': '0',
+	's': '5',
+	't': '7',
+}
+
+// leetspeakWords returns a float64.
+// Implements the logic to substitute each lower case letter of the words that
+// has a leet equivalent with a coin flip from the RandomSource, returning the
+// entropy in bits added by the flips, which is 1 bit per eligible letter.
+func leetspeakWords(words []string, rs RandomSource) (float64, error) {
+	bits := 0.0
+	for i, word := range words {
+		runes := []rune(word)
+		for j, r := range runes {
+			substitute, found := leetSubstitutions[r]
+			if !found {
+				continue
+			}
+
+			flip, err := randomInt(rs, 2)
+			if err != nil {
+				return 0, err
+			}
+
+			if flip == 1 {
+				runes[j] = substitute
+			}
+
+			bits++
+		}
+
+		words[i] = string(runes)
+	}
+
+	return bits, nil
+}
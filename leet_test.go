@@ -0,0 +1,33 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeetspeak(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 2, map[int]string{
+		1: "cheese",
+		2: "Toast",
+	})
+
+	// rolls "cheese" and "Toast", then flips a coin for each of the eligible
+	// letters: the three e's and the s of "cheese", then the o, a, s and t of
+	// "Toast", skipping the upper case T.
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:    2,
+		Separator:    " ",
+		Wordlist:     words,
+		Leetspeak:    true,
+		RandomSource: &sequenceRandomSource{values: []int64{0, 1, 1, 0, 1, 0, 1, 1, 0, 1}},
+	})
+	if assert.NoError(err) {
+		assert.Equal("ch3e5e T04s7", passphrase.String())
+		assert.InDelta(2+8, passphrase.EntropyBits, 0.0001)
+	}
+}
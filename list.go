@@ -0,0 +1,131 @@
+package diceware
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// List defines the simplified methods required to implement a list of words,
+// describing the wordlist by its words alone rather than by the dice rolled
+// to select them, without any *big.Int.  A List is converted into a Wordlist
+// with `FromList`, and a Wordlist into a List with `ToList`, so custom
+// wordlists can be written against List while every function still accepts a
+// Wordlist.  List is planned to replace Wordlist in the next major version.
+type List interface {
+	// Len describes the number of words within the list
+	Len() int
+
+	// WordAt describes the logic to fetch a word from the list with the given
+	// index within the range of [0, Len())
+	WordAt(int) string
+}
+
+// listWordlist defines the adapter of a List to the Wordlist interface, which
+// is rolled with a single die having a side for each of the words.
+type listWordlist struct {
+	// list represents the adapted list.
+	list List
+}
+
+// FromList returns a Wordlist.
+// Implements the logic to adapt the given List into a Wordlist rolled with a
+// single die having a side for each of the words, so that the roll value of
+// the word at index i is i+1.  Every word is sampled with the same
+// probability, regardless of the number of words.
+func FromList(l List) Wordlist {
+	if wl, ok := l.(Wordlist); ok {
+		return wl
+	}
+
+	return &listWordlist{list: l}
+}
+
+// FetchWord returns a string.
+// It implements the logic for the Wordlist interface which pulls the word of
+// the roll value, the index of the word plus 1, from the list.
+func (wl *listWordlist) FetchWord(rollValue int) string {
+	return wl.WordAt(rollValue - 1)
+}
+
+// Rolls returns an int.
+// It implements the logic for the Wordlist interface which gives the single
+// die rolled for every word.
+func (wl *listWordlist) Rolls() int {
+	return 1
+}
+
+// SidesOfDice returns a *big.Int.
+// It implements the logic for the Wordlist interface which gives a side of
+// the die for each of the words.
+func (wl *listWordlist) SidesOfDice() *big.Int {
+	return big.NewInt(int64(wl.list.Len()))
+}
+
+// Len returns an int.
+// It implements the logic for the IndexedWordlist interface which gives the
+// number of words within the list.
+func (wl *listWordlist) Len() int {
+	return wl.list.Len()
+}
+
+// WordAt returns a string.
+// It implements the logic for the IndexedWordlist interface which pulls the
+// word found at the given index from the list.
+func (wl *listWordlist) WordAt(index int) string {
+	if index < 0 || index >= wl.list.Len() {
+		return ""
+	}
+
+	return wl.list.WordAt(index)
+}
+
+// wordlistList defines the adapter of a Wordlist to the List interface, which
+// indexes the roll values of the dice in ascending order.
+type wordlistList struct {
+	// wl represents the adapted wordlist.
+	wl Wordlist
+}
+
+// ToList returns a List.
+// Implements the logic to adapt the given Wordlist into a List, where the
+// index of every word is the position of its roll value within the ascending
+// roll values of the dice.  Wordlists implementing IndexedWordlist are
+// returned as they are.
+func ToList(wl Wordlist) List {
+	if indexed, ok := wl.(IndexedWordlist); ok {
+		return indexed
+	}
+
+	return &wordlistList{wl: wl}
+}
+
+// Len returns an int.
+// It implements the logic for the List interface which gives the number of
+// roll values that can be rolled with the dice of the wordlist, which is zero
+// when the number does not fit within an int.
+func (l *wordlistList) Len() int {
+	sides := l.wl.SidesOfDice()
+	if l.wl.Rolls() < 1 || sides.Sign() <= 0 {
+		return 0
+	}
+
+	total := new(big.Int).Exp(sides, big.NewInt(int64(l.wl.Rolls())), nil)
+	if !total.IsInt64() || total.Int64() > math.MaxInt {
+		return 0
+	}
+
+	return int(total.Int64())
+}
+
+// WordAt returns a string.
+// It implements the logic for the List interface which pulls the word of the
+// roll value found at the given index from the wordlist.
+func (l *wordlistList) WordAt(index int) string {
+	if index < 0 || index >= l.Len() {
+		return ""
+	}
+
+	return l.wl.FetchWord(wordlist.RollValueForIndex(index, l.wl.Rolls(), int(l.wl.SidesOfDice().Int64())))
+}
@@ -0,0 +1,76 @@
+package diceware_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// stringList defines a List of plain strings.
+type stringList []string
+
+func (l stringList) Len() int {
+	return len(l)
+}
+
+func (l stringList) WordAt(index int) string {
+	return l[index]
+}
+
+func TestFromList(t *testing.T) {
+	assert := assert.New(t)
+
+	list := stringList{"correct", "horse", "battery", "staple", "orange"}
+	wl := diceware.FromList(list)
+
+	assert.Equal(1, wl.Rolls())
+	assert.Equal(int64(5), wl.SidesOfDice().Int64())
+	assert.Equal("correct", wl.FetchWord(1))
+	assert.Equal("orange", wl.FetchWord(5))
+	assert.Equal("", wl.FetchWord(6))
+	assert.InDelta(math.Log2(5), diceware.WordEntropy(wl), 0.0001)
+
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wl,
+	})
+	if assert.NoError(err) {
+		for i, word := range passphrase.Words {
+			assert.Contains(list, word)
+			assert.Equal(word, wl.FetchWord(passphrase.Rolls[i]))
+		}
+	}
+
+	// a List that already is a Wordlist is used as it is
+	assert.Same(wordlist.EFFLong, diceware.FromList(wordlist.EFFLong))
+}
+
+func TestToList(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist diceware.Wordlist
+	}{
+		{
+			Name:     "will return an IndexedWordlist as it is",
+			Wordlist: wordlist.EFFLong,
+		}, {
+			Name:     "will adapt a Wordlist without indexes",
+			Wordlist: struct{ diceware.Wordlist }{wordlist.EFFLong},
+		},
+	}
+
+	for _, test := range tests {
+		list := diceware.ToList(test.Wordlist)
+		assert.Equal(7776, list.Len(), test.Name)
+		assert.Equal("abacus", list.WordAt(0), test.Name)
+		assert.Equal("zoom", list.WordAt(7775), test.Name)
+	}
+
+	assert.Equal("", diceware.ToList(struct{ diceware.Wordlist }{wordlist.EFFLong}).WordAt(7776))
+}
@@ -0,0 +1,554 @@
+package diceware
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// ErrInvalidWordCount represents the error given when a passphrase is
+	// requested with less than a single word
+	ErrInvalidWordCount = errors.New("invalid word count given, must be at least 1")
+	// ErrInvalidMinEntropy represents the error given when a passphrase is
+	// requested with a negative minimum entropy
+	ErrInvalidMinEntropy = errors.New("invalid negative minimum entropy given")
+	// ErrInvalidCapitalization represents the error given when a passphrase is
+	// requested with an unknown capitalization mode
+	ErrInvalidCapitalization = errors.New("invalid capitalization mode given")
+	// ErrInvalidEnhancementCharset represents the error given when the
+	// enhancement charset contains an empty or repeated character, or only
+	// characters found within the separator
+	ErrInvalidEnhancementCharset = errors.New("invalid enhancement charset given")
+	// ErrInvalidWordLength represents the error given when the word length
+	// constraints are negative or the minimum exceeds the maximum
+	ErrInvalidWordLength = errors.New("invalid word length constraints given")
+	// ErrInvalidDigits represents the error given when a digit is requested to
+	// be included but every digit is found within the separators
+	ErrInvalidDigits = errors.New("invalid digits requested, every digit is found within the separators")
+)
+
+// PassphraseOptions defines the configuration that is utilized in order to
+// generate a diceware passphrase.
+type PassphraseOptions struct {
+	// WordCount represents the number of words that should be returned within
+	// the passphrase.
+	WordCount int
+
+	// Separator represents the character(s) used to separate each of the
+	// passphrase words.
+	Separator string
+
+	// RandomSeparators represents whether each gap between the words should be
+	// given a separator chosen at random from the SeparatorSet, in place of
+	// Separator.
+	RandomSeparators bool
+
+	// SeparatorSet represents the separators chosen from when RandomSeparators
+	// is enabled.  When empty, `DefaultSeparatorSet()` is used.
+	SeparatorSet []string
+
+	// StrictSeparator represents whether the separator, or each separator of
+	// the SeparatorSet when RandomSeparators is enabled, must not be found
+	// within any of the words of the wordlist, so that the passphrase is able
+	// to be split back into its words.  The separators of a pattern are not
+	// checked.
+	StrictSeparator bool
+
+	// Wordlist represents the implementation of the `diceware.Wordlist` that
+	// will be utilized in order to fetch the words for the final passphrase.
+	Wordlist Wordlist
+
+	// Pattern represents the structure of the passphrase, where each `W` is
+	// replaced by a rolled word, each `D` by a random digit, each `S` by a
+	// random symbol and every other character is kept literally, e.g.
+	// "W-W-DD-W!".  A character is escaped with a backslash.  When given, the
+	// pattern replaces WordCount, MinEntropyBits and Separator.
+	Pattern string
+
+	// EnhanceEntropy represents whether a random character or number should be
+	// added within the passphrase.  At minimum 1 word within the passphrase
+	// will be modified.
+	EnhanceEntropy bool
+
+	// EnhancementCharset represents the characters EnhanceEntropy chooses from,
+	// replacing the characters and numbers within `wordlist.ExtraEntropy`.
+	EnhancementCharset []string
+
+	// MinEntropyBits represents the minimum entropy in bits the passphrase must
+	// provide.  When given, the word count is raised to the smallest number of
+	// words from the wordlist meeting it, allowing WordCount to be left as 0.
+	MinEntropyBits float64
+
+	// IncludeDigits represents whether a single random digit should be inserted
+	// into a randomly chosen word, without any of the symbols EnhanceEntropy
+	// may add.  Digits found within the separators are never inserted.
+	IncludeDigits bool
+
+	// MinWordLength represents the minimum number of characters a rolled word
+	// must have, words that are shorter are re-rolled.  Zero disables the
+	// constraint.
+	MinWordLength int
+
+	// MaxWordLength represents the maximum number of characters a rolled word
+	// may have, words that are longer are re-rolled.  Zero disables the
+	// constraint.
+	MaxWordLength int
+
+	// ExcludeWords represents words of the wordlist that must never appear
+	// within the passphrase, which are re-rolled.
+	ExcludeWords []string
+
+	// ExcludeFunc represents a predicate of words that must never appear within
+	// the passphrase, which are re-rolled when it returns true.
+	ExcludeFunc func(word string) bool
+
+	// Capitalization represents the mode in which the words of the passphrase
+	// are capitalized.
+	Capitalization Capitalization
+
+	// JoinStyle represents the style in which the words are joined.  Any style
+	// other than JoinSeparator replaces Separator, and is unable to be
+	// combined with Capitalization, RandomSeparators or a Pattern.
+	JoinStyle JoinStyle
+
+	// Leetspeak represents whether each lower case letter of the words with a
+	// leet equivalent (a→4, e→3, …) should be substituted at random, with each
+	// eligible letter adding 1 bit of entropy.
+	Leetspeak bool
+
+	// RequireClasses represents the classes of characters the passphrase is
+	// guaranteed to contain.  Each missing class is added by upper casing a
+	// random letter, or inserting a random digit or symbol into a random word.
+	RequireClasses CharacterClass
+
+	// Checksum represents whether a checksum word, derived from the preceding
+	// words, should be appended to the passphrase so that transcription errors
+	// are caught by `ValidateChecksum`.  The checksum word is added beyond
+	// WordCount and provides no entropy.  It requires a non-empty Separator
+	// and is unable to be combined with RandomSeparators, Leetspeak,
+	// IncludeDigits, EnhanceEntropy or RequireClasses other than ClassUpper,
+	// which `ValidateChecksum` is unable to undo.  The checksum word satisfies
+	// the word constraints, which `ValidateChecksumWithOptions` accounts for.
+	Checksum bool
+
+	// Transcript represents whether a `Transcript` of every roll should be
+	// captured alongside the passphrase.  The transcript reveals the
+	// passphrase, so it must be handled with the same care.
+	Transcript bool
+
+	// Frequencies represents the frequency scores of the words, used to list
+	// the rare words of the passphrase within `Passphrase.RareWords`.  The
+	// scores never change which words are rolled.  When nil, the scores of a
+	// wordlist implementing `wordlist.Frequencies` are used.
+	Frequencies wordlist.Frequencies
+
+	// RandomSource represents the source of every random choice made while
+	// generating the passphrase.  When nil, `CryptoRandomSource` is used.
+	RandomSource RandomSource
+
+	// Instrumentation represents the hooks every generated passphrase and every
+	// failure of the RandomSource are reported to.  When nil, nothing is
+	// reported.
+	Instrumentation Instrumentation
+}
+
+// frequencies returns a wordlist.Frequencies.
+// Implements the logic to give the frequency scores of the words, preferring
+// the Frequencies option over those of the wordlist, which is nil when
+// neither is available.
+func (opts PassphraseOptions) frequencies() wordlist.Frequencies {
+	if opts.Frequencies != nil {
+		return opts.Frequencies
+	}
+
+	if frequencies, ok := opts.Wordlist.(wordlist.Frequencies); ok {
+		return frequencies
+	}
+
+	return nil
+}
+
+// Validate returns an error.
+// Implements the logic to verify that the options are able to generate a
+// passphrase, returning the first problem that was found.
+func (opts PassphraseOptions) Validate() error {
+	if opts.Wordlist == nil {
+		return ErrInvalidWordlist
+	}
+
+	if opts.MinEntropyBits < 0 {
+		return ErrInvalidMinEntropy
+	}
+
+	if opts.Pattern != "" {
+		if _, err := parsePattern(opts.Pattern); err != nil {
+			return err
+		}
+
+		if opts.RandomSeparators {
+			return fmt.Errorf("%w: random separators are unable to be combined with a pattern", ErrInvalidPattern)
+		}
+
+		if opts.Checksum {
+			return fmt.Errorf("%w: a checksum word is unable to be combined with a pattern", ErrInvalidPattern)
+		}
+	}
+
+	if opts.wordCount(WordEntropy(opts.Wordlist)) < 1 {
+		return ErrInvalidWordCount
+	}
+
+	if opts.MinWordLength < 0 || opts.MaxWordLength < 0 ||
+		(opts.MaxWordLength > 0 && opts.MinWordLength > opts.MaxWordLength) {
+		return ErrInvalidWordLength
+	}
+
+	if opts.Capitalization < CapitalizationNone || opts.Capitalization > CapitalizationRandomLetter {
+		return ErrInvalidCapitalization
+	}
+
+	if err := opts.validateJoinStyle(); err != nil {
+		return err
+	}
+
+	if err := validateSeparatorSet(opts.SeparatorSet); err != nil {
+		return err
+	}
+
+	if err := opts.validateRequiredClasses(); err != nil {
+		return err
+	}
+
+	if err := opts.validateStrictSeparator(); err != nil {
+		return err
+	}
+
+	if err := opts.validateChecksum(); err != nil {
+		return err
+	}
+
+	return opts.validateInsertions()
+}
+
+// validateInsertions returns an error.
+// Implements the logic to verify that the digits and the characters of the
+// enhancement charset inserted into the words are able to be used alongside
+// the separators.
+func (opts PassphraseOptions) validateInsertions() error {
+	if opts.IncludeDigits && digitEntropy(opts.reservedSeparators()) == 0 {
+		return ErrInvalidDigits
+	}
+
+	if opts.EnhancementCharset != nil {
+		return validateCharset(opts.EnhancementCharset, opts.reservedSeparators())
+	}
+
+	return nil
+}
+
+// validateCharset returns an error.
+// Implements the logic to verify that every character is non-empty and distinct
+// and that at least 1 of the characters is able to be used alongside the
+// separator.
+func validateCharset(charset []string, separator string) error {
+	usable := false
+	seen := make(map[string]bool, len(charset))
+	for _, character := range charset {
+		if character == "" {
+			return ErrInvalidEnhancementCharset
+		}
+
+		if seen[character] {
+			return fmt.Errorf("%w: %q is repeated", ErrInvalidEnhancementCharset, character)
+		}
+
+		seen[character] = true
+
+		if !strings.Contains(separator, character) {
+			usable = true
+		}
+	}
+
+	if !usable {
+		return ErrInvalidEnhancementCharset
+	}
+
+	return nil
+}
+
+// patternSymbols returns a slice of strings.
+// Implements the logic to select the symbols the `S` of a pattern chooses
+// from, which are the EnhancementCharset when given.
+func (opts PassphraseOptions) patternSymbols() []string {
+	if opts.EnhancementCharset != nil {
+		return opts.EnhancementCharset
+	}
+
+	return symbolsExcluding("")
+}
+
+// enhancementWordlist returns a Wordlist.
+// Implements the logic to select the characters EnhanceEntropy chooses from.
+func (opts PassphraseOptions) enhancementWordlist() Wordlist {
+	if opts.EnhancementCharset == nil {
+		return wordlist.ExtraEntropy
+	}
+
+	return charsetWordlist(opts.EnhancementCharset)
+}
+
+// wordCount returns an int.
+// Implements the logic to determine the number of words to roll, taking the
+// larger of WordCount and the words, each providing perWord bits of entropy,
+// needed to meet MinEntropyBits, unless the words are given by a pattern.
+func (opts PassphraseOptions) wordCount(perWord float64) int {
+	if opts.Pattern != "" {
+		tokens, _ := parsePattern(opts.Pattern)
+		return patternWordCount(tokens)
+	}
+
+	if suggested := wordsForEntropy(perWord, opts.MinEntropyBits); suggested > opts.WordCount {
+		return suggested
+	}
+
+	return opts.WordCount
+}
+
+// clone returns a PassphraseOptions.
+// Implements the logic to copy the options along with each of their slices,
+// so that the copy is unaffected by changes made to the original.
+func (opts PassphraseOptions) clone() PassphraseOptions {
+	opts.SeparatorSet = slices.Clone(opts.SeparatorSet)
+	opts.EnhancementCharset = slices.Clone(opts.EnhancementCharset)
+	opts.ExcludeWords = slices.Clone(opts.ExcludeWords)
+
+	return opts
+}
+
+// randomSource returns a RandomSource.
+// Implements the logic to fall back to `CryptoRandomSource` when no
+// RandomSource was configured, reporting its failures to the Instrumentation
+// when one was configured.
+func (opts PassphraseOptions) randomSource() RandomSource {
+	rs := opts.RandomSource
+	if rs == nil {
+		rs = CryptoRandomSource{}
+	}
+
+	if opts.Instrumentation != nil {
+		return instrumentSource(rs, opts.Instrumentation)
+	}
+
+	return rs
+}
+
+// DefaultWordCount represents the number of words used by `New` when no word
+// count option is given.
+const DefaultWordCount = 6
+
+// DefaultSeparator represents the separator used by `New` when no separator
+// option is given.
+const DefaultSeparator = " "
+
+// Option defines a function that modifies the PassphraseOptions used when
+// creating a Generator through `New`.
+type Option func(*PassphraseOptions)
+
+// WithWordCount returns an Option that sets the number of words within the
+// passphrase.
+func WithWordCount(wordCount int) Option {
+	return func(opts *PassphraseOptions) {
+		opts.WordCount = wordCount
+	}
+}
+
+// WithSeparator returns an Option that sets the character(s) used to separate
+// each of the passphrase words.
+func WithSeparator(separator string) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Separator = separator
+	}
+}
+
+// WithStrictSeparator returns an Option that sets whether the separators must
+// not be found within any of the words of the wordlist.
+func WithStrictSeparator(strict bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.StrictSeparator = strict
+	}
+}
+
+// WithWordlist returns an Option that sets the wordlist words are fetched from.
+func WithWordlist(wl Wordlist) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Wordlist = wl
+	}
+}
+
+// WithRandomSeparators returns an Option that enables a random separator for
+// each gap between the words, chosen from the given set or
+// `DefaultSeparatorSet()` when no set is given.
+func WithRandomSeparators(set ...string) Option {
+	return func(opts *PassphraseOptions) {
+		opts.RandomSeparators = true
+		opts.SeparatorSet = set
+	}
+}
+
+// WithPattern returns an Option that sets the structure of the passphrase.
+func WithPattern(pattern string) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Pattern = pattern
+	}
+}
+
+// WithEnhanceEntropy returns an Option that sets whether a random character or
+// number should be added within the passphrase.
+func WithEnhanceEntropy(enhanceEntropy bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.EnhanceEntropy = enhanceEntropy
+	}
+}
+
+// WithEnhancementCharset returns an Option that sets the characters
+// EnhanceEntropy chooses from.
+func WithEnhancementCharset(charset ...string) Option {
+	return func(opts *PassphraseOptions) {
+		opts.EnhancementCharset = charset
+	}
+}
+
+// WithIncludeDigits returns an Option that sets whether a single random digit
+// should be inserted within the passphrase.
+func WithIncludeDigits(includeDigits bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.IncludeDigits = includeDigits
+	}
+}
+
+// WithMinEntropyBits returns an Option that sets the minimum entropy in bits
+// the passphrase must provide.
+func WithMinEntropyBits(bits float64) Option {
+	return func(opts *PassphraseOptions) {
+		opts.MinEntropyBits = bits
+	}
+}
+
+// WithWordLength returns an Option that sets the minimum and maximum number
+// of characters a rolled word may have, where zero disables either constraint.
+func WithWordLength(minLength, maxLength int) Option {
+	return func(opts *PassphraseOptions) {
+		opts.MinWordLength = minLength
+		opts.MaxWordLength = maxLength
+	}
+}
+
+// WithExcludeWords returns an Option that sets the words of the wordlist that
+// must never appear within the passphrase.
+func WithExcludeWords(words ...string) Option {
+	return func(opts *PassphraseOptions) {
+		opts.ExcludeWords = words
+	}
+}
+
+// WithExcludeFunc returns an Option that sets the predicate of words that must
+// never appear within the passphrase.
+func WithExcludeFunc(exclude func(word string) bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.ExcludeFunc = exclude
+	}
+}
+
+// WithCapitalization returns an Option that sets the mode in which the words
+// of the passphrase are capitalized.
+func WithCapitalization(mode Capitalization) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Capitalization = mode
+	}
+}
+
+// WithJoinStyle returns an Option that sets the style in which the words of
+// the passphrase are joined.
+func WithJoinStyle(style JoinStyle) Option {
+	return func(opts *PassphraseOptions) {
+		opts.JoinStyle = style
+	}
+}
+
+// WithLeetspeak returns an Option that sets whether letters of the words
+// should be substituted at random with their leet equivalents.
+func WithLeetspeak(leetspeak bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Leetspeak = leetspeak
+	}
+}
+
+// WithRequireClasses returns an Option that sets the classes of characters the
+// passphrase is guaranteed to contain.
+func WithRequireClasses(classes CharacterClass) Option {
+	return func(opts *PassphraseOptions) {
+		opts.RequireClasses = classes
+	}
+}
+
+// WithChecksum returns an Option that sets whether a checksum word should be
+// appended to the passphrase.
+func WithChecksum(checksum bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Checksum = checksum
+	}
+}
+
+// WithTranscript returns an Option that sets whether a transcript of every
+// roll should be captured alongside the passphrase.
+func WithTranscript(transcript bool) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Transcript = transcript
+	}
+}
+
+// WithFrequencies returns an Option that sets the frequency scores of the
+// words, used to list the rare words of the passphrase.
+func WithFrequencies(frequencies wordlist.Frequencies) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Frequencies = frequencies
+	}
+}
+
+// WithRandomSource returns an Option that sets the source of every random
+// choice made while generating the passphrase.
+func WithRandomSource(rs RandomSource) Option {
+	return func(opts *PassphraseOptions) {
+		opts.RandomSource = rs
+	}
+}
+
+// WithInstrumentation returns an Option that sets the hooks every generated
+// passphrase and every failure of the RandomSource are reported to.
+func WithInstrumentation(inst Instrumentation) Option {
+	return func(opts *PassphraseOptions) {
+		opts.Instrumentation = inst
+	}
+}
+
+// New returns an initialized Generator object.
+// Implements the logic to apply each of the given options on top of the
+// defaults, which are a `DefaultWordCount` word passphrase using the
+// `DefaultSeparator` and the EFF long wordlist.
+func New(options ...Option) (*Generator, error) {
+	opts := PassphraseOptions{
+		WordCount: DefaultWordCount,
+		Separator: DefaultSeparator,
+		Wordlist:  wordlist.EFFLong,
+	}
+
+	for _, option := range options {
+		option(&opts)
+	}
+
+	return NewGenerator(opts)
+}
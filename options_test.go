@@ -0,0 +1,118 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassphraseOptionsValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Error   error
+		Options diceware.PassphraseOptions
+	}{
+		{
+			Name:    "will error with a nil wordlist",
+			Error:   diceware.ErrInvalidWordlist,
+			Options: diceware.PassphraseOptions{WordCount: 6},
+		}, {
+			Name:    "will error with a word count of zero",
+			Error:   diceware.ErrInvalidWordCount,
+			Options: diceware.PassphraseOptions{Wordlist: wordlist.EFFLong},
+		}, {
+			Name:  "will error with a negative minimum entropy",
+			Error: diceware.ErrInvalidMinEntropy,
+			Options: diceware.PassphraseOptions{
+				Wordlist:       wordlist.EFFLong,
+				MinEntropyBits: -1,
+			},
+		}, {
+			Name: "will validate a minimum entropy without a word count",
+			Options: diceware.PassphraseOptions{
+				Wordlist:       wordlist.EFFLong,
+				MinEntropyBits: 80,
+			},
+		}, {
+			Name: "will validate a usable configuration",
+			Options: diceware.PassphraseOptions{
+				WordCount: 6,
+				Separator: "-",
+				Wordlist:  wordlist.EFFLong,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(test.Options.Validate(), test.Error, test.Name)
+	}
+}
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Error    error
+		Options  []diceware.Option
+		Expected diceware.PassphraseOptions
+	}{
+		{
+			Name: "will use the defaults without any options",
+			Expected: diceware.PassphraseOptions{
+				WordCount: diceware.DefaultWordCount,
+				Separator: diceware.DefaultSeparator,
+				Wordlist:  wordlist.EFFLong,
+			},
+		}, {
+			Name: "will apply each of the given options",
+			Options: []diceware.Option{
+				diceware.WithWordCount(8),
+				diceware.WithSeparator("-"),
+				diceware.WithWordlist(wordlist.EFFLong),
+				diceware.WithEnhanceEntropy(true),
+				diceware.WithIncludeDigits(true),
+				diceware.WithMinEntropyBits(90),
+			},
+			Expected: diceware.PassphraseOptions{
+				WordCount:      8,
+				Separator:      "-",
+				Wordlist:       wordlist.EFFLong,
+				EnhanceEntropy: true,
+				MinEntropyBits: 90,
+				IncludeDigits:  true,
+			},
+		}, {
+			Name: "will apply the frequencies option",
+			Options: []diceware.Option{
+				diceware.WithFrequencies(wordlist.FrequencyTable{"abacus": 3.1}),
+			},
+			Expected: diceware.PassphraseOptions{
+				WordCount:   diceware.DefaultWordCount,
+				Separator:   diceware.DefaultSeparator,
+				Wordlist:    wordlist.EFFLong,
+				Frequencies: wordlist.FrequencyTable{"abacus": 3.1},
+			},
+		}, {
+			Name:    "will error with an invalid option",
+			Error:   diceware.ErrInvalidWordCount,
+			Options: []diceware.Option{diceware.WithWordCount(0)},
+		},
+	}
+
+	for _, test := range tests {
+		generator, err := diceware.New(test.Options...)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Expected, generator.Options(), test.Name)
+		}
+	}
+}
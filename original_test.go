@@ -0,0 +1,22 @@
+//go:build !diceware_minimal && !diceware_no_original
+
+package diceware_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollWordsOriginal(t *testing.T) {
+	assert := assert.New(t)
+
+	passphrase, err := diceware.RollWords(8, "_", wordlist.Original)
+	if assert.NoError(err, "Rolling several words with the original wordlist") {
+		assert.NotEmpty(passphrase)
+		assert.Len(strings.Split(passphrase, "_"), 8)
+	}
+}
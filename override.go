@@ -0,0 +1,162 @@
+package diceware
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+const (
+	// MaxOverrideWords represents the largest number of words an Override is
+	// able to ask for.
+	MaxOverrideWords = 64
+	// MaxOverrideSeparatorLength represents the largest number of characters an
+	// Override is able to give as the separator.
+	MaxOverrideSeparatorLength = 8
+)
+
+var (
+	// ErrInvalidOverride represents the error given when an Override asks for
+	// an invalid number of words, a wordlist that is unable to be selected or
+	// an invalid separator
+	ErrInvalidOverride = errors.New("invalid override given")
+	// ErrInsufficientEntropy represents the error given when an Override asks
+	// for fewer words than are needed to meet the minimum entropy of the
+	// Generator
+	ErrInsufficientEntropy = errors.New("override falls below the minimum entropy")
+)
+
+// Override defines the changes a request made to a passphrase service makes to
+// the options of the Generator serving it, where each zero value keeps the
+// option of the Generator.
+type Override struct {
+	// Words represents the number of words within the passphrase, between 1
+	// and MaxOverrideWords.
+	Words int
+
+	// Wordlist represents the name of the registered wordlist the words are
+	// rolled from.
+	Wordlist string
+
+	// Separator represents the character(s) placed between the words, at most
+	// MaxOverrideSeparatorLength characters long.
+	Separator string
+}
+
+// OverrideError defines the error given when an Override is rejected, naming
+// the field that was rejected so that a service is able to describe it in the
+// terms of its own requests.
+type OverrideError struct {
+	// Field represents the rejected field of the Override, which is one of
+	// "words", "wordlist" or "separator".
+	Field string
+
+	// Reason represents the description of what the field must hold, e.g.
+	// "must be between 1 and 64".
+	Reason string
+
+	// Err represents the cause of the rejection, which is either
+	// ErrInvalidOverride or ErrInsufficientEntropy.
+	Err error
+}
+
+// Error returns a string.
+// It implements the logic for the error interface which describes the
+// rejected field along with what it must hold.
+func (e *OverrideError) Error() string {
+	return fmt.Sprintf("%s: %s %s", e.Err, e.Field, e.Reason)
+}
+
+// Unwrap returns an error.
+// Implements the logic to give the cause of the rejection to `errors.Is`.
+func (e *OverrideError) Unwrap() error {
+	return e.Err
+}
+
+// Override returns a Generator.
+// Implements the logic to apply the changes of the Override to the options of
+// the Generator, keeping every other option, for services generating
+// passphrases on behalf of their requests.  Wordlist is only able to select the
+// registered wordlists named by allowed, or any registered wordlist when none
+// are named, and a number of words too small to meet the MinEntropyBits of the
+// Generator is rejected rather than raised.  The Generator itself is returned
+// when the Override changes nothing.
+func (g *Generator) Override(override Override, allowed ...string) (*Generator, error) {
+	if override == (Override{}) {
+		return g, nil
+	}
+
+	opts := g.Options()
+	if override.Words != 0 {
+		if override.Words < 1 || override.Words > MaxOverrideWords {
+			return nil, &OverrideError{
+				Field:  "words",
+				Reason: fmt.Sprintf("must be between 1 and %d", MaxOverrideWords),
+				Err:    ErrInvalidOverride,
+			}
+		}
+
+		opts.WordCount = override.Words
+	}
+
+	if override.Wordlist != "" {
+		wl, err := selectWordlist(override.Wordlist, allowed)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Wordlist = wl
+	}
+
+	if override.Separator != "" {
+		if characterCount(override.Separator) > MaxOverrideSeparatorLength {
+			return nil, &OverrideError{
+				Field:  "separator",
+				Reason: fmt.Sprintf("must be at most %d characters", MaxOverrideSeparatorLength),
+				Err:    ErrInvalidOverride,
+			}
+		}
+
+		opts.Separator = override.Separator
+	}
+
+	generator, err := NewGenerator(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if required := generator.opts.wordCount(generator.plan.perWord); override.Words != 0 &&
+		required > override.Words {
+		return nil, &OverrideError{
+			Field:  "words",
+			Reason: fmt.Sprintf("must be at least %d to provide %g bits of entropy", required, opts.MinEntropyBits),
+			Err:    ErrInsufficientEntropy,
+		}
+	}
+
+	return generator, nil
+}
+
+// selectWordlist returns a Wordlist.
+// Implements the logic to retrieve the registered wordlist of the given name,
+// provided that it is named by allowed or that allowed is empty.
+func selectWordlist(name string, allowed []string) (Wordlist, error) {
+	names := allowed
+	if len(names) == 0 {
+		names = wordlist.Names()
+	}
+
+	wl, err := wordlist.Get(name)
+	if err != nil || !slices.Contains(names, name) {
+		return nil, &OverrideError{
+			Field:  "wordlist",
+			Reason: "must be one of: " + strings.Join(names, ", "),
+			Err:    ErrInvalidOverride,
+		}
+	}
+
+	return wl, nil
+}
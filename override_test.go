@@ -0,0 +1,150 @@
+package diceware_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorOverride(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		MinEntropyBits: 64,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	custom, err := diceware.NewGenerator(diceware.PassphraseOptions{
+		WordCount: 2,
+		Separator: " ",
+		Wordlist:  wordlist.NewMap(1, 2, map[int]string{1: "alpha", 2: "bravo"}),
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name      string
+		Generator *diceware.Generator
+		Override  diceware.Override
+		Allowed   []string
+		Words     int
+		Separator string
+		Wordlist  string
+		Field     string
+		Error     error
+	}{
+		{
+			Name:      "will keep the options of the Generator",
+			Generator: generator,
+			Words:     5,
+			Separator: " ",
+			Wordlist:  "eff-long",
+		}, {
+			Name:      "will apply the words and separator",
+			Generator: generator,
+			Override:  diceware.Override{Words: 9, Separator: "-"},
+			Words:     9,
+			Separator: "-",
+			Wordlist:  "eff-long",
+		}, {
+			Name:      "will count the separator in user perceived characters",
+			Generator: generator,
+			Override:  diceware.Override{Separator: strings.Repeat("🇳🇱", diceware.MaxOverrideSeparatorLength)},
+			Words:     5,
+			Separator: strings.Repeat("🇳🇱", diceware.MaxOverrideSeparatorLength),
+			Wordlist:  "eff-long",
+		}, {
+			Name:      "will select a registered wordlist",
+			Generator: custom,
+			Override:  diceware.Override{Wordlist: "eff-long"},
+			Allowed:   []string{"eff-long"},
+			Words:     2,
+			Separator: " ",
+			Wordlist:  "eff-long",
+		}, {
+			Name:      "will reject too many words",
+			Generator: generator,
+			Override:  diceware.Override{Words: diceware.MaxOverrideWords + 1},
+			Field:     "words",
+			Error:     diceware.ErrInvalidOverride,
+		}, {
+			Name:      "will reject a negative number of words",
+			Generator: generator,
+			Override:  diceware.Override{Words: -1},
+			Field:     "words",
+			Error:     diceware.ErrInvalidOverride,
+		}, {
+			Name:      "will reject an unregistered wordlist",
+			Generator: generator,
+			Override:  diceware.Override{Wordlist: "../../etc/passwd"},
+			Field:     "wordlist",
+			Error:     diceware.ErrInvalidOverride,
+		}, {
+			Name:      "will reject a wordlist that is not allowed",
+			Generator: custom,
+			Override:  diceware.Override{Wordlist: "eff-long"},
+			Allowed:   []string{"original"},
+			Field:     "wordlist",
+			Error:     diceware.ErrInvalidOverride,
+		}, {
+			Name:      "will reject a long separator",
+			Generator: generator,
+			Override:  diceware.Override{Separator: strings.Repeat("-", diceware.MaxOverrideSeparatorLength+1)},
+			Field:     "separator",
+			Error:     diceware.ErrInvalidOverride,
+		}, {
+			Name:      "will reject fewer words than the minimum entropy needs",
+			Generator: generator,
+			Override:  diceware.Override{Words: 4},
+			Field:     "words",
+			Error:     diceware.ErrInsufficientEntropy,
+		},
+	}
+
+	for _, test := range tests {
+		overridden, err := test.Generator.Override(test.Override, test.Allowed...)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			assert.Nil(overridden, test.Name)
+
+			var overrideErr *diceware.OverrideError
+			if assert.True(errors.As(err, &overrideErr), test.Name) {
+				assert.Equal(test.Field, overrideErr.Field, test.Name)
+			}
+
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		if test.Override == (diceware.Override{}) {
+			assert.Same(test.Generator, overridden, test.Name)
+		}
+
+		passphrase, err := overridden.GeneratePassphrase()
+		if assert.NoError(err, test.Name) {
+			assert.Len(passphrase.Words, test.Words, test.Name)
+			assert.Equal(test.Separator, passphrase.Separator, test.Name)
+			assert.Equal(test.Wordlist, passphrase.Wordlist, test.Name)
+		}
+	}
+}
+
+func TestOverrideError(t *testing.T) {
+	assert := assert.New(t)
+
+	err := &diceware.OverrideError{Field: "words", Reason: "must be between 1 and 64", Err: diceware.ErrInvalidOverride}
+	assert.EqualError(err, "invalid override given: words must be between 1 and 64")
+	assert.ErrorIs(err, diceware.ErrInvalidOverride)
+}
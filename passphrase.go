@@ -0,0 +1,407 @@
+package diceware
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// Passphrase defines the structured result of generating a diceware
+// passphrase, retaining the individual words alongside the information used to
+// produce them.
+type Passphrase struct {
+	// Words represents each of the words within the passphrase, including any
+	// enhancement characters that were inserted into them.
+	Words []string
+
+	// Separator represents the character(s) used to separate each of the
+	// passphrase words.
+	Separator string
+
+	// Separators represents the separator placed within each gap between the
+	// words, which is only set when random separators or a pattern were used
+	// and takes precedence over Separator.
+	Separators []string
+
+	// Prefix represents the text placed before the first word, which is only
+	// set when a pattern was used.
+	Prefix string
+
+	// Suffix represents the text placed after the last word, which is only set
+	// when a pattern was used.
+	Suffix string
+
+	// Rolls represents the dice roll value used to fetch each of the words.
+	Rolls []int
+
+	// Wordlist represents the name of the wordlist the words were fetched from,
+	// which is empty when the wordlist does not implement `NamedWordlist`.
+	Wordlist string
+
+	// EntropyBits represents the entropy in bits of the rolled words, reduced
+	// by any word constraints that limit which words can be rolled, any
+	// random capitalization or leetspeak applied to them, any random
+	// separators, any inserted digit, any digits or symbols of a pattern and
+	// any characters added for the required classes.
+	// The characters inserted by EnhanceEntropy are not included.
+	EntropyBits float64
+
+	// RareWords represents the rolled words, before any modification, whose
+	// frequency score is below `wordlist.RareFrequency`, which is only set when
+	// frequency scores are available for the wordlist.
+	RareWords []string
+
+	// Transcript represents the record of every roll made while generating the
+	// passphrase, which is only set when the Transcript option was enabled.
+	// The transcript is as sensitive as the passphrase itself.
+	Transcript *Transcript
+}
+
+// String returns a string.
+// Implements the logic to join each of the words with the separator, or with
+// the separator of each gap when random separators were used.
+func (p *Passphrase) String() string {
+	if len(p.Separators) == 0 && p.Prefix == "" && p.Suffix == "" {
+		return strings.Join(p.Words, p.Separator)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(p.Prefix)
+	for i, word := range p.Words {
+		if i > 0 {
+			builder.WriteString(p.separator(i - 1))
+		}
+
+		builder.WriteString(word)
+	}
+
+	builder.WriteString(p.Suffix)
+
+	return builder.String()
+}
+
+// AllWordsRare returns a bool.
+// Implements the logic to check whether every rolled word of the passphrase is
+// rare, warning that the passphrase may be hard to remember.  The warning is
+// meant to be shown alongside the passphrase, since discarding the passphrases
+// it applies to would reduce the entropy of those that remain.
+func (p *Passphrase) AllWordsRare() bool {
+	return len(p.Rolls) > 0 && len(p.RareWords) == len(p.Rolls)
+}
+
+// separator returns a string.
+// Implements the logic to give the separator placed within the gap following
+// the word at the given index.
+func (p *Passphrase) separator(gap int) string {
+	if len(p.Separators) == len(p.Words)-1 && gap < len(p.Separators) {
+		return p.Separators[gap]
+	}
+
+	return p.Separator
+}
+
+// GeneratePassphrase returns a Passphrase.
+// Implements the logic required to generate a structured passphrase from the
+// given options.
+func GeneratePassphrase(opts PassphraseOptions) (*Passphrase, error) {
+	return GeneratePassphraseContext(context.Background(), opts)
+}
+
+// GeneratePassphraseContext returns a Passphrase.
+// Implements the logic required to generate a structured passphrase from the
+// given options, aborting between rolls when the given context is cancelled or
+// its deadline expires.
+func GeneratePassphraseContext(ctx context.Context, opts PassphraseOptions) (*Passphrase, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rollPassphrase(ctx, opts)
+}
+
+// wordPlan defines the configuration of the words derived from validated
+// options, which is prepared a single time so that it can be shared by every
+// passphrase rolled from the same options.
+type wordPlan struct {
+	// constraints represents the restrictions on the words that can be rolled.
+	constraints *wordConstraints
+
+	// perWord represents the entropy in bits provided by each word.
+	perWord float64
+
+	// checksumRolls represents the roll values the checksum word is chosen
+	// from, which are only collected when Checksum is set.
+	checksumRolls []int
+}
+
+// planWords returns a wordPlan.
+// Implements the logic to prepare the word constraints of the options and the
+// entropy each word provides, which is reduced to the words accepted by the
+// constraints.
+func (opts PassphraseOptions) planWords() (wordPlan, error) {
+	plan := wordPlan{
+		constraints: opts.wordConstraints(),
+		perWord:     WordEntropy(opts.Wordlist),
+	}
+
+	if plan.constraints != nil {
+		accepted := plan.constraints.countAccepted(opts.Wordlist)
+		if accepted == 0 {
+			return wordPlan{}, ErrNoWordsAvailable
+		}
+
+		plan.perWord = math.Log2(float64(accepted))
+	}
+
+	if opts.Checksum {
+		plan.checksumRolls = checksumRolls(opts.Wordlist, plan.constraints)
+	}
+
+	return plan, nil
+}
+
+// rollPassphrase returns a Passphrase.
+// Implements the logic to generate a passphrase from already validated options.
+func rollPassphrase(ctx context.Context, opts PassphraseOptions) (*Passphrase, error) {
+	plan, err := opts.planWords()
+	if err != nil {
+		return nil, err
+	}
+
+	return rollPlannedPassphrase(ctx, opts, plan)
+}
+
+// rollPlannedPassphrase returns a Passphrase.
+// Implements the logic to generate a passphrase from already validated options
+// and the words planned from them, reporting it along with the time taken, and
+// the number of words rolled to a WordInstrumentation, to the Instrumentation
+// when one was configured.
+func rollPlannedPassphrase(ctx context.Context, opts PassphraseOptions, plan wordPlan) (*Passphrase, error) {
+	if opts.Instrumentation == nil {
+		return composePassphrase(ctx, opts, plan)
+	}
+
+	start := time.Now()
+
+	passphrase, err := composePassphrase(ctx, opts, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Instrumentation.PassphraseGenerated(time.Since(start))
+	if inst, ok := opts.Instrumentation.(WordInstrumentation); ok {
+		inst.WordsRolled(opts.wordCount(plan.perWord))
+	}
+
+	return passphrase, nil
+}
+
+// passphraseStep defines a modification applied to the rolled words of a
+// passphrase, giving the bits of entropy it adds.
+type passphraseStep func(
+	ctx context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error)
+
+// passphraseSteps represents every modification applied to the rolled words of
+// a passphrase, in the order they are applied, each of which does nothing
+// unless its option was configured.
+var passphraseSteps = []passphraseStep{
+	rareWordsStep,
+	capitalizeStep,
+	styleStep,
+	leetspeakStep,
+	digitsStep,
+	patternStep,
+	separatorsStep,
+	enhanceStep,
+	classesStep,
+}
+
+// composePassphrase returns a Passphrase.
+// Implements the logic to roll the words of the passphrase and apply every
+// configured modification to them.
+func composePassphrase(ctx context.Context, opts PassphraseOptions, plan wordPlan) (*Passphrase, error) {
+	wordCount := opts.wordCount(plan.perWord)
+	passphrase := &Passphrase{
+		Words:       make([]string, wordCount),
+		Separator:   opts.separator(),
+		Rolls:       make([]int, wordCount),
+		EntropyBits: float64(wordCount) * plan.perWord,
+	}
+
+	if named, ok := opts.Wordlist.(NamedWordlist); ok {
+		passphrase.Wordlist = named.Name()
+	}
+
+	if opts.Transcript {
+		passphrase.Transcript = &Transcript{}
+	}
+
+	rs := opts.randomSource()
+	for i := range passphrase.Words {
+		word, rollValue, err := rollAcceptedWord(ctx, rs, opts.Wordlist, plan.constraints)
+		if err != nil {
+			return nil, err
+		}
+
+		passphrase.Words[i] = word
+		passphrase.Rolls[i] = rollValue
+		passphrase.Transcript.recordWord(word, rollValue, opts.Wordlist)
+	}
+
+	if opts.Checksum {
+		word, rollValue := checksumWord(opts.Wordlist, plan.checksumRolls, passphrase.Words)
+		passphrase.Words = append(passphrase.Words, word)
+		passphrase.Rolls = append(passphrase.Rolls, rollValue)
+	}
+
+	for _, step := range passphraseSteps {
+		bits, err := step(ctx, rs, opts, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		passphrase.EntropyBits += bits
+	}
+
+	return passphrase, nil
+}
+
+// rareWordsStep returns a float64 and an error.
+// Implements the logic to note the rare words before they are modified.
+func rareWordsStep(
+	_ context.Context, _ RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	passphrase.RareWords = rareWords(passphrase.Words, opts.frequencies())
+
+	return 0, nil
+}
+
+// capitalizeStep returns a float64 and an error.
+// Implements the logic to capitalize the words as set by Capitalization.
+func capitalizeStep(
+	_ context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	return capitalizeWords(passphrase.Words, opts.Capitalization, rs)
+}
+
+// styleStep returns a float64 and an error.
+// Implements the logic to restyle the words as set by JoinStyle.
+func styleStep(
+	_ context.Context, _ RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	styleWords(passphrase.Words, opts.JoinStyle)
+
+	return 0, nil
+}
+
+// leetspeakStep returns a float64 and an error.
+// Implements the logic to substitute the letters of the words when Leetspeak
+// is set.
+func leetspeakStep(
+	_ context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if !opts.Leetspeak {
+		return 0, nil
+	}
+
+	return leetspeakWords(passphrase.Words, rs)
+}
+
+// digitsStep returns a float64 and an error.
+// Implements the logic to insert a digit into the words when IncludeDigits is
+// set.
+func digitsStep(
+	ctx context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if !opts.IncludeDigits {
+		return 0, nil
+	}
+
+	return insertDigit(ctx, rs, passphrase.Words, opts.reservedSeparators(), passphrase.Transcript)
+}
+
+// patternStep returns a float64 and an error.
+// Implements the logic to lay the words out as described by Pattern.
+func patternStep(
+	_ context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if opts.Pattern == "" {
+		return 0, nil
+	}
+
+	tokens, err := parsePattern(opts.Pattern)
+	if err != nil {
+		return 0, err
+	}
+
+	return applyPattern(rs, tokens, opts.patternSymbols(), passphrase)
+}
+
+// separatorsStep returns a float64 and an error.
+// Implements the logic to roll a separator for each gap between the words when
+// RandomSeparators is set.
+func separatorsStep(
+	_ context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if !opts.RandomSeparators {
+		return 0, nil
+	}
+
+	separators, bits, err := rollSeparators(rs, opts.separatorSet(), len(passphrase.Words)-1)
+	if err != nil {
+		return 0, err
+	}
+
+	passphrase.Separators = separators
+
+	return bits, nil
+}
+
+// enhanceStep returns a float64 and an error.
+// Implements the logic to insert characters into the words when
+// EnhanceEntropy is set, which adds no entropy to the count.
+func enhanceStep(
+	ctx context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if !opts.EnhanceEntropy {
+		return 0, nil
+	}
+
+	return 0, enhanceWords(ctx, rs, passphrase.Words, opts.reservedSeparators(), opts.enhancementWordlist(),
+		passphrase.Transcript)
+}
+
+// classesStep returns a float64 and an error.
+// Implements the logic to add the character classes the words are missing
+// when RequireClasses is set.
+func classesStep(
+	ctx context.Context, rs RandomSource, opts PassphraseOptions, passphrase *Passphrase,
+) (float64, error) {
+	if opts.RequireClasses == 0 {
+		return 0, nil
+	}
+
+	return requireClasses(ctx, rs, opts, passphrase)
+}
+
+// rareWords returns a slice of strings.
+// Implements the logic to collect the words whose frequency score is below
+// `wordlist.RareFrequency`, leaving out the words without a score.
+func rareWords(words []string, frequencies wordlist.Frequencies) []string {
+	if frequencies == nil {
+		return nil
+	}
+
+	rare := []string{}
+	for _, word := range words {
+		if score, found := frequencies.Frequency(word); found && score < wordlist.RareFrequency {
+			rare = append(rare, word)
+		}
+	}
+
+	return rare
+}
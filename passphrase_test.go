@@ -0,0 +1,177 @@
+package diceware_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassphraseString(t *testing.T) {
+	passphrase := diceware.Passphrase{
+		Words:     []string{"correct", "horse", "battery", "staple"},
+		Separator: "-",
+	}
+
+	assert.Equal(t, "correct-horse-battery-staple", passphrase.String())
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{WordCount: 6})
+	assert.ErrorIs(err, diceware.ErrInvalidWordlist)
+
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Len(passphrase.Words, 6)
+	assert.Len(passphrase.Rolls, 6)
+	assert.Equal(" ", passphrase.Separator)
+	assert.Equal("eff-long", passphrase.Wordlist)
+	assert.InDelta(6*diceware.WordEntropy(wordlist.EFFLong), passphrase.EntropyBits, 0.0001)
+	assert.Equal(strings.Join(passphrase.Words, " "), passphrase.String())
+
+	for i, word := range passphrase.Words {
+		assert.Equal(word, wordlist.EFFLong.FetchWord(passphrase.Rolls[i]))
+	}
+}
+
+func TestGeneratePassphraseMinEntropyBits(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		Options   diceware.PassphraseOptions
+		WordCount int
+	}{
+		{
+			Name: "will select the word count from the minimum entropy",
+			Options: diceware.PassphraseOptions{
+				Wordlist:       wordlist.EFFLong,
+				MinEntropyBits: 90,
+			},
+			WordCount: 7,
+		}, {
+			Name: "will keep a larger word count",
+			Options: diceware.PassphraseOptions{
+				WordCount:      10,
+				Wordlist:       wordlist.EFFLong,
+				MinEntropyBits: 90,
+			},
+			WordCount: 10,
+		}, {
+			Name: "will raise a smaller word count",
+			Options: diceware.PassphraseOptions{
+				WordCount:      2,
+				Wordlist:       wordlist.EFFLong,
+				MinEntropyBits: 90,
+			},
+			WordCount: 7,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(test.Options)
+		if assert.NoError(err, test.Name) {
+			assert.Len(passphrase.Words, test.WordCount, test.Name)
+			assert.GreaterOrEqual(passphrase.EntropyBits, test.Options.MinEntropyBits, test.Name)
+		}
+	}
+}
+
+func TestGeneratorGeneratePassphrase(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.New(diceware.WithWordlist(wordlist.NewMap(1, 3, map[int]string{
+		1: "test",
+		2: "testing",
+		3: "tests",
+	})))
+	if !assert.NoError(err) {
+		return
+	}
+
+	passphrase, err := generator.GeneratePassphrase()
+	if assert.NoError(err) {
+		assert.Len(passphrase.Words, diceware.DefaultWordCount)
+		assert.Empty(passphrase.Wordlist)
+	}
+}
+
+func TestGeneratePassphraseRareWords(t *testing.T) {
+	assert := assert.New(t)
+
+	wl := wordlist.NewMap(1, 2, map[int]string{1: "common", 2: "obscure"})
+	frequencies := wordlist.FrequencyTable{"common": 5.5, "obscure": 1.2}
+
+	tests := []struct {
+		Name        string
+		Frequencies wordlist.Frequencies
+		Values      []int64
+		RareWords   []string
+		AllRare     bool
+	}{
+		{
+			Name:        "will warn when every word is rare",
+			Frequencies: frequencies,
+			Values:      []int64{1, 1, 1},
+			RareWords:   []string{"obscure", "obscure", "obscure"},
+			AllRare:     true,
+		}, {
+			Name:        "will list the rare words without warning",
+			Frequencies: frequencies,
+			Values:      []int64{1, 0, 1},
+			RareWords:   []string{"obscure", "obscure"},
+		}, {
+			Name:        "will list no rare words of common words",
+			Frequencies: frequencies,
+			Values:      []int64{0, 0, 0},
+			RareWords:   []string{},
+		}, {
+			Name:   "will leave the rare words unset without frequencies",
+			Values: []int64{1, 1, 1},
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:    3,
+			Separator:    " ",
+			Wordlist:     wl,
+			Frequencies:  test.Frequencies,
+			RandomSource: &sequenceRandomSource{values: test.Values},
+		})
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.RareWords, passphrase.RareWords, test.Name)
+			assert.Equal(test.AllRare, passphrase.AllWordsRare(), test.Name)
+		}
+	}
+}
+
+func TestWithFrequencies(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.New(
+		diceware.WithWordCount(3),
+		diceware.WithWordlist(wordlist.NewMap(1, 2, map[int]string{1: "common", 2: "obscure"})),
+		diceware.WithFrequencies(wordlist.FrequencyTable{"common": 5.5, "obscure": 1.2}),
+		diceware.WithRandomSource(&sequenceRandomSource{values: []int64{1, 0, 1}}),
+	)
+	if !assert.NoError(err) {
+		return
+	}
+
+	passphrase, err := generator.GeneratePassphrase()
+	if assert.NoError(err) {
+		assert.Equal([]string{"obscure", "obscure"}, passphrase.RareWords)
+	}
+}
@@ -0,0 +1,169 @@
+package diceware
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// ErrInvalidPattern represents the error given when a pattern does not contain
+// a word, or ends with an unfinished escape
+var ErrInvalidPattern = errors.New("invalid pattern given")
+
+const (
+	// PatternWord represents the pattern character replaced by a rolled word.
+	PatternWord = 'W'
+	// PatternDigit represents the pattern character replaced by a random digit.
+	PatternDigit = 'D'
+	// PatternSymbol represents the pattern character replaced by a random
+	// symbol.
+	PatternSymbol = 'S'
+	// PatternEscape represents the pattern character that causes the following
+	// character to be used literally.
+	PatternEscape = '\\'
+)
+
+// patternToken defines a single element of a parsed pattern.
+type patternToken struct {
+	// kind represents the pattern character of the token, which is 0 for
+	// literal text.
+	kind rune
+
+	// literal represents the text of a literal token.
+	literal string
+}
+
+// parsePattern returns a slice of patternTokens.
+// Implements the logic to split the pattern into words, digits, symbols and
+// the literal text between them.
+func parsePattern(pattern string) ([]patternToken, error) {
+	tokens := []patternToken{}
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, patternToken{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	words := 0
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			literal.WriteRune(r)
+			escaped = false
+		case r == PatternEscape:
+			escaped = true
+		case r == PatternWord || r == PatternDigit || r == PatternSymbol:
+			flush()
+			tokens = append(tokens, patternToken{kind: r})
+			if r == PatternWord {
+				words++
+			}
+		default:
+			literal.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	if escaped {
+		return nil, fmt.Errorf("%w: unfinished escape", ErrInvalidPattern)
+	}
+
+	if words == 0 {
+		return nil, fmt.Errorf("%w: must contain a word", ErrInvalidPattern)
+	}
+
+	return tokens, nil
+}
+
+// patternWordCount returns an int.
+// Implements the logic to count the words within the pattern.
+func patternWordCount(tokens []patternToken) int {
+	words := 0
+	for _, token := range tokens {
+		if token.kind == PatternWord {
+			words++
+		}
+	}
+
+	return words
+}
+
+// patternLiterals returns a string.
+// Implements the logic to combine all of the literal text of the pattern.
+func patternLiterals(tokens []patternToken) string {
+	var literals strings.Builder
+	for _, token := range tokens {
+		literals.WriteString(token.literal)
+	}
+
+	return literals.String()
+}
+
+// applyPattern returns a float64.
+// Implements the logic to lay the rolled words of the passphrase out according
+// to the pattern, rolling each digit and symbol and placing the text found
+// before, between and after the words into the Prefix, Separators and Suffix.
+// The entropy in bits added by the digits and symbols is returned.
+func applyPattern(rs RandomSource, tokens []patternToken, symbols []string, passphrase *Passphrase) (float64, error) {
+	digits := make([]string, 0, wordlist.Digits.Len())
+	for i := 0; i < wordlist.Digits.Len(); i++ {
+		digits = append(digits, wordlist.Digits.WordAt(i))
+	}
+
+	bits := 0.0
+	gaps := []string{}
+	var gap strings.Builder
+	for _, token := range tokens {
+		choices := []string{token.literal}
+		switch token.kind {
+		case PatternWord:
+			gaps = append(gaps, gap.String())
+			gap.Reset()
+
+			continue
+		case PatternDigit:
+			choices = digits
+		case PatternSymbol:
+			choices = symbols
+		}
+
+		index, err := randomInt(rs, len(choices))
+		if err != nil {
+			return 0, err
+		}
+
+		gap.WriteString(choices[index])
+		bits += math.Log2(float64(len(choices)))
+	}
+
+	passphrase.Prefix = gaps[0]
+	passphrase.Separators = gaps[1:]
+	passphrase.Suffix = gap.String()
+
+	return bits, nil
+}
+
+// symbolsExcluding returns a slice of strings.
+// Implements the logic to collect the symbols within `wordlist.ExtraEntropy`,
+// leaving out the digits and any characters within exclude.
+func symbolsExcluding(exclude string) []string {
+	symbols := []string{}
+	for i := 0; i < wordlist.ExtraEntropy.Len(); i++ {
+		symbol := wordlist.ExtraEntropy.WordAt(i)
+		r, _ := utf8.DecodeRuneInString(symbol)
+		if !unicode.IsDigit(r) && !strings.Contains(exclude, symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	return symbols
+}
@@ -0,0 +1,107 @@
+package diceware_test
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 2, map[int]string{
+		1: "correct",
+		2: "horse",
+	})
+
+	tests := []struct {
+		Name    string
+		Pattern string
+		Charset []string
+		Random  bool
+		Error   error
+		Matches string
+		Words   int
+		Symbols int
+		Digits  int
+	}{
+		{
+			Name:    "will error without a word",
+			Pattern: "DD-S",
+			Error:   diceware.ErrInvalidPattern,
+		}, {
+			Name:    "will error with an unfinished escape",
+			Pattern: "W-W\\",
+			Error:   diceware.ErrInvalidPattern,
+		}, {
+			Name:    "will error when combined with random separators",
+			Pattern: "W-W",
+			Random:  true,
+			Error:   diceware.ErrInvalidPattern,
+		}, {
+			Name:    "will replace words, digits and symbols",
+			Pattern: "W-W-DD-W!",
+			Matches: `^(correct|horse)-(correct|horse)-[0-9]{2}-(correct|horse)!$`,
+			Words:   3,
+			Digits:  2,
+		}, {
+			Name:    "will keep escaped characters literally",
+			Pattern: "\\W:W\\DS",
+			Charset: []string{"#", "%"},
+			Matches: `^W:(correct|horse)D[#%]$`,
+			Words:   1,
+			Symbols: 2,
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			Wordlist:           words,
+			Pattern:            test.Pattern,
+			RandomSeparators:   test.Random,
+			EnhancementCharset: test.Charset,
+		})
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		expectedBits := float64(test.Words) + float64(test.Digits)*math.Log2(10)
+		if test.Symbols > 0 {
+			expectedBits += math.Log2(float64(test.Symbols))
+		}
+
+		assert.Len(passphrase.Words, test.Words, test.Name)
+		assert.Regexp(regexp.MustCompile(test.Matches), passphrase.String(), test.Name)
+		assert.InDelta(expectedBits, passphrase.EntropyBits, 0.0001, test.Name)
+	}
+}
+
+func TestPatternSecureString(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.New(diceware.WithPattern("<W.W>"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	passphrase, err := generator.GeneratePassphrase()
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal("<"+passphrase.Words[0]+"."+passphrase.Words[1]+">", passphrase.String())
+
+	secure, err := generator.GenerateBytes()
+	if assert.NoError(err) {
+		assert.Regexp(`^<[a-z-]+\.[a-z-]+>$`, string(secure.Bytes()))
+	}
+}
@@ -0,0 +1,72 @@
+package diceware
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// natoAlphabet represents the NATO phonetic alphabet word of each letter.
+var natoAlphabet = map[rune]string{
+	'a': "alfa", 'b': "bravo", 'c': "charlie", 'd': "delta", 'e': "echo",
+	'f': "foxtrot", 'g': "golf", 'h': "hotel", 'i': "india", 'j': "juliett",
+	'k': "kilo", 'l': "lima", 'm': "mike", 'n': "november", 'o': "oscar",
+	'p': "papa", 'q': "quebec", 'r': "romeo", 's': "sierra", 't': "tango",
+	'u': "uniform", 'v': "victor", 'w': "whiskey", 'x': "x-ray", 'y': "yankee",
+	'z': "zulu",
+}
+
+// phoneticCharacters represents the spoken name of each digit and symbol.
+var phoneticCharacters = map[rune]string{
+	'0': "zero", '1': "one", '2': "two", '3': "three", '4': "four",
+	'5': "five", '6': "six", '7': "seven", '8': "eight", '9': "nine",
+	' ': "space", '~': "tilde", '!': "exclamation-mark", '@': "at-sign",
+	'#': "hash", '$': "dollar-sign", '%': "percent-sign", '^': "caret",
+	'&': "ampersand", '*': "asterisk", '(': "open-parenthesis",
+	')': "close-parenthesis", '-': "hyphen", '_': "underscore", '=': "equals-sign",
+	'+': "plus-sign", '{': "open-brace", '}': "close-brace",
+	'[': "open-bracket", ']': "close-bracket", '|': "vertical-bar",
+	'.': "period", ':': "colon", ';': "semicolon", '/': "slash",
+	'?': "question-mark", '>': "greater-than", '<': "less-than", ',': "comma",
+	'\'': "apostrophe", '"': "quotation-mark", '\\': "backslash", '`': "backtick",
+}
+
+// PhoneticSpelling returns a string.
+// Implements the logic required to spell out every character of the
+// passphrase for reading it aloud, such as over the phone.  Letters are
+// rendered with the NATO phonetic alphabet, in upper case for upper case
+// letters, digits and symbols by their names, and any other character by its
+// Unicode code point, each separated by a space.
+func PhoneticSpelling(passphrase string) string {
+	spelled := make([]string, 0, len(passphrase))
+	for _, r := range passphrase {
+		spelled = append(spelled, phoneticCharacter(r))
+	}
+
+	return strings.Join(spelled, " ")
+}
+
+// Phonetic returns a string.
+// Implements the logic to spell out every character of the passphrase with
+// `PhoneticSpelling`.
+func (p *Passphrase) Phonetic() string {
+	return PhoneticSpelling(p.String())
+}
+
+// phoneticCharacter returns a string.
+// Implements the logic to give the spoken form of a single character.
+func phoneticCharacter(r rune) string {
+	if word, found := natoAlphabet[unicode.ToLower(r)]; found {
+		if unicode.IsUpper(r) {
+			return strings.ToUpper(word)
+		}
+
+		return word
+	}
+
+	if name, found := phoneticCharacters[r]; found {
+		return name
+	}
+
+	return fmt.Sprintf("%U", r)
+}
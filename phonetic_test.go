@@ -0,0 +1,53 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoneticSpelling(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Value      string
+	}{
+		{
+			Name:       "will spell an empty passphrase",
+			Passphrase: "",
+			Value:      "",
+		}, {
+			Name:       "will spell lower case letters",
+			Passphrase: "acid",
+			Value:      "alfa charlie india delta",
+		}, {
+			Name:       "will spell upper case letters",
+			Passphrase: "Zoom",
+			Value:      "ZULU oscar oscar mike",
+		}, {
+			Name:       "will spell digits and symbols",
+			Passphrase: "x-7 !",
+			Value:      "x-ray hyphen seven space exclamation-mark",
+		}, {
+			Name:       "will spell unknown characters by code point",
+			Passphrase: "é",
+			Value:      "U+00E9",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, diceware.PhoneticSpelling(test.Passphrase), test.Name)
+	}
+}
+
+func TestPassphrasePhonetic(t *testing.T) {
+	passphrase := diceware.Passphrase{
+		Words:     []string{"yo", "Ok"},
+		Separator: "_",
+	}
+
+	assert.Equal(t, "yankee oscar underscore OSCAR kilo", passphrase.Phonetic())
+}
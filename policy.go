@@ -0,0 +1,228 @@
+package diceware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// MaxPolicyAttempts represents the number of passphrases `RollWordsWithPolicy`
+// generates in an attempt to satisfy a policy before giving up.
+const MaxPolicyAttempts = 100
+
+var (
+	// ErrPolicyViolation represents the error given when a passphrase does not
+	// satisfy a policy
+	ErrPolicyViolation = errors.New("passphrase violates policy")
+	// ErrPolicyUnsatisfiable represents the error given when a policy is unable
+	// to be satisfied by the generated passphrases
+	ErrPolicyUnsatisfiable = errors.New("policy is unable to be satisfied")
+)
+
+// Policy defines the composition rules a passphrase must follow, bridging
+// diceware passphrases with legacy password rules.
+type Policy struct {
+	// MinLength represents the minimum number of characters within the
+	// passphrase.
+	MinLength int
+
+	// MaxLength represents the maximum number of characters within the
+	// passphrase.  Zero disables the constraint.  A maximum shorter than the
+	// shortest passphrase the options are able to produce is unsatisfiable.
+	MaxLength int
+
+	// RequireUpper represents whether at least 1 upper case letter is required.
+	RequireUpper bool
+
+	// RequireLower represents whether at least 1 lower case letter is required.
+	RequireLower bool
+
+	// RequireDigit represents whether at least 1 digit is required.
+	RequireDigit bool
+
+	// RequireSymbol represents whether at least 1 character that is neither a
+	// letter, digit or whitespace is required.
+	RequireSymbol bool
+
+	// ForbiddenCharacters represents each of the characters that must not
+	// appear within the passphrase.
+	ForbiddenCharacters string
+}
+
+// Validate returns an error.
+// Implements the logic to verify that the policy is able to be satisfied by
+// any passphrase.
+func (p Policy) Validate() error {
+	if p.MinLength < 0 || p.MaxLength < 0 {
+		return fmt.Errorf("%w: lengths must not be negative", ErrPolicyUnsatisfiable)
+	}
+
+	if p.MaxLength > 0 && p.MinLength > p.MaxLength {
+		return fmt.Errorf("%w: minimum length exceeds maximum length", ErrPolicyUnsatisfiable)
+	}
+
+	return nil
+}
+
+// Check returns an error.
+// Implements the logic to verify that the passphrase satisfies the policy,
+// returning an error wrapping `ErrPolicyViolation` describing the first rule
+// that was broken.
+func (p Policy) Check(passphrase string) error {
+	length := characterCount(passphrase)
+	if length < p.MinLength {
+		return fmt.Errorf("%w: shorter than %d characters", ErrPolicyViolation, p.MinLength)
+	}
+
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrPolicyViolation, p.MaxLength)
+	}
+
+	if strings.ContainsAny(passphrase, p.ForbiddenCharacters) {
+		return fmt.Errorf("%w: contains a forbidden character", ErrPolicyViolation)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range passphrase {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	for _, class := range []struct {
+		name     string
+		required bool
+		found    bool
+	}{
+		{"upper case letter", p.RequireUpper, upper},
+		{"lower case letter", p.RequireLower, lower},
+		{"digit", p.RequireDigit, digit},
+		{"symbol", p.RequireSymbol, symbol},
+	} {
+		if class.required && !class.found {
+			return fmt.Errorf("%w: missing a %s", ErrPolicyViolation, class.name)
+		}
+	}
+
+	return nil
+}
+
+// RollWordsWithPolicy returns a string.
+// Implements the logic required to generate a passphrase from the given
+// options that satisfies the policy.  The options are adjusted to produce the
+// required character classes, a word is added whenever a passphrase is too
+// short, and passphrases are regenerated until the policy is satisfied or
+// `MaxPolicyAttempts` is reached.  An error wrapping `ErrPolicyUnsatisfiable`
+// is returned straight away when MaxLength is shorter than the shortest
+// passphrase of the options, as no number of attempts would ever meet it.
+func RollWordsWithPolicy(opts PassphraseOptions, policy Policy) (string, error) {
+	if err := policy.Validate(); err != nil {
+		return "", err
+	}
+
+	opts = policy.adjust(opts)
+	if opts.EnhancementCharset != nil && len(opts.EnhancementCharset) == 0 {
+		return "", fmt.Errorf("%w: every symbol is forbidden", ErrPolicyUnsatisfiable)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	perWord := WordEntropy(opts.Wordlist)
+	if opts.wordCount(perWord) > 1 && !opts.RandomSeparators && opts.Pattern == "" &&
+		strings.ContainsAny(opts.separator(), policy.ForbiddenCharacters) {
+		return "", fmt.Errorf("%w: separator contains a forbidden character", ErrPolicyUnsatisfiable)
+	}
+
+	if policy.MaxLength > 0 && shortestLength(opts, opts.wordCount(perWord)) > policy.MaxLength {
+		return "", fmt.Errorf("%w: maximum length is shorter than the shortest passphrase", ErrPolicyUnsatisfiable)
+	}
+
+	for attempt := 0; attempt < MaxPolicyAttempts; attempt++ {
+		passphrase, err := rollPassphrase(context.Background(), opts)
+		if err != nil {
+			return "", err
+		}
+
+		phrase := passphrase.String()
+		if characterCount(phrase) < policy.MinLength {
+			// the words of the passphrase include the checksum word, so the
+			// count is grown from the options instead
+			opts.WordCount = opts.wordCount(perWord) + 1
+			continue
+		}
+
+		if policy.Check(phrase) == nil {
+			return phrase, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w after %d attempts", ErrPolicyUnsatisfiable, MaxPolicyAttempts)
+}
+
+// shortestLength returns an int.
+// Implements the logic to calculate the fewest characters a passphrase of the
+// given number of words is able to have, counting the checksum word and the
+// fixed separator but none of the characters added to the words.
+func shortestLength(opts PassphraseOptions, words int) int {
+	shortest := 0
+	for word := range wordlist.Index(opts.Wordlist).All() {
+		if length := characterCount(word); shortest == 0 || length < shortest {
+			shortest = length
+		}
+	}
+
+	shortest = max(shortest, opts.MinWordLength)
+	if opts.Checksum {
+		words++
+	}
+
+	length := words * shortest
+	if !opts.RandomSeparators && opts.Pattern == "" && words > 1 {
+		length += (words - 1) * characterCount(opts.separator())
+	}
+
+	return length
+}
+
+// adjust returns a PassphraseOptions.
+// Implements the logic to enable the options producing each of the character
+// classes required by the policy, leaving any options already enabled as they
+// are.
+func (p Policy) adjust(opts PassphraseOptions) PassphraseOptions {
+	if p.RequireUpper && opts.Capitalization == CapitalizationNone {
+		opts.Capitalization = CapitalizationRandomLetter
+	}
+
+	if p.RequireDigit {
+		opts.IncludeDigits = true
+	}
+
+	if p.RequireSymbol && !opts.EnhanceEntropy {
+		opts.EnhanceEntropy = true
+		if opts.EnhancementCharset == nil {
+			opts.EnhancementCharset = p.symbols(opts.reservedSeparators())
+		}
+	}
+
+	return opts
+}
+
+// symbols returns a slice of strings.
+// Implements the logic to collect the symbols within `wordlist.ExtraEntropy`
+// that are neither forbidden nor part of the separators.
+func (p Policy) symbols(separators string) []string {
+	return symbolsExcluding(p.ForbiddenCharacters + separators)
+}
@@ -0,0 +1,160 @@
+package diceware_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Policy     diceware.Policy
+		Passphrase string
+		Error      error
+	}{
+		{
+			Name:       "will satisfy an empty policy",
+			Passphrase: "correct horse",
+		}, {
+			Name:       "will error when shorter than the minimum length",
+			Policy:     diceware.Policy{MinLength: 20},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name:       "will error when longer than the maximum length",
+			Policy:     diceware.Policy{MaxLength: 10},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name:       "will error when containing a forbidden character",
+			Policy:     diceware.Policy{ForbiddenCharacters: "$| "},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name:       "will error when missing an upper case letter",
+			Policy:     diceware.Policy{RequireUpper: true},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name:       "will error when missing a digit",
+			Policy:     diceware.Policy{RequireDigit: true},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name:       "will error when missing a symbol",
+			Policy:     diceware.Policy{RequireSymbol: true},
+			Passphrase: "correct horse",
+			Error:      diceware.ErrPolicyViolation,
+		}, {
+			Name: "will satisfy every rule",
+			Policy: diceware.Policy{
+				MinLength:           10,
+				MaxLength:           20,
+				RequireUpper:        true,
+				RequireLower:        true,
+				RequireDigit:        true,
+				RequireSymbol:       true,
+				ForbiddenCharacters: "$|",
+			},
+			Passphrase: "Correct-h0rse",
+		},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(test.Policy.Check(test.Passphrase), test.Error, test.Name)
+	}
+}
+
+func TestRollWordsWithPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	opts := diceware.PassphraseOptions{
+		WordCount: 3,
+		Separator: "-",
+		Wordlist:  wordlist.EFFLong,
+	}
+
+	tests := []struct {
+		Name   string
+		Policy diceware.Policy
+		Error  error
+	}{
+		{
+			Name:   "will error when the minimum length exceeds the maximum length",
+			Policy: diceware.Policy{MinLength: 20, MaxLength: 10},
+			Error:  diceware.ErrPolicyUnsatisfiable,
+		}, {
+			Name:   "will error when the separator is forbidden",
+			Policy: diceware.Policy{ForbiddenCharacters: "-"},
+			Error:  diceware.ErrPolicyUnsatisfiable,
+		}, {
+			Name: "will error when every symbol is forbidden",
+			Policy: diceware.Policy{
+				RequireSymbol:       true,
+				ForbiddenCharacters: "~!@#$%^&*()_=+{}[]|.:;/?><",
+			},
+			Error: diceware.ErrPolicyUnsatisfiable,
+		}, {
+			Name:   "will error when the policy can never be satisfied",
+			Policy: diceware.Policy{MaxLength: 2},
+			Error:  diceware.ErrPolicyUnsatisfiable,
+		}, {
+			Name:   "will error when the maximum length is shorter than the shortest passphrase",
+			Policy: diceware.Policy{MaxLength: 10},
+			Error:  diceware.ErrPolicyUnsatisfiable,
+		}, {
+			Name:   "will add words to meet the minimum length",
+			Policy: diceware.Policy{MinLength: 60},
+		}, {
+			Name: "will adjust the options to meet the required classes",
+			Policy: diceware.Policy{
+				RequireUpper:        true,
+				RequireLower:        true,
+				RequireDigit:        true,
+				RequireSymbol:       true,
+				ForbiddenCharacters: "$|&",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.RollWordsWithPolicy(opts, test.Policy)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.NoError(test.Policy.Check(passphrase), test.Name)
+		}
+	}
+}
+
+func TestRollWordsWithPolicyChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 4, map[int]string{
+		1: "acid",
+		2: "bold",
+		3: "cats",
+		4: "dogs",
+	})
+
+	// 3 words followed by the checksum word are the fewest reaching 19
+	// characters, the checksum word must not be counted as one of the words
+	passphrase, err := diceware.RollWordsWithPolicy(diceware.PassphraseOptions{
+		WordCount: 2,
+		Separator: " ",
+		Wordlist:  words,
+		Checksum:  true,
+	}, diceware.Policy{MinLength: 19})
+	if assert.NoError(err) {
+		assert.Len(strings.Fields(passphrase), 4, passphrase)
+	}
+}
@@ -0,0 +1,179 @@
+package diceware
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+	mathrand "math/rand/v2"
+	"sync"
+)
+
+// ErrInvalidRandomRange represents the error given when a random number is
+// requested with a max that a RandomSource is unable to provide
+var ErrInvalidRandomRange = errors.New("invalid random range given")
+
+// RandomSource defines the methods required to provide the random numbers
+// utilized within the diceware implementation.
+type RandomSource interface {
+	// GetRandom describes the logic to fetch a uniformly distributed random
+	// number within the range of [0, max), where max is only borrowed for the
+	// duration of the call and must be neither modified nor retained
+	GetRandom(max *big.Int) (*big.Int, error)
+}
+
+// RandomSource64 defines the optional method a RandomSource can implement to
+// provide random numbers without allocating a *big.Int for every roll, which
+// is preferred whenever it is available.
+type RandomSource64 interface {
+	RandomSource
+
+	// GetRandom64 describes the logic to fetch a uniformly distributed random
+	// number within the range of [0, max)
+	GetRandom64(max int64) (int64, error)
+}
+
+// CryptoRandomSource defines the default RandomSource implementation, which
+// is backed by the cryptographically secure `crypto/rand` reader.
+type CryptoRandomSource struct{}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the RandomSource interface which pulls a
+// uniformly distributed random number within the range of [0, max) from
+// `crypto/rand`.
+func (CryptoRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	return rand.Int(rand.Reader, max)
+}
+
+// GetRandom64 returns an int64.
+// It implements the logic for the RandomSource64 interface which pulls a
+// uniformly distributed random number within the range of [0, max) from
+// `crypto/rand`.
+func (CryptoRandomSource) GetRandom64(max int64) (int64, error) {
+	return uniformInt64(rand.Reader, max)
+}
+
+// uniformInt64 returns an int64.
+// Implements the same rejection sampling as uniformInt without allocating a
+// *big.Int, consuming exactly the same bytes from the reader so that both
+// produce identical numbers.
+func uniformInt64(r io.Reader, max int64) (int64, error) {
+	if max <= 0 {
+		return 0, ErrInvalidRandomRange
+	}
+
+	bitLen := bits.Len64(uint64(max - 1))
+	if bitLen == 0 {
+		return 0, nil
+	}
+
+	var buffer [8]byte
+	size := (bitLen + 7) / 8
+	topBits := uint(bitLen % 8)
+	if topBits == 0 {
+		topBits = 8
+	}
+
+	for {
+		if _, err := io.ReadFull(r, buffer[:size]); err != nil {
+			return 0, err
+		}
+
+		buffer[0] &= uint8(int(1<<topBits) - 1)
+
+		value := int64(0)
+		for _, b := range buffer[:size] {
+			value = value<<8 | int64(b)
+		}
+
+		if value < max {
+			return value, nil
+		}
+	}
+}
+
+// InsecureRandomSource defines a RandomSource backed by `math/rand/v2`, which
+// trades cryptographic strength for speed.  It must never be used to generate
+// passphrases protecting anything, and is intended for simulations and load
+// tests only.
+//
+// An InsecureRandomSource is safe for concurrent use.
+type InsecureRandomSource struct {
+	// mu guards the wrapped generator, which is not safe for concurrent use.
+	mu sync.Mutex
+
+	// rand represents the wrapped generator.
+	rand *mathrand.Rand
+}
+
+// NewInsecureRandomSource returns an initialized InsecureRandomSource object
+// wrapping the given `math/rand/v2` generator.
+func NewInsecureRandomSource(r *mathrand.Rand) *InsecureRandomSource {
+	return &InsecureRandomSource{rand: r}
+}
+
+// NewInsecureChaCha8RandomSource returns an initialized InsecureRandomSource
+// object wrapping a `math/rand/v2` ChaCha8 generator with the given seed.
+func NewInsecureChaCha8RandomSource(seed [32]byte) *InsecureRandomSource {
+	return NewInsecureRandomSource(mathrand.New(mathrand.NewChaCha8(seed)))
+}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the RandomSource interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// wrapped generator, where max must fit within an int64.
+func (rs *InsecureRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	if max.Sign() <= 0 || !max.IsInt64() {
+		return nil, ErrInvalidRandomRange
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return big.NewInt(rs.rand.Int64N(max.Int64())), nil
+}
+
+// GetRandom64 returns an int64.
+// It implements the logic for the RandomSource64 interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// wrapped generator.
+func (rs *InsecureRandomSource) GetRandom64(max int64) (int64, error) {
+	if max <= 0 {
+		return 0, ErrInvalidRandomRange
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return rs.rand.Int64N(max), nil
+}
+
+// bigIntPool represents the scratch *big.Int values handed to
+// `RandomSource.GetRandom` as the max, which are reused between rolls so that
+// concurrent generators do not allocate one for every roll.
+var bigIntPool = sync.Pool{
+	New: func() any {
+		return new(big.Int)
+	},
+}
+
+// randomInt returns an int.
+// Implements the logic to fetch a uniformly distributed random number within
+// the range of [0, max) from the given RandomSource.
+func randomInt(rs RandomSource, max int) (int, error) {
+	if rs64, ok := rs.(RandomSource64); ok {
+		value, err := rs64.GetRandom64(int64(max))
+		return int(value), err
+	}
+
+	limit, _ := bigIntPool.Get().(*big.Int)
+	defer bigIntPool.Put(limit)
+
+	value, err := rs.GetRandom(limit.SetInt64(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(value.Int64()), nil
+}
@@ -0,0 +1,122 @@
+package diceware_test
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// sequenceRandomSource is a RandomSource returning each of the values in
+// order, wrapping each value into the requested range.
+type sequenceRandomSource struct {
+	values []int64
+	index  int
+}
+
+func (rs *sequenceRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	value := rs.values[rs.index%len(rs.values)]
+	rs.index++
+
+	return big.NewInt(value % max.Int64()), nil
+}
+
+func TestCryptoRandomSourceGetRandom(t *testing.T) {
+	assert := assert.New(t)
+
+	max := big.NewInt(6)
+	for i := 0; i < 100; i++ {
+		value, err := diceware.CryptoRandomSource{}.GetRandom(max)
+		if assert.NoError(err) {
+			assert.True(value.Sign() >= 0 && value.Cmp(max) < 0, "value should be within [0, 6)")
+		}
+	}
+}
+
+func TestInsecureRandomSourceGetRandom(t *testing.T) {
+	assert := assert.New(t)
+
+	rs := diceware.NewInsecureRandomSource(rand.New(rand.NewPCG(1, 2)))
+
+	max := big.NewInt(6)
+	for i := 0; i < 100; i++ {
+		value, err := rs.GetRandom(max)
+		if assert.NoError(err) {
+			assert.True(value.Sign() >= 0 && value.Cmp(max) < 0, "value should be within [0, 6)")
+		}
+	}
+
+	_, err := rs.GetRandom(big.NewInt(0))
+	assert.ErrorIs(err, diceware.ErrInvalidRandomRange)
+
+	_, err = rs.GetRandom(new(big.Int).Lsh(big.NewInt(1), 64))
+	assert.ErrorIs(err, diceware.ErrInvalidRandomRange)
+}
+
+func TestNewInsecureChaCha8RandomSource(t *testing.T) {
+	assert := assert.New(t)
+
+	generate := func() string {
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:    6,
+			Separator:    " ",
+			Wordlist:     wordlist.EFFLong,
+			RandomSource: diceware.NewInsecureChaCha8RandomSource([32]byte{1}),
+		})
+		if !assert.NoError(err) {
+			return ""
+		}
+
+		return passphrase.String()
+	}
+
+	assert.Equal(generate(), generate())
+}
+
+func TestCryptoRandomSourceGetRandom64(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, max := range []int64{1, 6, 10, 256, 257, 7776, 1 << 62} {
+		for i := 0; i < 100; i++ {
+			value, err := diceware.CryptoRandomSource{}.GetRandom64(max)
+			if assert.NoError(err) {
+				assert.True(value >= 0 && value < max, "value should be within [0, %d)", max)
+			}
+		}
+	}
+
+	_, err := diceware.CryptoRandomSource{}.GetRandom64(0)
+	assert.ErrorIs(err, diceware.ErrInvalidRandomRange)
+}
+
+func TestRollWordAllocations(t *testing.T) {
+	// rolling with a RandomSource64 should not allocate a *big.Int per die,
+	// leaving only the allocations made while reading from crypto/rand
+	withBigInt := testing.AllocsPerRun(100, func() {
+		_, _ = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:    6,
+			Wordlist:     wordlist.EFFLong,
+			RandomSource: bigIntOnlyRandomSource{},
+		})
+	})
+
+	with64 := testing.AllocsPerRun(100, func() {
+		_, _ = diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount: 6,
+			Wordlist:  wordlist.EFFLong,
+		})
+	})
+
+	assert.Less(t, with64, withBigInt)
+}
+
+// bigIntOnlyRandomSource is a RandomSource hiding the RandomSource64 method of
+// the CryptoRandomSource.
+type bigIntOnlyRandomSource struct{}
+
+func (bigIntOnlyRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	return diceware.CryptoRandomSource{}.GetRandom(max)
+}
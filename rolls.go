@@ -0,0 +1,66 @@
+package diceware
+
+import "github.com/everlastingbeta/diceware/wordlist"
+
+// forEachRoll implements the logic to call fn with every roll value that can
+// be rolled with the dice of the given wordlist, in ascending order, stopping
+// early when fn returns false.
+func forEachRoll(wl Wordlist, fn func(rollValue int) bool) {
+	rolls := wl.Rolls()
+	sides := int(wl.SidesOfDice().Int64())
+	if rolls < 1 || sides < 1 {
+		return
+	}
+
+	dice := make([]int, rolls)
+	for i := range dice {
+		dice[i] = 1
+	}
+
+	for {
+		if !fn(rollValueOf(dice, sides)) {
+			return
+		}
+
+		// advance the dice like an odometer, starting from the last die
+		i := len(dice) - 1
+		for ; i >= 0 && dice[i] == sides; i-- {
+			dice[i] = 1
+		}
+
+		if i < 0 {
+			return
+		}
+
+		dice[i]++
+	}
+}
+
+// rollValueOf returns an int.
+// Implements the logic to combine the face values of each die into the roll
+// value used to fetch a word, with the first die being the most significant
+// digits as described by `wordlist.RollPlace`.
+func rollValueOf(dice []int, sides int) int {
+	place := wordlist.RollPlace(sides)
+	rollValue := 0
+	for _, face := range dice {
+		rollValue = rollValue*place + face
+	}
+
+	return rollValue
+}
+
+// diceOf returns a slice of ints.
+// Implements the logic to split a roll value back into the face value of each
+// of the given number of dice with the given sides, the reverse of
+// rollValueOf.
+func diceOf(rollValue, rolls, sides int) []int {
+	place := wordlist.RollPlace(sides)
+	dice := make([]int, rolls)
+	for i := rolls - 1; i >= 0; i-- {
+		dice[i] = rollValue % place
+		rollValue /= place
+	}
+
+	return dice
+}
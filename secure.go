@@ -0,0 +1,101 @@
+package diceware
+
+import "context"
+
+// SecureString defines a mutable buffer holding a passphrase, which unlike an
+// immutable Go string is able to be scrubbed from memory once it is no longer
+// needed.  The individual words are still fetched from the wordlist as
+// strings while generating, so only the assembled passphrase is guaranteed to
+// live solely within the buffer.
+type SecureString struct {
+	// buffer represents the bytes of the passphrase.
+	buffer []byte
+}
+
+// GenerateBytes returns a SecureString.
+// Implements the logic required to generate a passphrase from the given
+// options directly into a SecureString, without creating the joined
+// passphrase as a Go string.
+func GenerateBytes(opts PassphraseOptions) (*SecureString, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	passphrase, err := rollPassphrase(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return passphrase.secureString(), nil
+}
+
+// GenerateBytes returns a SecureString.
+// Implements the logic to generate a single passphrase from the stored
+// configuration directly into a SecureString.
+func (g *Generator) GenerateBytes() (*SecureString, error) {
+	passphrase, err := g.roll(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return passphrase.secureString(), nil
+}
+
+// secureString returns a SecureString.
+// Implements the logic to join the words and separators of the passphrase
+// into a SecureString.
+func (p *Passphrase) secureString() *SecureString {
+	size := len(p.Prefix) + len(p.Suffix)
+	for i, word := range p.Words {
+		size += len(word)
+		if i > 0 {
+			size += len(p.separator(i - 1))
+		}
+	}
+
+	buffer := make([]byte, 0, size)
+	buffer = append(buffer, p.Prefix...)
+	for i, word := range p.Words {
+		if i > 0 {
+			buffer = append(buffer, p.separator(i-1)...)
+		}
+
+		buffer = append(buffer, word...)
+	}
+
+	buffer = append(buffer, p.Suffix...)
+
+	return &SecureString{buffer: buffer}
+}
+
+// Bytes returns a slice of bytes.
+// Implements the logic to expose the passphrase.  The returned slice shares
+// memory with the SecureString and is zeroed by Wipe, so it must not be
+// retained after wiping.
+func (s *SecureString) Bytes() []byte {
+	return s.buffer
+}
+
+// Len returns an int.
+// Implements the logic to give the length of the passphrase in bytes.
+func (s *SecureString) Len() int {
+	return len(s.buffer)
+}
+
+// String returns a string.
+// Implements the fmt.Stringer interface with a placeholder, so that the
+// passphrase is never copied into an immutable string when it is printed or
+// logged by accident.
+func (s *SecureString) String() string {
+	return redacted
+}
+
+// Wipe implements the logic to overwrite every byte of the passphrase with
+// zeros, after which the SecureString is empty.
+func (s *SecureString) Wipe() {
+	for i := range s.buffer {
+		s.buffer[i] = 0
+	}
+
+	s.buffer = s.buffer[:0]
+}
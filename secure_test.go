@@ -0,0 +1,52 @@
+package diceware_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := diceware.GenerateBytes(diceware.PassphraseOptions{WordCount: 6})
+	assert.ErrorIs(err, diceware.ErrInvalidWordlist)
+
+	secure, err := diceware.GenerateBytes(diceware.PassphraseOptions{
+		WordCount:        6,
+		Wordlist:         wordlist.EFFLong,
+		RandomSeparators: true,
+		SeparatorSet:     []string{" "},
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Len(strings.Split(string(secure.Bytes()), " "), 6)
+	assert.Equal(len(secure.Bytes()), secure.Len())
+	assert.Equal("[REDACTED]", fmt.Sprint(secure))
+
+	buffer := secure.Bytes()
+	secure.Wipe()
+
+	assert.Equal(0, secure.Len())
+	assert.Equal(make([]byte, len(buffer)), buffer)
+}
+
+func TestGeneratorGenerateBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	generator, err := diceware.New(diceware.WithSeparator(":"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	secure, err := generator.GenerateBytes()
+	if assert.NoError(err) {
+		assert.Len(strings.Split(string(secure.Bytes()), ":"), diceware.DefaultWordCount)
+	}
+}
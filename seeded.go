@@ -0,0 +1,161 @@
+package diceware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+)
+
+// ErrInvalidSeed represents the error given when a SeededRandomSource is
+// created with an empty seed
+var ErrInvalidSeed = errors.New("invalid empty seed given")
+
+// seededRandomSourceSalt represents the salt used to derive the key of a
+// SeededRandomSource from its seed.  Changing it changes every passphrase
+// derived from a seed.
+var seededRandomSourceSalt = []byte("github.com/everlastingbeta/diceware seeded random source")
+
+// SeededRandomSource defines a deterministic RandomSource, where the same seed
+// always reproduces the same sequence of random numbers and in turn the same
+// passphrases.  The stream is produced by HMAC-SHA256 in counter mode, keyed
+// with an HKDF-style extraction of the seed, so it is only as unpredictable as
+// the seed itself: the seed must be kept secret and should hold at least 256
+// bits of entropy when the passphrases protect anything.
+//
+// A SeededRandomSource is safe for concurrent use, though concurrent callers
+// will observe the shared sequence in a nondeterministic order.
+type SeededRandomSource struct {
+	// mu guards every field below while reading from the stream.
+	mu sync.Mutex
+
+	// key represents the HMAC key extracted from the seed.
+	key []byte
+
+	// counter represents the index of the next block within the stream.
+	counter uint64
+
+	// buffer represents the unread remainder of the current block.
+	buffer []byte
+}
+
+// NewSeededRandomSource returns an initialized SeededRandomSource object
+// derived from the given seed.
+func NewSeededRandomSource(seed []byte) (*SeededRandomSource, error) {
+	if len(seed) == 0 {
+		return nil, ErrInvalidSeed
+	}
+
+	extract := hmac.New(sha256.New, seededRandomSourceSalt)
+	extract.Write(seed)
+
+	return &SeededRandomSource{key: extract.Sum(nil)}, nil
+}
+
+// Read returns an int.
+// It implements the io.Reader interface, filling p with the next bytes of the
+// deterministic stream.  It never returns an error.
+func (rs *SeededRandomSource) Read(p []byte) (int, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return rs.read(p), nil
+}
+
+// read returns an int.
+// Implements the logic to fill p with the next bytes of the stream, expecting
+// the caller to hold the lock.
+func (rs *SeededRandomSource) read(p []byte) int {
+	n := 0
+	for n < len(p) {
+		if len(rs.buffer) == 0 {
+			var counter [8]byte
+			binary.BigEndian.PutUint64(counter[:], rs.counter)
+			rs.counter++
+
+			block := hmac.New(sha256.New, rs.key)
+			block.Write(counter[:])
+			rs.buffer = block.Sum(nil)
+		}
+
+		copied := copy(p[n:], rs.buffer)
+		rs.buffer = rs.buffer[copied:]
+		n += copied
+	}
+
+	return n
+}
+
+// GetRandom returns a *big.Int.
+// It implements the logic for the RandomSource interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// deterministic stream.
+func (rs *SeededRandomSource) GetRandom(max *big.Int) (*big.Int, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return uniformInt(readerFunc(rs.read), max)
+}
+
+// GetRandom64 returns an int64.
+// It implements the logic for the RandomSource64 interface which pulls a
+// uniformly distributed random number within the range of [0, max) from the
+// deterministic stream, matching the numbers GetRandom would have returned.
+func (rs *SeededRandomSource) GetRandom64(max int64) (int64, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return uniformInt64(readerFunc(rs.read), max)
+}
+
+// readerFunc defines an adapter allowing a function that never fails to be
+// used as an io.Reader.
+type readerFunc func([]byte) int
+
+// Read returns an int.
+// It implements the io.Reader interface by calling the function.
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p), nil
+}
+
+// uniformInt returns a *big.Int.
+// Implements the logic to pull a uniformly distributed random number within
+// the range of [0, max) from the reader using rejection sampling.  The
+// algorithm is pinned here rather than relying on `crypto/rand.Int`, so that
+// seeded sequences do not change between Go releases.
+func uniformInt(r io.Reader, max *big.Int) (*big.Int, error) {
+	if max.Sign() <= 0 {
+		return nil, ErrInvalidRandomRange
+	}
+
+	limit := new(big.Int).Sub(max, big.NewInt(1))
+	bitLen := limit.BitLen()
+	if bitLen == 0 {
+		return new(big.Int), nil
+	}
+
+	buffer := make([]byte, (bitLen+7)/8)
+	topBits := uint(bitLen % 8)
+	if topBits == 0 {
+		topBits = 8
+	}
+
+	value := new(big.Int)
+	for {
+		if _, err := io.ReadFull(r, buffer); err != nil {
+			return nil, err
+		}
+
+		// clear the bits above the bit length of the limit, so that at least half
+		// of the candidates fall within the range
+		buffer[0] &= uint8(int(1<<topBits) - 1)
+
+		value.SetBytes(buffer)
+		if value.Cmp(max) < 0 {
+			return value, nil
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package diceware_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSeededRandomSource(t *testing.T) {
+	rs, err := diceware.NewSeededRandomSource(nil)
+	assert.ErrorIs(t, err, diceware.ErrInvalidSeed)
+	assert.Nil(t, rs)
+}
+
+func TestSeededRandomSourceGetRandom(t *testing.T) {
+	assert := assert.New(t)
+
+	rs, err := diceware.NewSeededRandomSource([]byte("seed"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	for _, max := range []int64{1, 6, 10, 256, 257, 7776} {
+		for i := 0; i < 100; i++ {
+			value, err := rs.GetRandom(big.NewInt(max))
+			if assert.NoError(err) {
+				assert.True(value.Sign() >= 0 && value.Int64() < max, "value should be within [0, %d)", max)
+			}
+		}
+	}
+
+	_, err = rs.GetRandom(big.NewInt(0))
+	assert.ErrorIs(err, diceware.ErrInvalidRandomRange)
+}
+
+func TestSeededRandomSourceReproducible(t *testing.T) {
+	assert := assert.New(t)
+
+	generate := func(seed string) string {
+		rs, err := diceware.NewSeededRandomSource([]byte(seed))
+		if !assert.NoError(err) {
+			return ""
+		}
+
+		passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+			WordCount:      6,
+			Separator:      " ",
+			Wordlist:       wordlist.EFFLong,
+			EnhanceEntropy: true,
+			RandomSource:   rs,
+		})
+		if !assert.NoError(err) {
+			return ""
+		}
+
+		return passphrase.String()
+	}
+
+	first := generate("correct horse battery staple")
+	assert.Equal(first, generate("correct horse battery staple"))
+	assert.NotEqual(first, generate("correct horse battery stapler"))
+}
+
+func TestSeededRandomSourceKnownAnswer(t *testing.T) {
+	assert := assert.New(t)
+
+	// the sequence for a seed must never change, otherwise every passphrase
+	// previously derived from a seed would no longer be reproducible.
+	rs, err := diceware.NewSeededRandomSource([]byte("correct horse battery staple"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	values := []int64{}
+	for i := 0; i < 6; i++ {
+		value, err := rs.GetRandom(big.NewInt(7776))
+		if !assert.NoError(err) {
+			return
+		}
+
+		values = append(values, value.Int64())
+	}
+
+	assert.Equal([]int64{1896, 6156, 6016, 3104, 1413, 3419}, values)
+}
+
+func TestSeededRandomSourceGetRandom64(t *testing.T) {
+	assert := assert.New(t)
+
+	bigSource, err := diceware.NewSeededRandomSource([]byte("seed"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	source64, err := diceware.NewSeededRandomSource([]byte("seed"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	// both methods must consume the stream identically
+	for _, max := range []int64{1, 6, 10, 256, 257, 7776, 1 << 40} {
+		for i := 0; i < 20; i++ {
+			expected, err := bigSource.GetRandom(big.NewInt(max))
+			if !assert.NoError(err) {
+				return
+			}
+
+			value, err := source64.GetRandom64(max)
+			if assert.NoError(err) {
+				assert.Equal(expected.Int64(), value)
+			}
+		}
+	}
+
+	_, err = source64.GetRandom64(0)
+	assert.ErrorIs(err, diceware.ErrInvalidRandomRange)
+}
@@ -0,0 +1,182 @@
+package diceware
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+var (
+	// ErrInvalidSeparatorSet represents the error given when the separator set
+	// used for random separators contains an empty or repeated separator
+	ErrInvalidSeparatorSet = errors.New("invalid separator set given, separators must be distinct and not empty")
+	// ErrSeparatorCollision represents the error given when a separator is
+	// found within words of the wordlist, which makes splitting the passphrase
+	// back into its words ambiguous
+	ErrSeparatorCollision = errors.New("separator found within words of the wordlist")
+)
+
+// SeparatorCollisionError defines the error given when a separator is found
+// within words of the wordlist, retaining the separator and the words.
+type SeparatorCollisionError struct {
+	// Separator represents the separator found within the words.
+	Separator string
+
+	// Words represents each of the words containing the separator, sorted.
+	Words []string
+}
+
+// Error returns a string.
+// It implements the logic for the error interface which describes the
+// separator and the number of words containing it.
+func (e *SeparatorCollisionError) Error() string {
+	return fmt.Sprintf("%s: %q is found within %d words, such as %q",
+		ErrSeparatorCollision, e.Separator, len(e.Words), e.Words[0])
+}
+
+// Unwrap returns an error.
+// Implements the logic to allow `errors.Is` to match ErrSeparatorCollision.
+func (e *SeparatorCollisionError) Unwrap() error {
+	return ErrSeparatorCollision
+}
+
+// CheckSeparator returns an error.
+// Implements the logic required to verify that the separator is not found
+// within any of the words of the wordlist, returning a
+// *SeparatorCollisionError listing the words when it is.  An empty separator
+// never collides.
+func CheckSeparator(wl Wordlist, separator string) error {
+	if wl == nil {
+		return ErrInvalidWordlist
+	}
+
+	return checkSeparators(wordlist.Index(wl), []string{separator})
+}
+
+// checkSeparators returns an error.
+// Implements the logic to verify that none of the separators are found within
+// any of the words, reporting the first separator that is.
+func checkSeparators(index *wordlist.WordIndex, separators []string) error {
+	for _, separator := range separators {
+		if separator == "" {
+			continue
+		}
+
+		collisions := []string{}
+		for word := range index.All() {
+			if strings.Contains(word, separator) {
+				collisions = append(collisions, word)
+			}
+		}
+
+		if len(collisions) > 0 {
+			sort.Strings(collisions)
+			return &SeparatorCollisionError{Separator: separator, Words: collisions}
+		}
+	}
+
+	return nil
+}
+
+// validateStrictSeparator returns an error.
+// Implements the logic to verify, when StrictSeparator is enabled, that the
+// separator or each separator of the random separators is not found within
+// any of the words of the wordlist.
+func (opts PassphraseOptions) validateStrictSeparator() error {
+	if !opts.StrictSeparator {
+		return nil
+	}
+
+	separators := []string{opts.separator()}
+	if opts.RandomSeparators {
+		separators = opts.separatorSet()
+	}
+
+	return checkSeparators(wordlist.Index(opts.Wordlist), separators)
+}
+
+// defaultSeparatorSet represents the separators chosen from when
+// RandomSeparators is enabled without a SeparatorSet.
+var defaultSeparatorSet = []string{
+	"0", "1", "2", "3", "4", "5", "6", "7", "8", "9",
+	"!", "-", ".", "_", "+", "=",
+}
+
+// DefaultSeparatorSet returns a slice of strings.
+// Implements the logic to give a copy of the separators chosen from when
+// RandomSeparators is enabled without a SeparatorSet, providing 4 bits of
+// entropy per gap.
+func DefaultSeparatorSet() []string {
+	return slices.Clone(defaultSeparatorSet)
+}
+
+// separatorSet returns a slice of strings.
+// Implements the logic to select the separators chosen from when
+// RandomSeparators is enabled.
+func (opts PassphraseOptions) separatorSet() []string {
+	if len(opts.SeparatorSet) == 0 {
+		return defaultSeparatorSet
+	}
+
+	return opts.SeparatorSet
+}
+
+// reservedSeparators returns a string.
+// Implements the logic to combine every separator, or the literal text of the
+// pattern, that may appear between words, which characters inserted into the
+// words must not collide with.
+func (opts PassphraseOptions) reservedSeparators() string {
+	if opts.Pattern != "" {
+		tokens, _ := parsePattern(opts.Pattern)
+		return patternLiterals(tokens)
+	}
+
+	if !opts.RandomSeparators {
+		return opts.separator()
+	}
+
+	return strings.Join(opts.separatorSet(), "")
+}
+
+// validateSeparatorSet returns an error.
+// Implements the logic to verify that none of the separators are empty or
+// repeated, since a repeated separator would be counted as entropy that the
+// choices do not provide.
+func validateSeparatorSet(set []string) error {
+	seen := make(map[string]bool, len(set))
+	for _, separator := range set {
+		if separator == "" {
+			return ErrInvalidSeparatorSet
+		}
+
+		if seen[separator] {
+			return fmt.Errorf("%w: %q is repeated", ErrInvalidSeparatorSet, separator)
+		}
+
+		seen[separator] = true
+	}
+
+	return nil
+}
+
+// rollSeparators returns a slice of strings.
+// Implements the logic to choose a random separator from the set for each of
+// the gaps, returning the entropy in bits added by the choices.
+func rollSeparators(rs RandomSource, set []string, gaps int) ([]string, float64, error) {
+	separators := make([]string, gaps)
+	for i := range separators {
+		index, err := randomInt(rs, len(set))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		separators[i] = set[index]
+	}
+
+	return separators, float64(gaps) * math.Log2(float64(len(set))), nil
+}
@@ -0,0 +1,192 @@
+package diceware_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassphraseStringSeparators(t *testing.T) {
+	passphrase := diceware.Passphrase{
+		Words:      []string{"correct", "horse", "battery"},
+		Separator:  " ",
+		Separators: []string{"4", "!"},
+	}
+
+	assert.Equal(t, "correct4horse!battery", passphrase.String())
+}
+
+func TestRandomSeparators(t *testing.T) {
+	assert := assert.New(t)
+
+	words := wordlist.NewMap(1, 1, map[int]string{1: "word"})
+
+	tests := []struct {
+		Name  string
+		Set   []string
+		Error error
+	}{
+		{
+			Name:  "will error with an empty separator",
+			Set:   []string{"-", ""},
+			Error: diceware.ErrInvalidSeparatorSet,
+		}, {
+			Name:  "will error with a repeated separator",
+			Set:   []string{"-", "+", "-"},
+			Error: diceware.ErrInvalidSeparatorSet,
+		}, {
+			Name: "will use the default separator set",
+		}, {
+			Name: "will use the given separator set",
+			Set:  []string{"-", "::", "+"},
+		},
+	}
+
+	for _, test := range tests {
+		generator, err := diceware.New(
+			diceware.WithWordCount(5),
+			diceware.WithWordlist(words),
+			diceware.WithRandomSeparators(test.Set...),
+		)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		passphrase, err := generator.GeneratePassphrase()
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		set := test.Set
+		if len(set) == 0 {
+			set = diceware.DefaultSeparatorSet()
+		}
+
+		assert.Len(passphrase.Separators, 4, test.Name)
+		for _, separator := range passphrase.Separators {
+			assert.Contains(set, separator, test.Name)
+		}
+
+		assert.InDelta(4*math.Log2(float64(len(set))), passphrase.EntropyBits, 0.0001, test.Name)
+		assert.Equal(5, strings.Count(passphrase.String(), "word"), test.Name)
+	}
+}
+
+func TestCheckSeparator(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		Wordlist  diceware.Wordlist
+		Separator string
+		Words     []string
+		Error     error
+	}{
+		{
+			Name:      "will error with a nil wordlist",
+			Separator: "-",
+			Error:     diceware.ErrInvalidWordlist,
+		}, {
+			Name:      "will accept a separator missing from the words",
+			Wordlist:  wordlist.EFFLong,
+			Separator: " ",
+		}, {
+			Name:      "will accept an empty separator",
+			Wordlist:  wordlist.EFFLong,
+			Separator: "",
+		}, {
+			Name:      "will report the words containing the separator",
+			Wordlist:  wordlist.EFFLong,
+			Separator: "-",
+			Words:     []string{"drop-down", "felt-tip", "t-shirt", "yo-yo"},
+			Error:     diceware.ErrSeparatorCollision,
+		}, {
+			Name:      "will report the words containing a multi character separator",
+			Wordlist:  wordlist.EFFLong,
+			Separator: "oo",
+			Error:     diceware.ErrSeparatorCollision,
+		},
+	}
+
+	for _, test := range tests {
+		err := diceware.CheckSeparator(test.Wordlist, test.Separator)
+		assert.ErrorIs(err, test.Error, test.Name)
+
+		var collision *diceware.SeparatorCollisionError
+		if errors.As(err, &collision) {
+			assert.Equal(test.Separator, collision.Separator, test.Name)
+			if test.Words != nil {
+				assert.Equal(test.Words, collision.Words, test.Name)
+			}
+
+			for _, word := range collision.Words {
+				assert.Contains(word, test.Separator, test.Name)
+			}
+		}
+	}
+}
+
+func TestStrictSeparator(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Error   error
+	}{
+		{
+			Name:    "will allow a colliding separator when not strict",
+			Options: diceware.PassphraseOptions{Separator: "-"},
+		}, {
+			Name:    "will error with a colliding separator when strict",
+			Options: diceware.PassphraseOptions{Separator: "-", StrictSeparator: true},
+			Error:   diceware.ErrSeparatorCollision,
+		}, {
+			Name:    "will allow a separator missing from the words when strict",
+			Options: diceware.PassphraseOptions{Separator: " ", StrictSeparator: true},
+		}, {
+			Name: "will error with a colliding random separator when strict",
+			Options: diceware.PassphraseOptions{
+				RandomSeparators: true,
+				SeparatorSet:     []string{"+", "-"},
+				StrictSeparator:  true,
+			},
+			Error: diceware.ErrSeparatorCollision,
+		}, {
+			Name: "will error with a colliding join style when strict",
+			Options: diceware.PassphraseOptions{
+				JoinStyle:       diceware.JoinKebabCase,
+				StrictSeparator: true,
+			},
+			Error: diceware.ErrSeparatorCollision,
+		},
+	}
+
+	for _, test := range tests {
+		test.Options.WordCount = 4
+		test.Options.Wordlist = wordlist.EFFLong
+
+		_, err := diceware.GeneratePassphrase(test.Options)
+		assert.ErrorIs(err, test.Error, test.Name)
+	}
+}
+
+func TestDefaultSeparatorSet(t *testing.T) {
+	assert := assert.New(t)
+
+	set := diceware.DefaultSeparatorSet()
+	assert.Len(set, 16)
+
+	set[0] = "changed"
+	assert.Equal("0", diceware.DefaultSeparatorSet()[0], "expected a copy of the default separator set")
+}
@@ -0,0 +1,34 @@
+package diceware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// Sheet returns a string.
+// Implements the logic to print each word of the passphrase on its own line
+// alongside its dice roll value, e.g. "31246 clip", in the layout of the
+// original diceware sheets.  The words are fetched from the given wordlist, as
+// it was rolled with, so they match a printed copy of the wordlist even when
+// capitalization or insertions modified the words of the passphrase.
+func (p *Passphrase) Sheet(wl Wordlist) (string, error) {
+	if wl == nil {
+		return "", ErrInvalidWordlist
+	}
+
+	sides := int(wl.SidesOfDice().Int64())
+
+	var sheet strings.Builder
+	for _, rollValue := range p.Rolls {
+		word, err := fetchWord(wl, rollValue)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&sheet, "%s %s\n", wordlist.FormatRollValue(rollValue, wl.Rolls(), sides), word)
+	}
+
+	return sheet.String(), nil
+}
@@ -0,0 +1,48 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassphraseSheet(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase *diceware.Passphrase
+		Wordlist   diceware.Wordlist
+		Value      string
+		Error      error
+	}{
+		{
+			Name:       "will error with a nil wordlist",
+			Passphrase: &diceware.Passphrase{Rolls: []int{11111}},
+			Error:      diceware.ErrInvalidWordlist,
+		}, {
+			Name:       "will print each word with its roll value",
+			Passphrase: &diceware.Passphrase{Words: []string{"Abacus", "z!oom"}, Rolls: []int{11111, 66666}},
+			Wordlist:   wordlist.EFFLong,
+			Value:      "11111 abacus\n66666 zoom\n",
+		}, {
+			Name:       "will pad the roll values of dice with more than 9 sides",
+			Passphrase: &diceware.Passphrase{Rolls: []int{1, 10}},
+			Wordlist:   wordlist.Digits,
+			Value:      "01 0\n10 9\n",
+		}, {
+			Name:       "will error when a word is unable to be fetched",
+			Passphrase: &diceware.Passphrase{Rolls: []int{77777}},
+			Wordlist:   wordlist.EFFLong,
+			Error:      diceware.ErrInvalidWordFetched,
+		},
+	}
+
+	for _, test := range tests {
+		sheet, err := test.Passphrase.Sheet(test.Wordlist)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, sheet, test.Name)
+	}
+}
@@ -0,0 +1,225 @@
+package diceware
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// GuessRate defines the speed at which an attacker is assumed to guess
+// passphrases.
+type GuessRate struct {
+	// Name represents a human readable description of the attack scenario.
+	Name string
+
+	// GuessesPerSecond represents the number of passphrases guessed each
+	// second.
+	GuessesPerSecond float64
+}
+
+var (
+	// GuessRateOnlineThrottled represents an online attack against a service
+	// that rate limits login attempts.
+	GuessRateOnlineThrottled = GuessRate{Name: "online, throttled", GuessesPerSecond: 100.0 / 3600}
+	// GuessRateOnlineUnthrottled represents an online attack against a service
+	// without any rate limiting.
+	GuessRateOnlineUnthrottled = GuessRate{Name: "online, unthrottled", GuessesPerSecond: 10}
+	// GuessRateOfflineSlowHash represents an offline attack against a
+	// passphrase stored with a slow hash, such as bcrypt or argon2.
+	GuessRateOfflineSlowHash = GuessRate{Name: "offline, slow hash", GuessesPerSecond: 1e4}
+	// GuessRateOfflineGPU represents an offline attack using GPUs against a
+	// passphrase stored with a fast hash.
+	GuessRateOfflineGPU = GuessRate{Name: "offline, fast hash on GPUs", GuessesPerSecond: 1e10}
+)
+
+// DefaultGuessRates represents the guess rates `StrengthReport` estimates the
+// crack times for when no guess rates are given.
+var DefaultGuessRates = []GuessRate{
+	GuessRateOnlineThrottled,
+	GuessRateOnlineUnthrottled,
+	GuessRateOfflineSlowHash,
+	GuessRateOfflineGPU,
+}
+
+// CrackTime defines the estimated time required to guess a passphrase at a
+// single guess rate.
+type CrackTime struct {
+	// Rate represents the guess rate of the attacker.
+	Rate GuessRate
+
+	// Seconds represents the average number of seconds needed to guess the
+	// passphrase, which is the time to search half of the possibilities.
+	Seconds float64
+}
+
+// String returns a string.
+// It implements the logic for the fmt.Stringer interface, describing the
+// crack time in human readable units.
+func (c CrackTime) String() string {
+	return humanizeSeconds(c.Seconds)
+}
+
+// Strength defines the estimated strength of passphrases generated from a
+// configuration.
+type Strength struct {
+	// EntropyBits represents the entropy in bits every generated passphrase is
+	// guaranteed to provide.
+	EntropyBits float64
+
+	// CrackTimes represents the estimated time to guess a passphrase at each of
+	// the guess rates.
+	CrackTimes []CrackTime
+}
+
+// StrengthReport returns a Strength.
+// Implements the logic required to estimate the strength of passphrases
+// generated from the given options at each of the guess rates, or at the
+// `DefaultGuessRates` when none are given.  The entropy only includes the
+// random choices made for every passphrase, leaving out those that depend on
+// the rolled words such as Leetspeak and `CapitalizationRandomLetter`, so the
+// report is a lower bound.
+func StrengthReport(opts PassphraseOptions, rates ...GuessRate) (*Strength, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	bits, err := opts.guaranteedEntropy()
+	if err != nil {
+		return nil, err
+	}
+
+	strength := EstimateStrength(bits, rates...)
+
+	return &strength, nil
+}
+
+// EstimateStrength returns a Strength.
+// Implements the logic required to estimate the time to guess a passphrase
+// with the given entropy in bits at each of the guess rates, or at the
+// `DefaultGuessRates` when none are given.
+func EstimateStrength(bits float64, rates ...GuessRate) Strength {
+	if len(rates) == 0 {
+		rates = DefaultGuessRates
+	}
+
+	strength := Strength{
+		EntropyBits: bits,
+		CrackTimes:  make([]CrackTime, 0, len(rates)),
+	}
+
+	for _, rate := range rates {
+		strength.CrackTimes = append(strength.CrackTimes, CrackTime{
+			Rate:    rate,
+			Seconds: math.Exp2(bits-1) / rate.GuessesPerSecond,
+		})
+	}
+
+	return strength
+}
+
+// guaranteedEntropy returns a float64.
+// Implements the logic to calculate the entropy in bits provided by the random
+// choices made for every passphrase generated from the validated options.
+func (opts PassphraseOptions) guaranteedEntropy() (float64, error) {
+	plan, err := opts.planWords()
+	if err != nil {
+		return 0, err
+	}
+
+	wordCount := opts.wordCount(plan.perWord)
+	bits := float64(wordCount) * plan.perWord
+
+	if opts.Capitalization == CapitalizationRandomWord && casedWords(opts.Wordlist) {
+		bits += math.Log2(float64(wordCount))
+	}
+
+	if opts.IncludeDigits {
+		bits += digitEntropy(opts.reservedSeparators())
+	}
+
+	if opts.Pattern != "" {
+		tokens, err := parsePattern(opts.Pattern)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, token := range tokens {
+			switch token.kind {
+			case PatternDigit:
+				bits += WordEntropy(wordlist.Digits)
+			case PatternSymbol:
+				bits += math.Log2(float64(len(opts.patternSymbols())))
+			}
+		}
+	}
+
+	if opts.RandomSeparators && wordCount > 1 {
+		bits += float64(wordCount-1) * math.Log2(float64(len(opts.separatorSet())))
+	}
+
+	return bits, nil
+}
+
+// humanizeSeconds returns a string.
+// Implements the logic to describe a number of seconds in the largest unit
+// that keeps the amount at least 1.
+func humanizeSeconds(seconds float64) string {
+	const year = 365.25 * 24 * 60 * 60
+
+	if seconds < 1 {
+		return "less than a second"
+	}
+
+	units := []struct {
+		singular string
+		plural   string
+		seconds  float64
+	}{
+		{"1 trillion years", "%.0f trillion years", 1e12 * year},
+		{"1 billion years", "%.0f billion years", 1e9 * year},
+		{"1 million years", "%.0f million years", 1e6 * year},
+		{"1 thousand years", "%.0f thousand years", 1e3 * year},
+		{"1 year", "%.0f years", year},
+		{"1 day", "%.0f days", 24 * 60 * 60},
+		{"1 hour", "%.0f hours", 60 * 60},
+		{"1 minute", "%.0f minutes", 60},
+		{"1 second", "%.0f seconds", 1},
+	}
+
+	if seconds >= 1e3*units[0].seconds {
+		return "more than a thousand trillion years"
+	}
+
+	for _, unit := range units {
+		if seconds < unit.seconds {
+			continue
+		}
+
+		amount := math.Floor(seconds / unit.seconds)
+		if amount == 1 {
+			return unit.singular
+		}
+
+		return fmt.Sprintf(unit.plural, amount)
+	}
+
+	return "less than a second"
+}
+
+// casedWords returns a bool.
+// Implements the logic to check whether every word of the wordlist has a lower
+// case letter, so that capitalizing any of its words changes the word.
+func casedWords(wl Wordlist) bool {
+	cased := true
+	forEachRoll(wl, func(rollValue int) bool {
+		word := wl.FetchWord(rollValue)
+		cased = word == "" || strings.IndexFunc(word, unicode.IsLower) >= 0
+
+		return cased
+	})
+
+	return cased
+}
@@ -0,0 +1,130 @@
+package diceware_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrengthReport(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+		Bits    float64
+		Error   error
+	}{
+		{
+			Name:    "will error with invalid options",
+			Options: diceware.PassphraseOptions{WordCount: 6},
+			Error:   diceware.ErrInvalidWordlist,
+		}, {
+			Name:    "will report the entropy of the words",
+			Options: diceware.PassphraseOptions{WordCount: 6, Wordlist: wordlist.EFFLong},
+			Bits:    77.5489,
+		}, {
+			Name: "will report the entropy of the random choices made for every passphrase",
+			Options: diceware.PassphraseOptions{
+				WordCount:        4,
+				Wordlist:         wordlist.EFFLong,
+				Capitalization:   diceware.CapitalizationRandomWord,
+				IncludeDigits:    true,
+				RandomSeparators: true,
+				SeparatorSet:     []string{"!", "-", ".", "_", "+", "=", "1", "2"},
+			},
+			Bits: 4*12.9248 + 2 + math.Log2(8) + 3*3,
+		}, {
+			Name: "will not report the entropy of capitalizing words without letters",
+			Options: diceware.PassphraseOptions{
+				WordCount:      4,
+				Wordlist:       wordlist.NewMap(1, 3, map[int]string{1: "🐂", 2: "🍋", 3: "🦊"}),
+				Capitalization: diceware.CapitalizationRandomWord,
+			},
+			Bits: 4 * math.Log2(3),
+		}, {
+			Name: "will report the entropy of the digits and symbols of a pattern",
+			Options: diceware.PassphraseOptions{
+				Wordlist:           wordlist.EFFLong,
+				Pattern:            "W-W-DS",
+				EnhancementCharset: []string{"!", "?"},
+			},
+			Bits: 2*12.9248 + math.Log2(10) + 1,
+		}, {
+			Name: "will report the entropy of the accepted words",
+			Options: diceware.PassphraseOptions{
+				WordCount:    2,
+				Wordlist:     wordlist.NewMap(1, 4, map[int]string{1: "ox", 2: "cat", 3: "horse", 4: "zebra"}),
+				ExcludeWords: []string{"ox"},
+			},
+			Bits: 2 * math.Log2(3),
+		},
+	}
+
+	for _, test := range tests {
+		strength, err := diceware.StrengthReport(test.Options)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.InDelta(test.Bits, strength.EntropyBits, 0.001, test.Name)
+			assert.Len(strength.CrackTimes, len(diceware.DefaultGuessRates), test.Name)
+		}
+	}
+}
+
+func TestEstimateStrength(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Bits  float64
+		Rate  diceware.GuessRate
+		Value string
+	}{
+		{
+			Name:  "will describe a crack time under a second",
+			Bits:  10,
+			Rate:  diceware.GuessRateOfflineGPU,
+			Value: "less than a second",
+		}, {
+			Name:  "will describe a crack time in seconds",
+			Bits:  5,
+			Rate:  diceware.GuessRate{Name: "test", GuessesPerSecond: 1},
+			Value: "16 seconds",
+		}, {
+			Name:  "will describe a crack time in singular units",
+			Bits:  13,
+			Rate:  diceware.GuessRate{Name: "test", GuessesPerSecond: 4096.0 / 3600},
+			Value: "1 hour",
+		}, {
+			Name:  "will describe a crack time in years",
+			Bits:  26,
+			Rate:  diceware.GuessRateOnlineThrottled,
+			Value: "38 years",
+		}, {
+			Name:  "will describe a crack time in large multiples of years",
+			Bits:  77.5489,
+			Rate:  diceware.GuessRateOfflineGPU,
+			Value: "350 thousand years",
+		}, {
+			Name:  "will describe a crack time beyond the largest unit",
+			Bits:  128,
+			Rate:  diceware.GuessRateOfflineGPU,
+			Value: "more than a thousand trillion years",
+		},
+	}
+
+	for _, test := range tests {
+		strength := diceware.EstimateStrength(test.Bits, test.Rate)
+		if assert.Len(strength.CrackTimes, 1, test.Name) {
+			assert.Equal(test.Rate, strength.CrackTimes[0].Rate, test.Name)
+			assert.Equal(test.Value, strength.CrackTimes[0].String(), test.Name)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package diceware
+
+// redacted represents the text printed in place of sensitive values.
+const redacted = "[REDACTED]"
+
+// Transcript defines the record of every roll made while generating a
+// passphrase, allowing the generation to be audited or the passphrase to be
+// reproduced with physical dice.
+//
+// SENSITIVE: a transcript reveals the passphrase it was captured for.  It
+// must never be logged or stored anywhere the passphrase itself would not be,
+// which is why printing it with the fmt package only shows "[REDACTED]".
+type Transcript struct {
+	// Words represents the roll of each word within the passphrase, in order.
+	Words []WordRoll
+
+	// Insertions represents each character inserted into the words by
+	// IncludeDigits and EnhanceEntropy, in the order they were inserted.
+	Insertions []Insertion
+}
+
+// WordRoll defines the roll of a single word of a passphrase.
+type WordRoll struct {
+	// Position represents the index of the word within the passphrase.
+	Position int
+
+	// Dice represents the face value of each die that was rolled.
+	Dice []int
+
+	// RollValue represents the roll value the dice combine into.
+	RollValue int
+
+	// Word represents the word fetched from the wordlist for the roll value,
+	// before any capitalization or insertions were applied.
+	Word string
+}
+
+// Insertion defines a character inserted into a word of a passphrase.
+type Insertion struct {
+	// Position represents the index of the word within the passphrase.
+	Position int
+
+	// Offset represents the byte offset within the word, at the time of the
+	// insertion, that the character was inserted at.
+	Offset int
+
+	// Character represents the inserted character.
+	Character string
+}
+
+// String returns a string.
+// It implements the logic for the fmt.Stringer interface, redacting the
+// transcript to keep it out of logs.
+func (t *Transcript) String() string {
+	return redacted
+}
+
+// GoString returns a string.
+// It implements the logic for the fmt.GoStringer interface, redacting the
+// transcript to keep it out of logs.
+func (t *Transcript) GoString() string {
+	return redacted
+}
+
+// recordWord implements the logic to append the roll of the next word to the
+// transcript, doing nothing when no transcript is being captured.
+func (t *Transcript) recordWord(word string, rollValue int, wl Wordlist) {
+	if t == nil {
+		return
+	}
+
+	t.Words = append(t.Words, WordRoll{
+		Position:  len(t.Words),
+		Dice:      diceOf(rollValue, wl.Rolls(), int(wl.SidesOfDice().Int64())),
+		RollValue: rollValue,
+		Word:      word,
+	})
+}
+
+// recordInsertion implements the logic to append a character inserted into
+// the word at the given position to the transcript, doing nothing when no
+// transcript is being captured.
+func (t *Transcript) recordInsertion(position, offset int, character string) {
+	if t == nil {
+		return
+	}
+
+	t.Insertions = append(t.Insertions, Insertion{
+		Position:  position,
+		Offset:    offset,
+		Character: character,
+	})
+}
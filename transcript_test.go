@@ -0,0 +1,83 @@
+package diceware_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranscript(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Options diceware.PassphraseOptions
+	}{
+		{
+			Name: "will record the rolls of the words",
+			Options: diceware.PassphraseOptions{
+				WordCount:  6,
+				Separator:  " ",
+				Wordlist:   wordlist.EFFLong,
+				Transcript: true,
+			},
+		}, {
+			Name: "will record the inserted characters",
+			Options: diceware.PassphraseOptions{
+				WordCount:      4,
+				Separator:      " ",
+				Wordlist:       wordlist.EFFLong,
+				IncludeDigits:  true,
+				EnhanceEntropy: true,
+				Transcript:     true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		passphrase, err := diceware.GeneratePassphrase(test.Options)
+		if !assert.NoError(err, test.Name) || !assert.NotNil(passphrase.Transcript, test.Name) {
+			continue
+		}
+
+		transcript := passphrase.Transcript
+		if !assert.Len(transcript.Words, len(passphrase.Words), test.Name) {
+			continue
+		}
+
+		// replaying the transcript must reproduce the passphrase
+		words := make([]string, len(transcript.Words))
+		for i, roll := range transcript.Words {
+			assert.Equal(i, roll.Position, test.Name)
+			assert.Equal(passphrase.Rolls[i], roll.RollValue, test.Name)
+
+			word, err := diceware.WordFromDiceRolls(test.Options.Wordlist, roll.Dice)
+			assert.NoError(err, test.Name)
+			assert.Equal(roll.Word, word, test.Name)
+
+			words[i] = roll.Word
+		}
+
+		for _, insertion := range transcript.Insertions {
+			word := words[insertion.Position]
+			words[insertion.Position] = word[:insertion.Offset] + insertion.Character + word[insertion.Offset:]
+		}
+
+		assert.Equal(passphrase.Words, words, test.Name)
+		assert.Equal(test.Options.IncludeDigits || test.Options.EnhanceEntropy, len(transcript.Insertions) > 0, test.Name)
+		assert.Equal("[REDACTED]", fmt.Sprint(transcript), test.Name)
+		assert.Equal("[REDACTED]", fmt.Sprintf("%#v", transcript), test.Name)
+	}
+
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount: 6,
+		Separator: " ",
+		Wordlist:  wordlist.EFFLong,
+	})
+	if assert.NoError(err) {
+		assert.Nil(passphrase.Transcript)
+	}
+}
@@ -0,0 +1,87 @@
+package diceware
+
+import (
+	"strings"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+)
+
+// maxInsertedCharacters represents the number of characters that may have
+// been inserted into a single word by EnhanceEntropy and IncludeDigits.
+const maxInsertedCharacters = 2
+
+// Verify returns a bool.
+// Implements the logic required to check whether every word of the passphrase
+// exists within the wordlist, ignoring any capitalization and the characters
+// of `wordlist.ExtraEntropy` and `wordlist.Digits` that EnhanceEntropy and
+// IncludeDigits insert into the words.  Characters inserted from a custom
+// EnhancementCharset are not ignored.
+func Verify(passphrase, separator string, wl Wordlist) (bool, error) {
+	if wl == nil {
+		return false, ErrInvalidWordlist
+	}
+
+	if separator == "" {
+		return false, ErrInvalidSeparator
+	}
+
+	index := wordlist.Index(wl)
+	lowered := make(map[string]bool, index.Len())
+	for word := range index.All() {
+		lowered[strings.ToLower(word)] = true
+	}
+
+	inserted := insertedCharacters()
+	for _, word := range strings.Split(passphrase, separator) {
+		if !matchesWord(characters(strings.ToLower(word)), lowered, inserted, maxInsertedCharacters) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// insertedCharacters returns a map of strings to bools.
+// Implements the logic to collect the characters EnhanceEntropy and
+// IncludeDigits insert by default, which are those of `wordlist.ExtraEntropy`
+// and `wordlist.Digits`.
+func insertedCharacters() map[string]bool {
+	inserted := map[string]bool{}
+	for _, charset := range []Wordlist{wordlist.ExtraEntropy, wordlist.Digits} {
+		for character := range wordlist.Index(charset).All() {
+			inserted[character] = true
+		}
+	}
+
+	return inserted
+}
+
+// matchesWord returns a bool.
+// Implements the logic to check whether the word, with at most removals of its
+// characters that are found within inserted, is found within the words.
+func matchesWord(word []string, words, inserted map[string]bool, removals int) bool {
+	if words[strings.Join(word, "")] {
+		return true
+	}
+
+	if removals == 0 || len(word) < 2 {
+		return false
+	}
+
+	// inserted characters are never placed before the first character
+	for i := 1; i < len(word); i++ {
+		if !inserted[word[i]] {
+			continue
+		}
+
+		candidate := make([]string, 0, len(word)-1)
+		candidate = append(candidate, word[:i]...)
+		candidate = append(candidate, word[i+1:]...)
+
+		if matchesWord(candidate, words, inserted, removals-1) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package diceware_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware"
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Passphrase string
+		Separator  string
+		Wordlist   diceware.Wordlist
+		Value      bool
+		Error      error
+	}{
+		{
+			Name:       "will error with a nil wordlist",
+			Passphrase: "acid zoom",
+			Separator:  " ",
+			Error:      diceware.ErrInvalidWordlist,
+		}, {
+			Name:       "will error with an empty separator",
+			Passphrase: "acid zoom",
+			Wordlist:   wordlist.EFFLong,
+			Error:      diceware.ErrInvalidSeparator,
+		}, {
+			Name:       "will verify words found within the wordlist",
+			Passphrase: "acid zoom yodel",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+			Value:      true,
+		}, {
+			Name:       "will verify words ignoring capitalization",
+			Passphrase: "Acid ZOOM yoDel",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+			Value:      true,
+		}, {
+			Name:       "will verify words ignoring inserted characters",
+			Passphrase: "a!cid zo7om yod$e4l",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+			Value:      true,
+		}, {
+			Name:       "will not verify a word missing from the wordlist",
+			Passphrase: "acid diceware zoom",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		}, {
+			Name:       "will not verify a word with too many inserted characters",
+			Passphrase: "a!c#i$d zoom",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		}, {
+			Name:       "will not verify a word with inserted letters",
+			Passphrase: "acidxy zoom",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		}, {
+			Name:       "will not verify the plural of a word",
+			Passphrase: "acids zoom",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		}, {
+			Name:       "will not verify a word with a letter inserted alongside a digit",
+			Passphrase: "ac7iqd zoom",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		}, {
+			Name:       "will not verify a word from another wordlist",
+			Passphrase: "alien angel",
+			Separator:  " ",
+			Wordlist:   wordlist.EFFLong,
+		},
+	}
+
+	for _, test := range tests {
+		verified, err := diceware.Verify(test.Passphrase, test.Separator, test.Wordlist)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			continue
+		}
+
+		if assert.NoError(err, test.Name) {
+			assert.Equal(test.Value, verified, test.Name)
+		}
+	}
+
+	verified, err := diceware.Verify("abacusxy abdomens", " ", wordlist.EFFLong)
+	if assert.NoError(err) {
+		assert.False(verified, "expected words with appended letters to not be verified")
+	}
+
+	// every generated passphrase is verified regardless of its enhancements
+	passphrase, err := diceware.GeneratePassphrase(diceware.PassphraseOptions{
+		WordCount:      6,
+		Separator:      " ",
+		Wordlist:       wordlist.EFFLong,
+		EnhanceEntropy: true,
+		IncludeDigits:  true,
+		Capitalization: diceware.CapitalizationRandomLetter,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	verified, err = diceware.Verify(passphrase.String(), " ", wordlist.EFFLong)
+	if assert.NoError(err) {
+		assert.True(verified, passphrase.String())
+	}
+}
@@ -0,0 +1,31 @@
+//go:build !diceware_minimal && !diceware_no_bip39_english
+
+package wordlist
+
+import _ "embed"
+
+// bip39EnglishData represents the gzip compressed words of BIP39English, one on
+// each line in ascending order of their BIP39 index.
+//
+//go:embed data/bip39-english.txt.gz
+var bip39EnglishData []byte
+
+// BIP39English defines the 2048 word English wordlist of the BIP39 mnemonic
+// specification, with the words in the order of their BIP39 index, so that
+// `WordAt` gives the word of an index.  Every word is identified by its first
+// 4 letters.  The words are keyed with 4 8-sided dice, rejecting the rolls
+// past the last word.
+var BIP39English = newEmbeddedSlice(
+	"bip39-english",
+	// obtained from https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt
+	bip39EnglishData,
+)
+
+// BIP39EnglishSHA256 represents the SHA-256 digest of the words of
+// BIP39English, as calculated by `Digest` and checked by `VerifyIntegrity`.  It
+// matches the digest of the published english.txt.
+const BIP39EnglishSHA256 = "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda"
+
+// bip39EnglishBuiltins represents BIP39English as a built-in wordlist, along with its
+// expected digest.
+var bip39EnglishBuiltins = []builtin{{wl: BIP39English, digest: BIP39EnglishSHA256, selectable: true}}
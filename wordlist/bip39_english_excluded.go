@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_bip39_english
+
+package wordlist
+
+// bip39EnglishBuiltins represents no built-in wordlist, as BIP39English is left out of the
+// binary by a build tag.
+var bip39EnglishBuiltins []builtin
@@ -0,0 +1,84 @@
+//go:build !diceware_minimal && !diceware_no_bip39_english
+
+package wordlist_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBIP39EnglishWordAt(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Index int
+		Value string
+	}{
+		{
+			Name:  "will return the word of the first index",
+			Index: 0,
+			Value: "abandon",
+		}, {
+			Name:  "will return the word of the last index",
+			Index: 2047,
+			Value: "zoo",
+		}, {
+			Name:  "will return a blank value past the last index",
+			Index: 2048,
+			Value: "",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.BIP39English.WordAt(test.Index), test.Name)
+	}
+}
+
+func TestBIP39EnglishFetchWord(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("abandon", wordlist.BIP39English.FetchWord(1111))
+	assert.Equal("zoo", wordlist.BIP39English.FetchWord(4888))
+	assert.Equal("", wordlist.BIP39English.FetchWord(5111))
+}
+
+func TestBIP39EnglishRolls(t *testing.T) {
+	assert.Equal(t, 4, wordlist.BIP39English.Rolls(), "Rolls should return 4")
+	assert.Equal(
+		t,
+		big.NewInt(int64(8)),
+		wordlist.BIP39English.SidesOfDice(),
+		"SidesOfDice should return 8",
+	)
+}
+
+func TestBIP39EnglishWords(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(2048, wordlist.BIP39English.Len())
+	assert.InDelta(11, wordlist.BIP39English.Entropy(), 0.0001)
+	assert.True(wordlist.Validate(wordlist.BIP39English).Valid())
+
+	// every word is identified by its first 4 letters
+	prefixes := map[string]bool{}
+	for index := 0; index < wordlist.BIP39English.Len(); index++ {
+		word := wordlist.BIP39English.WordAt(index)
+		prefixes[word[:min(len(word), 4)]] = true
+	}
+
+	assert.Len(prefixes, 2048)
+}
+
+func TestBIP39EnglishEmbedded(t *testing.T) {
+	assertEmbedded(t, wordlist.BIP39English, "bip39-english", 2048, "abandon", "zoo")
+}
+
+func TestBIP39EnglishDigest(t *testing.T) {
+	// matches the digest of the published english.txt
+	assert.Equal(t, "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda",
+		wordlist.Digest(wordlist.BIP39English))
+}
@@ -0,0 +1,55 @@
+package wordlist
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ParseCSV returns a Map.
+// Implements the logic required to read a wordlist from CSV, such as one
+// exported from a spreadsheet, with a roll value and a word on each record.
+// A first record without a numeric roll value is treated as a header and
+// skipped.  The roll values follow the rules described by `Parse`, and
+// problems within the records are returned as a *ParseError.
+func ParseCSV(r io.Reader) (*Map, error) {
+	return parseCSV("", r)
+}
+
+// parseCSV returns a Map.
+// Implements the logic of ParseCSV, identifying the Map by the given name.
+func parseCSV(name string, r io.Reader) (*Map, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	builder := newMapBuilder(0)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var csvErr *csv.ParseError
+		if errors.As(err, &csvErr) {
+			return nil, &ParseError{Line: csvErr.Line, Err: fmt.Errorf("%w: %w", ErrInvalidFormat, csvErr.Err)}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading wordlist: %w", err)
+		}
+
+		if _, err := strconv.Atoi(record[0]); first && err != nil {
+			continue
+		}
+
+		if err := builder.add(record[0], record[1]); err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, &ParseError{Line: line, Err: err}
+		}
+	}
+
+	return builder.build(name)
+}
@@ -0,0 +1,60 @@
+package wordlist_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Input string
+		Words map[int]string
+		Line  int
+		Error error
+	}{
+		{
+			Name:  "will parse records of roll values and words",
+			Input: "1,acid\n2,cat\n",
+			Words: map[int]string{1: "acid", 2: "cat"},
+		}, {
+			Name:  "will skip a header record",
+			Input: "roll,word\n1, acid\n2,\"cat\"\n",
+			Words: map[int]string{1: "acid", 2: "cat"},
+		}, {
+			Name:  "will error with a record of the wrong length",
+			Input: "1,acid\n2,cat,extra\n",
+			Line:  2,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with an invalid roll value after the first record",
+			Input: "1,acid\nx,cat\n",
+			Line:  2,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with missing roll values",
+			Input: "1,acid\n3,cat\n",
+			Error: wordlist.ErrIncomplete,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.ParseCSV(strings.NewReader(test.Input))
+		assert.ErrorIs(err, test.Error, test.Name)
+
+		var parseErr *wordlist.ParseError
+		if errors.As(err, &parseErr) {
+			assert.Equal(test.Line, parseErr.Line, test.Name)
+		}
+
+		for rollValue, word := range test.Words {
+			assert.Equal(word, wl.FetchWord(rollValue), test.Name)
+		}
+	}
+}
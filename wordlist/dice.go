@@ -0,0 +1,104 @@
+package wordlist
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The sides of the common physical dice, which are able to key a wordlist in
+// addition to the 6 sided dice used by the built-in wordlists.
+const (
+	D4  = 4
+	D6  = 6
+	D8  = 8
+	D10 = 10
+	D12 = 12
+	D20 = 20
+)
+
+// ErrInvalidDice represents the error given when the number of dice or their
+// sides are unable to key a wordlist
+var ErrInvalidDice = errors.New("invalid dice")
+
+// ValidateDice returns an error.
+// Implements the logic to verify that the given number of dice, each having
+// the given number of sides, is able to key a wordlist, which requires at
+// least 1 die with at least 2 sides and a number of roll values fitting
+// within an int.
+func ValidateDice(rolls, sides int) error {
+	if rolls < 1 {
+		return fmt.Errorf("%w: %d dice", ErrInvalidDice, rolls)
+	}
+
+	if sides < 2 {
+		return fmt.Errorf("%w: %d sides", ErrInvalidDice, sides)
+	}
+
+	if combinations(rolls, sides) == 0 || combinations(rolls, RollPlace(sides)) == 0 {
+		return fmt.Errorf("%w: %d dice with %d sides has too many roll values", ErrInvalidDice, rolls, sides)
+	}
+
+	return nil
+}
+
+// faceDigits returns an int.
+// Implements the logic to calculate the number of decimal digits each die
+// with the given sides contributes to a roll value.
+func faceDigits(sides int) int {
+	return len(strconv.Itoa(RollPlace(sides))) - 1
+}
+
+// FormatRollValue returns a string.
+// Implements the logic to write the roll value of the given dice in the
+// format of a printed wordlist, zero padding the face of each die to the
+// digits of its sides, e.g. "13624" for 6 sided dice and "011620" for a 1, 16
+// and 20 rolled with 20 sided dice.
+func FormatRollValue(rollValue, rolls, sides int) string {
+	return fmt.Sprintf("%0*d", rolls*faceDigits(sides), rollValue)
+}
+
+// ParseRollValue returns an int.
+// Implements the logic to read a roll value written in the format given by
+// `FormatRollValue`, verifying that it has the digits of each of the dice and
+// that every face is between 1 and the sides.  Problems are returned wrapping
+// ErrInvalidFormat.
+func ParseRollValue(text string, rolls, sides int) (int, error) {
+	width := faceDigits(sides)
+	if len(text) != rolls*width || strings.Trim(text, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: roll value %q is not %d digits", ErrInvalidFormat, text, rolls*width)
+	}
+
+	for i := 0; i < len(text); i += width {
+		if face, _ := strconv.Atoi(text[i : i+width]); face < 1 || face > sides {
+			return 0, fmt.Errorf("%w: roll value %q has a face outside of 1 to %d", ErrInvalidFormat, text, sides)
+		}
+	}
+
+	rollValue, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid roll value %q", ErrInvalidFormat, text)
+	}
+
+	return rollValue, nil
+}
+
+// NewDiceMap returns an initialized Map object, or an error when the dice are
+// unable to key a wordlist or any of the roll values of the words is unable
+// to be rolled with them.  Unlike NewNamedMap, the roll values are checked
+// against the dice, e.g. 20 sided dice key the words with roll values such as
+// 10120 rather than 126.
+func NewDiceMap(name string, rolls, sides int, words map[int]string) (*Map, error) {
+	if err := ValidateDice(rolls, sides); err != nil {
+		return nil, err
+	}
+
+	for rollValue := range words {
+		if _, valid := IndexForRollValue(rollValue, rolls, sides); !valid {
+			return nil, fmt.Errorf("%w: %s", ErrRollOutOfRange, FormatRollValue(rollValue, rolls, sides))
+		}
+	}
+
+	return NewNamedMap(name, rolls, sides, words), nil
+}
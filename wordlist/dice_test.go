@@ -0,0 +1,185 @@
+package wordlist_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDice(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Rolls int
+		Sides int
+		Error error
+	}{
+		{
+			Name:  "will accept 5 6 sided dice",
+			Rolls: 5,
+			Sides: wordlist.D6,
+		}, {
+			Name:  "will accept 3 20 sided dice",
+			Rolls: 3,
+			Sides: wordlist.D20,
+		}, {
+			Name:  "will error without any dice",
+			Rolls: 0,
+			Sides: wordlist.D6,
+			Error: wordlist.ErrInvalidDice,
+		}, {
+			Name:  "will error with a single sided die",
+			Rolls: 1,
+			Sides: 1,
+			Error: wordlist.ErrInvalidDice,
+		}, {
+			Name:  "will error with too many roll values",
+			Rolls: 10,
+			Sides: math.MaxInt32,
+			Error: wordlist.ErrInvalidDice,
+		},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(wordlist.ValidateDice(test.Rolls, test.Sides), test.Error, test.Name)
+	}
+}
+
+func TestFormatRollValue(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		RollValue int
+		Rolls     int
+		Sides     int
+		Value     string
+	}{
+		{
+			Name:      "will format 6 sided dice with a digit each",
+			RollValue: 13624,
+			Rolls:     5,
+			Sides:     wordlist.D6,
+			Value:     "13624",
+		}, {
+			Name:      "will zero pad the faces of 20 sided dice",
+			RollValue: 11620,
+			Rolls:     3,
+			Sides:     wordlist.D20,
+			Value:     "011620",
+		}, {
+			Name:      "will zero pad the faces of 10 sided dice",
+			RollValue: 101,
+			Rolls:     2,
+			Sides:     wordlist.D10,
+			Value:     "0101",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.FormatRollValue(test.RollValue, test.Rolls, test.Sides), test.Name)
+	}
+}
+
+func TestParseRollValue(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Text  string
+		Rolls int
+		Sides int
+		Value int
+		Error error
+	}{
+		{
+			Name:  "will parse 6 sided dice",
+			Text:  "13624",
+			Rolls: 5,
+			Sides: wordlist.D6,
+			Value: 13624,
+		}, {
+			Name:  "will parse zero padded 20 sided dice",
+			Text:  "011620",
+			Rolls: 3,
+			Sides: wordlist.D20,
+			Value: 11620,
+		}, {
+			Name:  "will error with a face larger than the sides",
+			Text:  "011621",
+			Rolls: 3,
+			Sides: wordlist.D20,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a zero face",
+			Text:  "13604",
+			Rolls: 5,
+			Sides: wordlist.D6,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with too few digits",
+			Text:  "11620",
+			Rolls: 3,
+			Sides: wordlist.D20,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a sign",
+			Text:  "+1",
+			Rolls: 1,
+			Sides: wordlist.D20,
+			Error: wordlist.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		rollValue, err := wordlist.ParseRollValue(test.Text, test.Rolls, test.Sides)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, rollValue, test.Name)
+	}
+}
+
+func TestNewDiceMap(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Rolls int
+		Sides int
+		Words map[int]string
+		Error error
+	}{
+		{
+			Name:  "will create a map keyed with 20 sided dice",
+			Rolls: 2,
+			Sides: wordlist.D20,
+			Words: map[int]string{101: "acid", 2020: "zoom"},
+		}, {
+			Name:  "will error with a roll value unable to be rolled",
+			Rolls: 2,
+			Sides: wordlist.D20,
+			Words: map[int]string{11: "acid"},
+			Error: wordlist.ErrRollOutOfRange,
+		}, {
+			Name:  "will error with invalid dice",
+			Rolls: 0,
+			Sides: wordlist.D20,
+			Error: wordlist.ErrInvalidDice,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.NewDiceMap("dice", test.Rolls, test.Sides, test.Words)
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error != nil {
+			assert.Nil(wl, test.Name)
+			continue
+		}
+
+		for rollValue, word := range test.Words {
+			assert.Equal(word, wl.FetchWord(rollValue), test.Name)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package wordlist
+
+// Digits defines a list of the numbers 0 through 9 in a single 10 sided die
+// pattern that can be utilized to pull a random digit without any of the
+// symbols found within ExtraEntropy.
+var Digits = newLazyMap(
+	"digits",
+	1,
+	10,
+	func() map[int]string {
+		return map[int]string{
+			1:  "0",
+			2:  "1",
+			3:  "2",
+			4:  "3",
+			5:  "4",
+			6:  "5",
+			7:  "6",
+			8:  "7",
+			9:  "8",
+			10: "9",
+		}
+	},
+)
+
+// DigitsSHA256 represents the SHA-256 digest of the words of Digits, as
+// calculated by `Digest` and checked by `VerifyIntegrity`.
+const DigitsSHA256 = "7427877c40fb0361401248f9c96abe6117396bc6ab16811b5b1706274c02443e"
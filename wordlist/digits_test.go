@@ -0,0 +1,48 @@
+package wordlist_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigitsFetchWord(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		DiceRoll int
+		Value    string
+	}{
+		{
+			Name:     "will return a value from the map",
+			DiceRoll: 1,
+			Value:    "0",
+		}, {
+			Name:     "will return the last value from the map",
+			DiceRoll: 10,
+			Value:    "9",
+		}, {
+			Name:     "will return a blank value",
+			DiceRoll: 11,
+			Value:    "",
+		},
+	}
+
+	for _, test := range tests {
+		fetchedValue := wordlist.Digits.FetchWord(test.DiceRoll)
+		assert.Equal(test.Value, fetchedValue, test.Name)
+	}
+}
+
+func TestDigitsRolls(t *testing.T) {
+	assert.Equal(t, 1, wordlist.Digits.Rolls(), "Rolls should return 1")
+	assert.Equal(
+		t,
+		big.NewInt(int64(10)),
+		wordlist.Digits.SidesOfDice(),
+		"SidesOfDice should return 10",
+	)
+}
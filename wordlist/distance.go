@@ -0,0 +1,105 @@
+package wordlist
+
+import "sort"
+
+// EFFMinEditDistance represents the minimum edit distance between any 2 words
+// of the EFF short prefix wordlist, making it resilient to up to 2 typos.
+const EFFMinEditDistance = 3
+
+// SimilarPair defines a pair of words of a wordlist that are closer to each
+// other than the required edit distance.
+type SimilarPair struct {
+	// First represents the word sorting before Second.
+	First string
+
+	// Second represents the word sorting after First.
+	Second string
+
+	// Distance represents the Levenshtein distance between the words.
+	Distance int
+}
+
+// SimilarWords returns a slice of SimilarPairs.
+// Implements the logic required to find every pair of distinct words within
+// the wordlist with a Levenshtein distance below minDistance, sorted by the
+// words, allowing wordlist authors to measure how resilient the wordlist is
+// to typos.  A minDistance of `EFFMinEditDistance` matches the EFF short
+// prefix wordlist.
+func SimilarWords(wl Source, minDistance int) []SimilarPair {
+	words := uniqueWords(wl)
+	sort.Strings(words)
+
+	runes := make([][]rune, len(words))
+	for i, word := range words {
+		runes[i] = []rune(word)
+	}
+
+	pairs := []SimilarPair{}
+	for i := range runes {
+		for j := i + 1; j < len(runes); j++ {
+			if distance, within := boundedDistance(runes[i], runes[j], minDistance-1); within {
+				pairs = append(pairs, SimilarPair{First: words[i], Second: words[j], Distance: distance})
+			}
+		}
+	}
+
+	return pairs
+}
+
+// Levenshtein returns an int.
+// Implements the logic to calculate the minimum number of single character
+// insertions, deletions and substitutions needed to turn a into b.
+func Levenshtein(a, b string) int {
+	first, second := []rune(a), []rune(b)
+	distance, _ := boundedDistance(first, second, max(len(first), len(second)))
+
+	return distance
+}
+
+// boundedDistance returns an int and a bool.
+// Implements the logic to calculate the Levenshtein distance between a and b
+// when it is at most limit, returning false as soon as the distance is known
+// to exceed the limit.
+func boundedDistance(a, b []rune, limit int) (int, bool) {
+	if limit < 0 || abs(len(a)-len(b)) > limit {
+		return 0, false
+	}
+
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		smallest := current[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			smallest = min(smallest, current[j])
+		}
+
+		if smallest > limit {
+			return 0, false
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)], previous[len(b)] <= limit
+}
+
+// abs returns an int.
+// Implements the logic to calculate the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
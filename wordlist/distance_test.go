@@ -0,0 +1,95 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshtein(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name   string
+		First  string
+		Second string
+		Value  int
+	}{
+		{
+			Name:   "will return zero for equal words",
+			First:  "horse",
+			Second: "horse",
+			Value:  0,
+		}, {
+			Name:   "will count a substitution",
+			First:  "horse",
+			Second: "house",
+			Value:  1,
+		}, {
+			Name:   "will count insertions and deletions",
+			First:  "kitten",
+			Second: "sitting",
+			Value:  3,
+		}, {
+			Name:   "will count every character against an empty word",
+			First:  "",
+			Second: "zoom",
+			Value:  4,
+		}, {
+			Name:   "will count characters rather than bytes",
+			First:  "café",
+			Second: "cafe",
+			Value:  1,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.Levenshtein(test.First, test.Second), test.Name)
+		assert.Equal(test.Value, wordlist.Levenshtein(test.Second, test.First), test.Name)
+	}
+}
+
+func TestSimilarWords(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name        string
+		Wordlist    wordlist.Source
+		MinDistance int
+		Pairs       []wordlist.SimilarPair
+	}{
+		{
+			Name:        "will find the pairs closer than the distance",
+			Wordlist:    wordlist.NewMap(1, 4, map[int]string{1: "horse", 2: "house", 3: "mouse", 4: "staple"}),
+			MinDistance: 2,
+			Pairs: []wordlist.SimilarPair{
+				{First: "horse", Second: "house", Distance: 1},
+				{First: "house", Second: "mouse", Distance: 1},
+			},
+		}, {
+			Name:        "will find the pairs within a larger distance",
+			Wordlist:    wordlist.NewMap(1, 4, map[int]string{1: "horse", 2: "house", 3: "mouse", 4: "staple"}),
+			MinDistance: 3,
+			Pairs: []wordlist.SimilarPair{
+				{First: "horse", Second: "house", Distance: 1},
+				{First: "horse", Second: "mouse", Distance: 2},
+				{First: "house", Second: "mouse", Distance: 1},
+			},
+		}, {
+			Name:        "will find no pairs with a distance of 1",
+			Wordlist:    wordlist.NewMap(1, 2, map[int]string{1: "horse", 2: "house"}),
+			MinDistance: 1,
+			Pairs:       []wordlist.SimilarPair{},
+		}, {
+			Name:        "will count punctuation as a character",
+			Wordlist:    wordlist.NewMap(1, 2, map[int]string{1: "yo-yo", 2: "yoyo"}),
+			MinDistance: 2,
+			Pairs:       []wordlist.SimilarPair{{First: "yo-yo", Second: "yoyo", Distance: 1}},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Pairs, wordlist.SimilarWords(test.Wordlist, test.MinDistance), test.Name)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_eff_short
+
+package wordlist
+
+// effShortBuiltins represents no built-in wordlist, as EFFShort is left out of the
+// binary by a build tag.
+var effShortBuiltins []builtin
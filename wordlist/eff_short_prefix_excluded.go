@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_eff_short_prefix
+
+package wordlist
+
+// effShortPrefixBuiltins represents no built-in wordlist, as EFFShortPrefix is left out of the
+// binary by a build tag.
+var effShortPrefixBuiltins []builtin
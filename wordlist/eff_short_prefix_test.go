@@ -1,3 +1,5 @@
+//go:build !diceware_minimal && !diceware_no_eff_short_prefix
+
 package wordlist_test
 
 import (
@@ -42,3 +44,24 @@ func TestEFFShortPrefixRolls(t *testing.T) {
 		"SidesOfDice should return 6",
 	)
 }
+
+func TestEFFShortPrefixEmbedded(t *testing.T) {
+	assertEmbedded(t, wordlist.EFFShortPrefix, "eff-short-prefix", 1296, "aardvark", "zucchini")
+}
+
+func TestEFFShortPrefixMap(t *testing.T) {
+	assert.Equal(t, "eff-short-prefix", wordlist.EFFShortPrefix.Name())
+}
+
+func TestEFFShortPrefixValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	report := wordlist.Validate(wordlist.EFFShortPrefix)
+	assert.Equal(1296, report.Words)
+	assert.True(report.Valid())
+
+	assert.Equal([]wordlist.PrefixViolation{}, wordlist.PrefixViolations(wordlist.EFFShortPrefix))
+	assert.True(wordlist.IsPrefixFree(wordlist.EFFShortPrefix))
+
+	assert.Equal([]wordlist.SimilarPair{}, wordlist.SimilarWords(wordlist.EFFShortPrefix, wordlist.EFFMinEditDistance))
+}
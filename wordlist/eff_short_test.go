@@ -1,3 +1,5 @@
+//go:build !diceware_minimal && !diceware_no_eff_short
+
 package wordlist_test
 
 import (
@@ -42,3 +44,129 @@ func TestEFFShortRolls(t *testing.T) {
 		"SidesOfDice should return 6",
 	)
 }
+
+func TestEFFShortEmbedded(t *testing.T) {
+	assertEmbedded(t, wordlist.EFFShort, "eff-short", 1296, "acid", "zoom")
+}
+
+func TestEFFShortMap(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("eff-short", wordlist.EFFShort.Name())
+	assert.Equal(1296, wordlist.EFFShort.Len())
+	assert.Equal(1296, wordlist.EFFShort.Length())
+	assert.InDelta(10.3399, wordlist.EFFShort.Entropy(), 0.0001)
+}
+
+func TestEFFShortWordAt(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Index int
+		Value string
+	}{
+		{
+			Name:  "will return the first word",
+			Index: 0,
+			Value: "acid",
+		}, {
+			Name:  "will return the last word",
+			Index: 1295,
+			Value: "zoom",
+		}, {
+			Name:  "will return a blank value for a negative index",
+			Index: -1,
+			Value: "",
+		}, {
+			Name:  "will return a blank value for an index past the end",
+			Index: 1296,
+			Value: "",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.EFFShort.WordAt(test.Index), test.Name)
+	}
+}
+
+func TestEFFShortValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	report := wordlist.Validate(wordlist.EFFShort)
+	assert.Equal(1296, report.Words)
+	assert.True(report.Valid())
+
+	filtered, err := wordlist.FilterOffensive(wordlist.EFFShort)
+	if assert.NoError(err) {
+		assert.Equal(1294, wordlist.Validate(filtered).Words)
+		for index := 0; index < filtered.Len(); index++ {
+			assert.False(wordlist.IsOffensive(filtered.WordAt(index)))
+		}
+	}
+}
+
+func TestEFFShortIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	rollValue, found := wordlist.Index(wordlist.EFFShort).RollFor("zoom")
+	assert.True(found, "will find the roll value of the last word")
+	assert.Equal(6666, rollValue, "will find the roll value of the last word")
+
+	_, found = wordlist.Index(wordlist.EFFShort).RollFor("abacus")
+	assert.False(found, "will not find a word outside of the wordlist")
+}
+
+func TestEFFShortMerge(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		Wordlists []wordlist.Source
+		WordName  string
+	}{
+		{
+			Name:      "will remove the duplicated words",
+			Wordlists: []wordlist.Source{wordlist.EFFShort, wordlist.EFFShort},
+			WordName:  "eff-short+eff-short",
+		}, {
+			Name:      "will ignore the missing words and unnamed wordlists",
+			Wordlists: []wordlist.Source{wordlist.EFFShort, wordlist.NewMap(1, 6, map[int]string{1: "acid"})},
+			WordName:  "eff-short",
+		},
+	}
+
+	for _, test := range tests {
+		merged, err := wordlist.Merge(test.Wordlists...)
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Equal(test.WordName, merged.Name(), test.Name)
+		assert.Equal(4, merged.Rolls(), test.Name)
+		assert.Equal(int64(6), merged.SidesOfDice().Int64(), test.Name)
+		assert.InDelta(10.3399, merged.Entropy(), 0.0001, test.Name)
+		assert.True(wordlist.Validate(merged).Valid(), test.Name)
+	}
+}
+
+func TestEFFShortRekey(t *testing.T) {
+	assert := assert.New(t)
+
+	wl, err := wordlist.Rekey(wordlist.EFFShort, 2, 36, wordlist.RekeyStrict)
+	if assert.NoError(err, "will rekey a 4 dice wordlist with 2 dice of 36 sides") {
+		assert.Equal("eff-short-2d36", wl.Name())
+		assert.Equal(1296, wl.Length())
+		assert.True(wordlist.Validate(wl).Valid())
+	}
+
+	_, err = wordlist.Rekey(wordlist.EFFShort, 5, 6, wordlist.RekeyTruncate)
+	assert.ErrorIs(err, wordlist.ErrIncomplete, "will error for a 4 dice wordlist keyed with 5 dice")
+}
+
+func TestEFFShortStrict(t *testing.T) {
+	wl, err := wordlist.Strict(wordlist.EFFShort)
+	if assert.NoError(t, err, "will accept a complete map") {
+		assert.Equal(t, 1296, wl.Length())
+	}
+}
@@ -0,0 +1,27 @@
+package wordlist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// decodeWords returns a slice of strings.
+// Implements the logic to decompress the gzip compressed words of an embedded
+// wordlist, one on each line.  The embedded data is verified by the tests of
+// every wordlist, so it panics when the data is unable to be decompressed.
+func decodeWords(data []byte) []string {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		panic(fmt.Errorf("decompressing embedded wordlist: %w", err))
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		panic(fmt.Errorf("decompressing embedded wordlist: %w", err))
+	}
+
+	return strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+}
@@ -0,0 +1,38 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertEmbedded implements the logic to check that the embedded wordlist
+// decodes to the given number of words, from the first to the last, and is
+// registered under its name.
+func assertEmbedded(t *testing.T, wl wordlist.Source, name string, words int, first, last string) {
+	t.Helper()
+
+	assert := assert.New(t)
+
+	indexed := wl.(interface {
+		wordlist.Sizer
+		WordAt(int) string
+	})
+
+	assert.Equal(words, indexed.Length(), name)
+	assert.Equal(first, indexed.WordAt(0), name)
+	assert.Equal(last, indexed.WordAt(words-1), name)
+	assert.Empty(wordlist.Validate(wl).Missing, name)
+
+	registered, err := wordlist.Get(name)
+	if assert.NoError(err, name) {
+		assert.Equal(wl, registered, name)
+	}
+
+	assert.Contains(wordlist.Names(), name)
+}
+
+func TestEmbeddedWordlistsDecode(t *testing.T) {
+	assertEmbedded(t, wordlist.EFFLong, "eff-long", 7776, "abacus", "zoom")
+}
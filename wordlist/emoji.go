@@ -0,0 +1,244 @@
+//go:build !diceware_minimal && !diceware_no_emoji
+
+package wordlist
+
+// Emoji defines a 3 dice word list of 216 distinct emoji, such as animals,
+// plants, food and vehicles, for passphrases or PIN patterns made of emoji.
+// Every emoji is a single code point drawn as an emoji by default, without
+// any variation selector, skin tone modifier or zero width joiner, and look
+// alike emoji, such as a mouse next to a rat or a tropical fish next to a
+// fish, are left out.  Each emoji
+// provides 7.75 bits of entropy.
+var Emoji = newLazyMap(
+	"emoji",
+	3,
+	6,
+	func() map[int]string {
+		return map[int]string{
+			111: "🐀", // rat
+			112: "🔑", // key
+			113: "🐄", // cow
+			114: "🐅", // tiger
+			115: "🐇", // rabbit
+			116: "🐈", // cat
+			121: "🐉", // dragon
+			122: "🐊", // crocodile
+			123: "🐋", // whale
+			124: "🐌", // snail
+			125: "🐍", // snake
+			126: "🐎", // horse
+			131: "🔔", // bell
+			132: "🐐", // goat
+			133: "💡", // light bulb
+			134: "🐒", // monkey
+			135: "🐓", // rooster
+			136: "🐕", // dog
+			141: "🐖", // pig
+			142: "📚", // books
+			143: "🐘", // elephant
+			144: "🐙", // octopus
+			145: "🐚", // spiral shell
+			146: "🐛", // bug
+			151: "🐜", // ant
+			152: "🐝", // honeybee
+			153: "🐞", // lady beetle
+			154: "🐟", // fish
+			155: "🔥", // fire
+			156: "💎", // gem stone
+			161: "🐢", // turtle
+			162: "🐣", // hatching chick
+			163: "🐦", // bird
+			164: "🐧", // penguin
+			165: "🐨", // koala
+			166: "🔭", // telescope
+			211: "🐫", // bactrian camel
+			212: "🐬", // dolphin
+			213: "🐸", // frog face
+			214: "🐹", // hamster face
+			215: "🐺", // wolf face
+			216: "🐻", // bear face
+			221: "🐼", // panda face
+			222: "🐾", // paw prints
+			223: "🦀", // crab
+			224: "🦁", // lion face
+			225: "🦂", // scorpion
+			226: "🦃", // turkey
+			231: "🦄", // unicorn face
+			232: "🦅", // eagle
+			233: "🦆", // duck
+			234: "🦇", // bat
+			235: "🦈", // shark
+			236: "🦉", // owl
+			241: "🦊", // fox face
+			242: "🦋", // butterfly
+			243: "🦌", // deer
+			244: "🦍", // gorilla
+			245: "🦎", // lizard
+			246: "🦏", // rhinoceros
+			251: "🦐", // shrimp
+			252: "🦑", // squid
+			253: "🦒", // giraffe face
+			254: "🦓", // zebra face
+			255: "🦔", // hedgehog
+			256: "🦕", // sauropod
+			261: "🦖", // t-rex
+			262: "🦗", // cricket
+			263: "🦘", // kangaroo
+			264: "🦙", // llama
+			265: "🦚", // peacock
+			266: "🦛", // hippopotamus
+			311: "🦜", // parrot
+			312: "🦝", // raccoon
+			313: "🦞", // lobster
+			314: "🦟", // mosquito
+			315: "🦡", // badger
+			316: "🦢", // swan
+			321: "🌰", // chestnut
+			322: "🌱", // seedling
+			323: "🌲", // evergreen tree
+			324: "🌳", // deciduous tree
+			325: "🌴", // palm tree
+			326: "🌵", // cactus
+			331: "🌷", // tulip
+			332: "🌸", // cherry blossom
+			333: "🌹", // rose
+			334: "🌺", // hibiscus
+			335: "🌻", // sunflower
+			336: "🌽", // ear of maize
+			341: "🌿", // herb
+			342: "🍀", // four leaf clover
+			343: "🍁", // maple leaf
+			344: "🍄", // mushroom
+			345: "🍅", // tomato
+			346: "🍇", // grapes
+			351: "🍉", // watermelon
+			352: "🍊", // tangerine
+			353: "🍋", // lemon
+			354: "🍌", // banana
+			355: "🍍", // pineapple
+			356: "🍎", // red apple
+			361: "🍐", // pear
+			362: "🍒", // cherries
+			363: "🍓", // strawberry
+			364: "🍔", // hamburger
+			365: "🍕", // slice of pizza
+			366: "🍖", // meat on bone
+			411: "🍗", // poultry leg
+			412: "🍙", // rice ball
+			413: "🍝", // spaghetti
+			414: "🍞", // bread
+			415: "🍟", // french fries
+			416: "🍠", // roasted sweet potato
+			421: "🍣", // sushi
+			422: "🍦", // soft ice cream
+			423: "🍩", // doughnut
+			424: "🍪", // cookie
+			425: "🍫", // chocolate bar
+			426: "🍬", // candy
+			431: "🍭", // lollipop
+			432: "🍯", // honey pot
+			433: "🍰", // shortcake
+			434: "🍱", // bento box
+			435: "🍵", // teacup without handle
+			436: "🍷", // wine glass
+			441: "🍸", // cocktail glass
+			442: "🍹", // tropical drink
+			443: "🍺", // beer mug
+			444: "🍼", // baby bottle
+			445: "🍾", // bottle with popping cork
+			446: "🍿", // popcorn
+			451: "🥐", // croissant
+			452: "🥑", // avocado
+			453: "🥒", // cucumber
+			454: "🥓", // bacon
+			455: "🥔", // potato
+			456: "🥕", // carrot
+			461: "🥗", // green salad
+			462: "🥚", // egg
+			463: "🥛", // glass of milk
+			464: "🥜", // peanuts
+			465: "🥝", // kiwifruit
+			466: "🥞", // pancakes
+			511: "🥟", // dumpling
+			512: "🔨", // hammer
+			513: "🥡", // takeout box
+			514: "🥢", // chopsticks
+			515: "🥥", // coconut
+			516: "🥦", // broccoli
+			521: "🥧", // pie
+			522: "🥨", // pretzel
+			523: "🥪", // sandwich
+			524: "🥭", // mango
+			525: "🥯", // bagel
+			526: "🚀", // rocket
+			531: "🚁", // helicopter
+			532: "🚂", // steam locomotive
+			533: "🚄", // high-speed train
+			534: "🚌", // bus
+			535: "🚑", // ambulance
+			536: "🚒", // fire engine
+			541: "🚓", // police car
+			542: "🚕", // taxi
+			543: "🚗", // automobile
+			544: "🚚", // delivery truck
+			545: "🚜", // tractor
+			546: "🚢", // ship
+			551: "🚤", // speedboat
+			552: "🚦", // vertical traffic light
+			553: "🚧", // construction sign
+			554: "🚪", // door
+			555: "🚲", // bicycle
+			556: "🚿", // shower
+			561: "🛁", // bathtub
+			562: "🎀", // ribbon
+			563: "🎁", // wrapped present
+			564: "🎂", // birthday cake
+			565: "🎃", // jack-o-lantern
+			566: "🎄", // christmas tree
+			611: "🎆", // fireworks
+			612: "🎈", // balloon
+			613: "🎉", // party popper
+			614: "🎒", // school satchel
+			615: "🎓", // graduation cap
+			616: "🌙", // crescent moon
+			621: "🎡", // ferris wheel
+			622: "🎢", // roller coaster
+			623: "🎤", // microphone
+			624: "🎥", // movie camera
+			625: "🎧", // headphone
+			626: "🎨", // artist palette
+			631: "🎩", // top hat
+			632: "🎪", // circus tent
+			633: "🎫", // ticket
+			634: "🎬", // clapper board
+			635: "🎭", // performing arts
+			636: "🎮", // video game
+			641: "🎯", // direct hit
+			642: "🎰", // slot machine
+			643: "🎱", // billiards
+			644: "🎲", // game die
+			645: "🎳", // bowling
+			646: "🎷", // saxophone
+			651: "🎸", // guitar
+			652: "🎹", // musical keyboard
+			653: "🎺", // trumpet
+			654: "🎻", // violin
+			655: "🎾", // tennis racquet and ball
+			656: "🏀", // basketball and hoop
+			661: "🏁", // chequered flag
+			662: "🏆", // trophy
+			663: "🏈", // american football
+			664: "🌈", // rainbow
+			665: "🌋", // volcano
+			666: "🌟", // glowing star
+		}
+	},
+)
+
+// EmojiSHA256 represents the SHA-256 digest of the words of Emoji, as
+// calculated by `Digest` and checked by `VerifyIntegrity`.
+const EmojiSHA256 = "c34bfa94b3a9afab2eb46bac06e26d379ddd2fd2005ac23269b960cca4047c7e"
+
+// emojiBuiltins represents Emoji as a built-in wordlist, along with its
+// expected digest.
+var emojiBuiltins = []builtin{{wl: Emoji, digest: EmojiSHA256, selectable: true}}
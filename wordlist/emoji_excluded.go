@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_emoji
+
+package wordlist
+
+// emojiBuiltins represents no built-in wordlist, as Emoji is left out of the
+// binary by a build tag.
+var emojiBuiltins []builtin
@@ -0,0 +1,64 @@
+//go:build !diceware_minimal && !diceware_no_emoji
+
+package wordlist_test
+
+import (
+	"math/big"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmojiFetchWord(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		DiceRoll int
+		Value    string
+	}{
+		{
+			Name:     "will return a value from the map",
+			DiceRoll: 111,
+			Value:    "\U0001f400",
+		}, {
+			Name:     "will return the last value from the map",
+			DiceRoll: 666,
+			Value:    "\U0001f31f",
+		}, {
+			Name:     "will return a blank value",
+			DiceRoll: 1,
+			Value:    "",
+		},
+	}
+
+	for _, test := range tests {
+		fetchedValue := wordlist.Emoji.FetchWord(test.DiceRoll)
+		assert.Equal(test.Value, fetchedValue, test.Name)
+	}
+}
+
+func TestEmojiRolls(t *testing.T) {
+	assert.Equal(t, 3, wordlist.Emoji.Rolls(), "Rolls should return 3")
+	assert.Equal(
+		t,
+		big.NewInt(int64(6)),
+		wordlist.Emoji.SidesOfDice(),
+		"SidesOfDice should return 6",
+	)
+}
+
+func TestEmojiWords(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(wordlist.Validate(wordlist.Emoji).Valid())
+	assert.True(wordlist.IsPrefixFree(wordlist.Emoji))
+	assert.Contains(wordlist.Names(), "emoji")
+
+	// every emoji is a single code point without any modifiers
+	for index := 0; index < wordlist.Emoji.Len(); index++ {
+		assert.Equal(1, utf8.RuneCountInString(wordlist.Emoji.WordAt(index)), index)
+	}
+}
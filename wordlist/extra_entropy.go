@@ -3,46 +3,53 @@ package wordlist
 // ExtraEntropy defines a list of characters and numbers in a 2 dice
 // pattern that can be utilized to pull random values that will in turn
 // be used to increase the entropy of other passphrases.
-var ExtraEntropy = NewMap(
+var ExtraEntropy = newLazyMap(
+	"extra-entropy",
 	2,
 	6,
 	// inspiration came from http://diceware.com
-	map[int]string{
-		11: "~",
-		12: "!",
-		13: "@",
-		14: "#",
-		15: "$",
-		16: "%",
-		21: "^",
-		22: "&",
-		23: "*",
-		24: "(",
-		25: ")",
-		26: "-",
-		31: "_",
-		32: "=",
-		33: "+",
-		34: "{",
-		35: "}",
-		36: "[",
-		41: "]",
-		42: "|",
-		43: ".",
-		44: ":",
-		45: ";",
-		46: "/",
-		51: "?",
-		52: ">",
-		53: "<",
-		54: "1",
-		55: "2",
-		56: "3",
-		61: "4",
-		62: "5",
-		63: "6",
-		64: "7",
-		65: "8",
-		66: "9",
+	func() map[int]string {
+		return map[int]string{
+			11: "~",
+			12: "!",
+			13: "@",
+			14: "#",
+			15: "$",
+			16: "%",
+			21: "^",
+			22: "&",
+			23: "*",
+			24: "(",
+			25: ")",
+			26: "-",
+			31: "_",
+			32: "=",
+			33: "+",
+			34: "{",
+			35: "}",
+			36: "[",
+			41: "]",
+			42: "|",
+			43: ".",
+			44: ":",
+			45: ";",
+			46: "/",
+			51: "?",
+			52: ">",
+			53: "<",
+			54: "1",
+			55: "2",
+			56: "3",
+			61: "4",
+			62: "5",
+			63: "6",
+			64: "7",
+			65: "8",
+			66: "9",
+		}
 	},
 )
+
+// ExtraEntropySHA256 represents the SHA-256 digest of the words of
+// ExtraEntropy, as calculated by `Digest` and checked by `VerifyIntegrity`.
+const ExtraEntropySHA256 = "c5ce7f25a854e0b3f76333ace3ac873759079bc1f375dd9f64ad17383f2cf5c7"
@@ -0,0 +1,154 @@
+package wordlist
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// MaxFetchSize represents the largest wordlist in bytes that `Fetch` will
+// download.
+const MaxFetchSize = 16 << 20
+
+var (
+	// ErrInsecureURL represents the error given when a wordlist is fetched from
+	// a URL that does not use HTTPS
+	ErrInsecureURL = errors.New("wordlist URL must use https")
+	// ErrInvalidDigest represents the error given when the expected SHA-256
+	// digest of a wordlist is not 64 hexadecimal characters
+	ErrInvalidDigest = errors.New("invalid SHA-256 digest given")
+	// ErrDigestMismatch represents the error given when the SHA-256 digest of a
+	// fetched wordlist does not match the expected digest
+	ErrDigestMismatch = errors.New("wordlist SHA-256 digest mismatch")
+	// ErrFetchFailed represents the error given when a wordlist is unable to be
+	// downloaded, such as an unsuccessful HTTP status or an oversized body
+	ErrFetchFailed = errors.New("failed to fetch wordlist")
+)
+
+// Fetcher defines a downloader of remote wordlists, which keeps every
+// verified wordlist in memory so that it is only downloaded once.  The zero
+// value is ready to use, and a Fetcher is safe for concurrent use.
+type Fetcher struct {
+	// Client represents the HTTP client used to download the wordlists.  When
+	// nil, `http.DefaultClient` is used.
+	Client *http.Client
+
+	// mu guards the cache.
+	mu sync.Mutex
+
+	// cache represents the verified wordlists, keyed by their URL and digest.
+	cache map[string]*Map
+}
+
+// defaultFetcher represents the Fetcher used by `Fetch`.
+var defaultFetcher = &Fetcher{}
+
+// Fetch returns a Map.
+// Implements the logic required to download the wordlist found at the given
+// HTTPS URL with the default Fetcher, see `Fetcher.Fetch`.
+func Fetch(ctx context.Context, rawURL, sha256hex string) (*Map, error) {
+	return defaultFetcher.Fetch(ctx, rawURL, sha256hex)
+}
+
+// Fetch returns a Map.
+// Implements the logic required to download the wordlist found at the given
+// HTTPS URL, verify that its SHA-256 digest matches the given hexadecimal
+// digest and parse it in the format given by the extension of the URL path,
+// as `LoadFile` does.  Verified wordlists are cached in memory, so fetching
+// the same URL and digest again does not download the wordlist.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL, sha256hex string) (*Map, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme != "https" {
+		return nil, ErrInsecureURL
+	}
+
+	expected, err := hex.DecodeString(sha256hex)
+	if err != nil || len(expected) != sha256.Size {
+		return nil, ErrInvalidDigest
+	}
+
+	key := rawURL + "#" + strings.ToLower(sha256hex)
+	if wl := f.cached(key); wl != nil {
+		return wl, nil
+	}
+
+	body, err := f.download(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := sha256.Sum256(body); !bytes.Equal(digest[:], expected) {
+		return nil, fmt.Errorf("%w: got %x", ErrDigestMismatch, digest)
+	}
+
+	wl, err := parseFile(path.Base(parsed.Path), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.cache == nil {
+		f.cache = map[string]*Map{}
+	}
+
+	f.cache[key] = wl
+
+	return wl, nil
+}
+
+// cached returns a Map.
+// Implements the logic to look up a verified wordlist within the cache,
+// returning nil when it has not been fetched.
+func (f *Fetcher) cached(key string) *Map {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.cache[key]
+}
+
+// download returns a slice of bytes.
+// Implements the logic to read the body of the given URL, up to MaxFetchSize
+// bytes.
+func (f *Fetcher) download(ctx context.Context, rawURL string) ([]byte, error) {
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected status %s", ErrFetchFailed, response.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, MaxFetchSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	if len(body) > MaxFetchSize {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrFetchFailed, MaxFetchSize)
+	}
+
+	return body, nil
+}
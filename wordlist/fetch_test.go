@@ -0,0 +1,89 @@
+package wordlist_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetcherFetch(t *testing.T) {
+	assert := assert.New(t)
+
+	contents := "1\tacid\n2\tcat\n"
+	digest := sha256.Sum256([]byte(contents))
+	valid := hex.EncodeToString(digest[:])
+
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/missing.txt" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte(contents))
+	}))
+	defer server.Close()
+
+	fetcher := &wordlist.Fetcher{Client: server.Client()}
+
+	tests := []struct {
+		Name   string
+		URL    string
+		Digest string
+		Error  error
+	}{
+		{
+			Name:   "will fetch and verify a wordlist",
+			URL:    server.URL + "/custom.txt",
+			Digest: valid,
+		}, {
+			Name:   "will error with a URL not using https",
+			URL:    "http://example.com/custom.txt",
+			Digest: valid,
+			Error:  wordlist.ErrInsecureURL,
+		}, {
+			Name:   "will error with an invalid digest",
+			URL:    server.URL + "/custom.txt",
+			Digest: "abc",
+			Error:  wordlist.ErrInvalidDigest,
+		}, {
+			Name:   "will error when the digest does not match",
+			URL:    server.URL + "/other.txt",
+			Digest: hex.EncodeToString(make([]byte, sha256.Size)),
+			Error:  wordlist.ErrDigestMismatch,
+		}, {
+			Name:   "will error with an unsuccessful status",
+			URL:    server.URL + "/missing.txt",
+			Digest: valid,
+			Error:  wordlist.ErrFetchFailed,
+		}, {
+			Name:   "will parse the wordlist in the format of the URL path",
+			URL:    server.URL + "/custom.json",
+			Digest: valid,
+			Error:  wordlist.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := fetcher.Fetch(context.Background(), test.URL, test.Digest)
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error == nil && assert.NotNil(wl, test.Name) {
+			assert.Equal("custom", wl.Name(), test.Name)
+			assert.Equal("cat", wl.FetchWord(2), test.Name)
+		}
+	}
+
+	// a verified wordlist is cached rather than downloaded again
+	before := requests.Load()
+	_, err := fetcher.Fetch(context.Background(), server.URL+"/custom.txt", valid)
+	assert.NoError(err)
+	assert.Equal(before, requests.Load())
+}
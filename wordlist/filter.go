@@ -0,0 +1,25 @@
+package wordlist
+
+// Filter returns a Slice.
+// Implements the logic required to build a new wordlist from the distinct
+// words of the given wordlist that satisfy the keep predicate, keyed with new
+// roll values in the order of the original roll values.  When the wordlist
+// implements a Name method, the filtered wordlist is named by suffixing its
+// name with "-filtered", so that it is never mistaken for the original.  An
+// error wrapping ErrTooFewWords is returned when fewer than `MinWords` words
+// are kept.
+func Filter(wl Source, keep func(word string) bool) (*Slice, error) {
+	name := ""
+	if named, ok := wl.(interface{ Name() string }); ok && named.Name() != "" {
+		name = named.Name() + "-filtered"
+	}
+
+	words := []string{}
+	for _, word := range uniqueWords(wl) {
+		if keep(word) {
+			words = append(words, word)
+		}
+	}
+
+	return fromWords(name, words)
+}
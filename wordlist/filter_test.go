@@ -0,0 +1,89 @@
+package wordlist_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Source
+		Keep     func(string) bool
+		Words    int
+		Error    error
+	}{
+		{
+			Name:     "will keep every word",
+			Wordlist: wordlist.EFFLong,
+			Keep:     func(string) bool { return true },
+			Words:    7776,
+		}, {
+			Name:     "will keep the words satisfying the predicate",
+			Wordlist: wordlist.EFFLong,
+			Keep:     func(word string) bool { return !strings.Contains(word, "-") },
+			Words:    7772,
+		}, {
+			Name:     "will error when too few words are kept",
+			Wordlist: wordlist.EFFLong,
+			Keep:     func(word string) bool { return len(word) <= 4 },
+			Error:    wordlist.ErrTooFewWords,
+		},
+	}
+
+	for _, test := range tests {
+		filtered, err := wordlist.Filter(test.Wordlist, test.Keep)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			assert.Nil(filtered, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		report := wordlist.Validate(filtered)
+		assert.True(report.Valid(), test.Name)
+		assert.Equal(test.Words, report.Words, test.Name)
+		assert.Equal("eff-long-filtered", filtered.Name(), test.Name)
+		for index := 0; index < filtered.Len(); index++ {
+			assert.True(test.Keep(filtered.WordAt(index)), test.Name)
+		}
+	}
+}
+
+func TestFilterKeepsOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	filtered, err := wordlist.Filter(wordlist.EFFLong, func(word string) bool { return word != "abacus" })
+	assert.NoError(err)
+	assert.Equal(5, filtered.Rolls())
+	assert.Equal("abdomen", filtered.WordAt(0))
+	assert.Equal("zoom", filtered.WordAt(7774))
+}
+
+func TestFilterName(t *testing.T) {
+	assert := assert.New(t)
+
+	words := make([]string, wordlist.MinWords)
+	for index := range words {
+		words[index] = fmt.Sprintf("word%04d", index)
+	}
+
+	wl, err := wordlist.FromSlice(words)
+	if !assert.NoError(err) {
+		return
+	}
+
+	filtered, err := wordlist.Filter(wl, func(string) bool { return true })
+	if assert.NoError(err) {
+		assert.Empty(filtered.Name(), "expected an unnamed wordlist to stay unnamed")
+	}
+}
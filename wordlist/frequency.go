@@ -0,0 +1,77 @@
+package wordlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RareFrequency represents the frequency score below which a word is
+// considered rare, and likely to be hard to remember.  Frequency scores follow
+// the Zipf scale, the base-10 logarithm of the number of times a word is used
+// per billion words, where 3 is a word used once per million words.
+const RareFrequency = 3.0
+
+// Frequencies defines the optional method a wordlist can implement in order to
+// give how commonly each of its words is used, which is only ever used to warn
+// about passphrases that may be hard to remember and never changes which
+// words are rolled.
+type Frequencies interface {
+	// Frequency describes the Zipf frequency score of the given word, reporting
+	// whether a score is known for it
+	Frequency(word string) (float64, bool)
+}
+
+// FrequencyTable defines the Zipf frequency score of each word, for the
+// wordlists whose frequency data exists.
+type FrequencyTable map[string]float64
+
+// Frequency returns a float64 and a bool.
+// It implements the logic for the Frequencies interface which gives the score
+// of the word, matching the word without regard to its case.
+func (t FrequencyTable) Frequency(word string) (float64, bool) {
+	if score, found := t[word]; found {
+		return score, true
+	}
+
+	score, found := t[strings.ToLower(word)]
+
+	return score, found
+}
+
+// ParseFrequencies returns a FrequencyTable.
+// Implements the logic required to read the frequency scores of words, a word
+// followed by whitespace and its Zipf frequency score on each line, e.g.
+// "abacus	2.1".  Blank lines and lines starting with "#" are skipped.
+// Problems within the lines are returned as a *ParseError.
+func ParseFrequencies(r io.Reader) (FrequencyTable, error) {
+	table := FrequencyTable{}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, &ParseError{Line: line, Err: fmt.Errorf("%w: expected a word and a score", ErrInvalidFormat)}
+		}
+
+		score, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || score < 0 {
+			return nil, &ParseError{Line: line, Err: fmt.Errorf("%w: invalid score %q", ErrInvalidFormat, fields[1])}
+		}
+
+		table[fields[0]] = score
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading frequencies: %w", err)
+	}
+
+	return table, nil
+}
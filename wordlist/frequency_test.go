@@ -0,0 +1,72 @@
+package wordlist_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFrequencies(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Input string
+		Value wordlist.FrequencyTable
+		Line  int
+	}{
+		{
+			Name:  "will read the score of each word",
+			Input: "# zipf scores\nabacus\t2.1\n\nzoom 4.5\n",
+			Value: wordlist.FrequencyTable{"abacus": 2.1, "zoom": 4.5},
+		}, {
+			Name:  "will error for a line without a score",
+			Input: "abacus\t2.1\nzoom\n",
+			Line:  2,
+		}, {
+			Name:  "will error for an invalid score",
+			Input: "abacus\tcommon\n",
+			Line:  1,
+		}, {
+			Name:  "will error for a negative score",
+			Input: "abacus\t-1\n",
+			Line:  1,
+		},
+	}
+
+	for _, test := range tests {
+		table, err := wordlist.ParseFrequencies(strings.NewReader(test.Input))
+		if test.Line == 0 {
+			assert.NoError(err, test.Name)
+			assert.Equal(test.Value, table, test.Name)
+			continue
+		}
+
+		var parseErr *wordlist.ParseError
+		if assert.True(errors.As(err, &parseErr), test.Name) {
+			assert.Equal(test.Line, parseErr.Line, test.Name)
+		}
+
+		assert.ErrorIs(err, wordlist.ErrInvalidFormat, test.Name)
+	}
+}
+
+func TestFrequencyTableFrequency(t *testing.T) {
+	assert := assert.New(t)
+
+	table := wordlist.FrequencyTable{"abacus": 2.1}
+
+	score, found := table.Frequency("abacus")
+	assert.True(found)
+	assert.Equal(2.1, score)
+
+	score, found = table.Frequency("Abacus")
+	assert.True(found)
+	assert.Equal(2.1, score)
+
+	_, found = table.Frequency("zoom")
+	assert.False(found)
+}
@@ -0,0 +1,93 @@
+package wordlist
+
+import (
+	"iter"
+	"sync"
+)
+
+// WordIndex defines the lookup from each word of a wordlist to the roll value
+// that fetches it, the reverse of fetching a word, which is read by
+// verification, reverse lookups and checksums.  A WordIndex is immutable and
+// safe for concurrent use.
+type WordIndex struct {
+	// rollValues represents the roll value of each word.
+	rollValues map[string]int
+}
+
+// indexCache defines the WordIndex kept by a wordlist whose words never
+// change, such as the embedded wordlists, so that it is only built once.
+type indexCache struct {
+	// index represents the WordIndex of the wordlist, which is only built once
+	// once has been called.
+	index *WordIndex
+
+	// once represents the guard ensuring the WordIndex is built a single time.
+	once sync.Once
+}
+
+// Index returns a WordIndex.
+// Implements the logic required to build the lookup from every word within the
+// given wordlist to the roll value that fetches it, keeping the lowest roll
+// value of any duplicated words.  The words of a Map or Slice never change, so
+// their WordIndex is built a single time and shared by every call.
+func Index(wl Source) *WordIndex {
+	var cache *indexCache
+	switch cached := wl.(type) {
+	case *Map:
+		cache = cached.cache
+	case *Slice:
+		cache = cached.cache
+	}
+
+	if cache == nil {
+		return buildIndex(wl)
+	}
+
+	cache.once.Do(func() {
+		cache.index = buildIndex(wl)
+	})
+
+	return cache.index
+}
+
+// buildIndex returns a WordIndex.
+// Implements the logic to collect the roll value of every word within the
+// wordlist, keeping the lowest roll value of any duplicated words.
+func buildIndex(wl Source) *WordIndex {
+	index := &WordIndex{rollValues: map[string]int{}}
+	for rollValue, word := range allWords(wl) {
+		if _, found := index.rollValues[word]; !found {
+			index.rollValues[word] = rollValue
+		}
+	}
+
+	return index
+}
+
+// RollFor returns an int and a bool.
+// Implements the logic to look up the roll value that fetches the given word,
+// reporting whether the word is found within the wordlist.  Words are matched
+// exactly, including their case.
+func (idx *WordIndex) RollFor(word string) (int, bool) {
+	rollValue, found := idx.rollValues[word]
+	return rollValue, found
+}
+
+// Len returns an int.
+// Implements the logic to count the distinct words within the wordlist.
+func (idx *WordIndex) Len() int {
+	return len(idx.rollValues)
+}
+
+// All returns an iter.Seq2 of strings and ints.
+// Implements the logic to yield every distinct word within the wordlist
+// alongside its roll value, in no particular order.
+func (idx *WordIndex) All() iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for word, rollValue := range idx.rollValues {
+			if !yield(word, rollValue) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	duplicated := wordlist.NewMap(1, 6, map[int]string{1: "a", 2: "b", 3: "a", 4: "c", 5: "d", 6: "e"})
+
+	tests := []struct {
+		Name      string
+		Wordlist  wordlist.Source
+		Word      string
+		RollValue int
+		Found     bool
+	}{
+		{
+			Name:      "will find the roll value of a word",
+			Wordlist:  wordlist.EFFLong,
+			Word:      "abacus",
+			RollValue: 11111,
+			Found:     true,
+		}, {
+			Name:      "will find the roll value of the last word",
+			Wordlist:  wordlist.EFFLong,
+			Word:      "zoom",
+			RollValue: 66666,
+			Found:     true,
+		}, {
+			Name:      "will keep the lowest roll value of a duplicated word",
+			Wordlist:  duplicated,
+			Word:      "a",
+			RollValue: 1,
+			Found:     true,
+		}, {
+			Name:     "will match the case of the word",
+			Wordlist: wordlist.EFFLong,
+			Word:     "Abacus",
+		}, {
+			Name:     "will not find a word outside of the wordlist",
+			Wordlist: wordlist.EFFLong,
+			Word:     "diceware",
+		},
+	}
+
+	for _, test := range tests {
+		rollValue, found := wordlist.Index(test.Wordlist).RollFor(test.Word)
+		assert.Equal(test.Found, found, test.Name)
+		assert.Equal(test.RollValue, rollValue, test.Name)
+	}
+
+	assert.Equal(5, wordlist.Index(duplicated).Len())
+	assert.Equal(7776, wordlist.Index(wordlist.EFFLong).Len())
+}
+
+func TestIndexCache(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Same(wordlist.Index(wordlist.EFFLong), wordlist.Index(wordlist.EFFLong))
+
+	custom := wordlist.NewMap(1, 2, map[int]string{1: "a", 2: "b"})
+	assert.Same(wordlist.Index(custom), wordlist.Index(custom))
+
+	plain := plainWordlist{1: "a", 2: "b", 3: "c", 4: "d", 5: "e", 6: "f"}
+	assert.NotSame(wordlist.Index(plain), wordlist.Index(plain))
+}
+
+func TestWordIndexAll(t *testing.T) {
+	assert := assert.New(t)
+
+	wl := wordlist.NewMap(1, 2, map[int]string{1: "a", 2: "b"})
+
+	rollValues := map[string]int{}
+	for word, rollValue := range wordlist.Index(wl).All() {
+		rollValues[word] = rollValue
+	}
+
+	assert.Equal(map[string]int{"a": 1, "b": 2}, rollValues)
+}
@@ -0,0 +1,43 @@
+package wordlist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+)
+
+// Digest returns a string.
+// Implements the logic required to calculate the hexadecimal SHA-256 digest of
+// the words of the wordlist, each followed by a newline in ascending order of
+// their roll values, leaving out the roll values without a word.  For the
+// BIP39 English wordlist this is the digest of the published english.txt.
+func Digest(wl Source) string {
+	hash := sha256.New()
+	for _, word := range allWords(wl) {
+		_, _ = io.WriteString(hash, word+"\n")
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// VerifyIntegrity returns an error.
+// Implements the logic required to re-hash the words of every built-in
+// wordlist compiled into the binary and compare them against the SHA-256
+// digest constants, such as EFFLongSHA256, confirming at runtime that the
+// compiled wordlists have not been altered.  An error wrapping
+// ErrDigestMismatch naming the first altered wordlist is returned.
+func VerifyIntegrity() error {
+	sorted := slices.Clone(builtins)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].wl.Name() < sorted[j].wl.Name() })
+
+	for _, expected := range sorted {
+		if digest := Digest(expected.wl); digest != expected.digest {
+			return fmt.Errorf("%w: %s wordlist got %s", ErrDigestMismatch, expected.wl.Name(), digest)
+		}
+	}
+
+	return nil
+}
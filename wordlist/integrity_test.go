@@ -0,0 +1,36 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigest(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Source
+		Value    string
+	}{
+		{
+			Name:     "will hash the words in order of their roll values",
+			Wordlist: wordlist.NewMap(1, 3, map[int]string{2: "b", 1: "a"}),
+			Value:    "911169ddaaf146aff539f58c26c489af3b892dff0fe283c1c264c65ae5aa59a2",
+		}, {
+			Name:     "will match the digest of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Value:    wordlist.EFFLongSHA256,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.Digest(test.Wordlist), test.Name)
+	}
+}
+
+func TestVerifyIntegrity(t *testing.T) {
+	assert.NoError(t, wordlist.VerifyIntegrity())
+}
@@ -0,0 +1,70 @@
+package wordlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// arraySides represents the sides of the dice a wordlist given as an array of
+// words is rolled with.
+const arraySides = 6
+
+// ParseJSON returns a Map.
+// Implements the logic required to read a wordlist encoded as JSON, either as
+// an object of roll values to words, e.g. {"11111": "abacus"}, or as an array
+// of words in ascending order of their roll values, which must hold a word
+// for every roll value of 6-sided dice.  The roll values follow the rules
+// described by `Parse`.
+func ParseJSON(r io.Reader) (*Map, error) {
+	return parseJSON("", r)
+}
+
+// parseJSON returns a Map.
+// Implements the logic of ParseJSON, identifying the Map by the given name.
+func parseJSON(name string, r io.Reader) (*Map, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	builder := newMapBuilder(0)
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		var words []string
+		if err := json.Unmarshal(raw, &words); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+		}
+
+		rolls := 1
+		for combinations(rolls, arraySides) > 0 && combinations(rolls, arraySides) < len(words) {
+			rolls++
+		}
+
+		if combinations(rolls, arraySides) != len(words) {
+			return nil, fmt.Errorf("%w: %d words is not a power of %d", ErrIncomplete, len(words), arraySides)
+		}
+
+		for i, word := range words {
+			if err := builder.add(strconv.Itoa(RollValueForIndex(i, rolls, arraySides)), word); err != nil {
+				return nil, err
+			}
+		}
+
+		return builder.build(name)
+	}
+
+	var words map[string]string
+	if err := json.Unmarshal(raw, &words); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	for rollText, word := range words {
+		if err := builder.add(rollText, word); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.build(name)
+}
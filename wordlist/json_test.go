@@ -0,0 +1,74 @@
+package wordlist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Input string
+		Rolls int
+		Sides int
+		Words map[int]string
+		Error error
+	}{
+		{
+			Name:  "will parse an object of roll values to words",
+			Input: `{"11": "acid", "12": "cat", "21": "horse", "22": "zoom"}`,
+			Rolls: 2,
+			Sides: 2,
+			Words: map[int]string{11: "acid", 12: "cat", 21: "horse", 22: "zoom"},
+		}, {
+			Name:  "will parse an array of words for 6-sided dice",
+			Input: `["acid", "cat", "horse", "zoom", "yodel", "taco"]`,
+			Rolls: 1,
+			Sides: 6,
+			Words: map[int]string{1: "acid", 6: "taco"},
+		}, {
+			Name:  "will error with an array that is not a power of 6",
+			Input: `["acid", "cat", "horse"]`,
+			Error: wordlist.ErrIncomplete,
+		}, {
+			Name:  "will error with an invalid roll value",
+			Input: `{"1x": "acid"}`,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with an empty word",
+			Input: `{"1": ""}`,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with missing roll values",
+			Input: `{"11": "acid", "22": "zoom"}`,
+			Error: wordlist.ErrIncomplete,
+		}, {
+			Name:  "will error with invalid JSON",
+			Input: `{"11": `,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with JSON that is neither an object nor an array",
+			Input: `"acid"`,
+			Error: wordlist.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.ParseJSON(strings.NewReader(test.Input))
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error != nil {
+			continue
+		}
+
+		assert.Equal(test.Rolls, wl.Rolls(), test.Name)
+		assert.Equal(int64(test.Sides), wl.SidesOfDice().Int64(), test.Name)
+		for rollValue, word := range test.Words {
+			assert.Equal(word, wl.FetchWord(rollValue), test.Name)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package wordlist
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LoadFile returns a Map.
+// Implements the logic required to open, parse and validate the wordlist
+// found at the given path, which is read by `ParseJSON` for a ".json" file,
+// `ParseCSV` for a ".csv" file and `Parse` otherwise.  The Map is named after
+// the file, without its extension.  Failures to read the file are returned
+// wrapping the *fs.PathError, while problems with its contents are returned as
+// a *ParseError or wrapping ErrInvalidFormat or ErrIncomplete.
+func LoadFile(path string) (*Map, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening wordlist: %w", err)
+	}
+	defer file.Close()
+
+	return parseFile(filepath.Base(path), file)
+}
+
+// LoadFS returns a Map.
+// Implements the logic required to open, parse and validate the wordlist
+// found at the given path within the file system, such as an `embed.FS`
+// holding custom wordlists, as described by `LoadFile`.  The path follows the
+// rules of `fs.ValidPath`, using forward slashes on every platform.
+func LoadFS(fsys fs.FS, name string) (*Map, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("opening wordlist: %w", err)
+	}
+	defer file.Close()
+
+	return parseFile(path.Base(name), file)
+}
+
+// parseFile returns a Map.
+// Implements the logic to parse the wordlist read from r in the format given
+// by the extension of the file name, naming the Map after the file without
+// its extension.
+func parseFile(fileName string, r io.Reader) (*Map, error) {
+	extension := filepath.Ext(fileName)
+	name := strings.TrimSuffix(fileName, extension)
+
+	switch strings.ToLower(extension) {
+	case ".json":
+		return parseJSON(name, r)
+	case ".csv":
+		return parseCSV(name, r)
+	}
+
+	return ParseNamed(name, r)
+}
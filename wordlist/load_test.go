@@ -0,0 +1,73 @@
+package wordlist_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.txt")
+
+	assert.NoError(os.WriteFile(invalid, []byte("1\tacid\n2\n"), 0o600))
+
+	for name, contents := range map[string]string{
+		"custom.txt":  "1\tacid\n2\tcat\n",
+		"custom.json": `{"1": "acid", "2": "cat"}`,
+		"custom.CSV":  "roll,word\n1,acid\n2,cat\n",
+	} {
+		path := filepath.Join(dir, name)
+		assert.NoError(os.WriteFile(path, []byte(contents), 0o600), name)
+
+		wl, err := wordlist.LoadFile(path)
+		if assert.NoError(err, name) {
+			assert.Equal("custom", wl.Name(), name)
+			assert.Equal("cat", wl.FetchWord(2), name)
+		}
+	}
+
+	_, err := wordlist.LoadFile(filepath.Join(dir, "missing.txt"))
+	var pathErr *fs.PathError
+	assert.True(errors.As(err, &pathErr))
+	assert.ErrorIs(err, fs.ErrNotExist)
+
+	_, err = wordlist.LoadFile(invalid)
+	var parseErr *wordlist.ParseError
+	assert.True(errors.As(err, &parseErr))
+	assert.ErrorIs(err, wordlist.ErrInvalidFormat)
+}
+
+func TestLoadFS(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"lists/custom.txt":  {Data: []byte("1\tacid\n2\tcat\n")},
+		"lists/custom.json": {Data: []byte(`{"1": "acid", "2": "cat"}`)},
+		"lists/invalid.csv": {Data: []byte("roll,word\n1,acid\n3,cat\n")},
+	}
+
+	for _, name := range []string{"lists/custom.txt", "lists/custom.json"} {
+		wl, err := wordlist.LoadFS(fsys, name)
+		if assert.NoError(err, name) {
+			assert.Equal("custom", wl.Name(), name)
+			assert.Equal("cat", wl.FetchWord(2), name)
+		}
+	}
+
+	_, err := wordlist.LoadFS(fsys, "lists/missing.txt")
+	var pathErr *fs.PathError
+	assert.True(errors.As(err, &pathErr))
+	assert.ErrorIs(err, fs.ErrNotExist)
+
+	_, err = wordlist.LoadFS(fsys, "lists/invalid.csv")
+	assert.ErrorIs(err, wordlist.ErrIncomplete)
+}
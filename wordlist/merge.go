@@ -0,0 +1,83 @@
+package wordlist
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MinWords represents the fewest distinct words a wordlist built from words,
+// rather than from dice rolls, is allowed to have, which provides at least 10
+// bits of entropy per word while allowing a few words to be removed from the
+// EFF short wordlist.
+const MinWords = 1024
+
+// ErrTooFewWords represents the error given when a wordlist would be built
+// from fewer than `MinWords` distinct words
+var ErrTooFewWords = errors.New("too few words for a secure wordlist")
+
+// physicalDice represents the sides of the common physical dice, in order of
+// preference, used to key a wordlist built from words.
+var physicalDice = []int{D6, D8, D10, D12, D20, D4}
+
+// Merge returns a Slice.
+// Implements the logic required to combine the words of every given wordlist
+// into a single wordlist, removing empty and duplicated words, sorting the
+// remaining words and keying them with new roll values as `FromSlice` does.
+// The merged wordlist is named by joining the names of the wordlists
+// implementing a Name method with "+".  An error wrapping ErrTooFewWords is
+// returned when fewer than `MinWords` distinct words remain.  The entropy per
+// word of the merged wordlist is given by its Entropy method.
+func Merge(lists ...Source) (*Slice, error) {
+	names := []string{}
+	seen := map[string]bool{}
+	words := []string{}
+	for _, wl := range lists {
+		if named, ok := wl.(interface{ Name() string }); ok && named.Name() != "" {
+			names = append(names, named.Name())
+		}
+
+		for _, word := range uniqueWords(wl) {
+			if !seen[word] {
+				seen[word] = true
+				words = append(words, word)
+			}
+		}
+	}
+
+	sort.Strings(words)
+
+	return fromWords(strings.Join(names, "+"), words)
+}
+
+// fromWords returns a Slice.
+// Implements the logic to key the given distinct words with the roll values
+// of the dice chosen by `geometryFor`, in the order given.
+func fromWords(name string, words []string) (*Slice, error) {
+	if len(words) < MinWords {
+		return nil, fmt.Errorf("%w: %d of %d", ErrTooFewWords, len(words), MinWords)
+	}
+
+	return newSlice(name, append([]string(nil), words...)), nil
+}
+
+// geometryFor returns the number of rolls and the sides of the dice.
+// Implements the logic to choose the common physical dice with the fewest roll
+// values covering n words, preferring the dice listed first whenever several
+// have as many roll values.
+func geometryFor(n int) (int, int) {
+	bestRolls, bestSides, best := 0, 0, 0
+	for _, sides := range physicalDice {
+		rolls := 1
+		for combinations(rolls, sides) > 0 && combinations(rolls, sides) < n {
+			rolls++
+		}
+
+		if total := combinations(rolls, sides); total > 0 && (best == 0 || total < best) {
+			bestRolls, bestSides, best = rolls, sides, total
+		}
+	}
+
+	return bestRolls, bestSides
+}
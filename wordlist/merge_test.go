@@ -0,0 +1,101 @@
+package wordlist_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// domainWordlist returns a Map with the given number of distinct words that
+// are not found within any of the built-in wordlists.
+func domainWordlist(count int) *wordlist.Map {
+	words := map[int]string{}
+	for index := 0; index < count; index++ {
+		words[index+1] = fmt.Sprintf("domain%04d", index)
+	}
+
+	return wordlist.NewNamedMap("domain", 1, count, words)
+}
+
+func TestMerge(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		Wordlists []wordlist.Source
+		WordName  string
+		Rolls     int
+		Sides     int64
+		Entropy   float64
+		Error     error
+	}{
+		{
+			Name:      "will key the words with the dice rolling exactly as many words",
+			Wordlists: []wordlist.Source{wordlist.EFFLong, domainWordlist(224)},
+			WordName:  "eff-long+domain",
+			Rolls:     3,
+			Sides:     20,
+			Entropy:   12.9658,
+		}, {
+			Name:      "will key the words with the dice rejecting the fewest rolls",
+			Wordlists: []wordlist.Source{wordlist.EFFLong, domainWordlist(100)},
+			WordName:  "eff-long+domain",
+			Rolls:     3,
+			Sides:     20,
+			Entropy:   12.9433,
+		}, {
+			Name:      "will remove the duplicated words",
+			Wordlists: []wordlist.Source{wordlist.EFFLong, wordlist.EFFLong},
+			WordName:  "eff-long+eff-long",
+			Rolls:     5,
+			Sides:     6,
+			Entropy:   12.9248,
+		}, {
+			Name:      "will ignore the missing words and unnamed wordlists",
+			Wordlists: []wordlist.Source{wordlist.EFFLong, wordlist.NewMap(1, 6, map[int]string{1: "acid"})},
+			WordName:  "eff-long",
+			Rolls:     5,
+			Sides:     6,
+			Entropy:   12.9248,
+		}, {
+			Name:      "will error with too few words",
+			Wordlists: []wordlist.Source{domainWordlist(1023)},
+			Error:     wordlist.ErrTooFewWords,
+		}, {
+			Name:  "will error without any wordlists",
+			Error: wordlist.ErrTooFewWords,
+		},
+	}
+
+	for _, test := range tests {
+		merged, err := wordlist.Merge(test.Wordlists...)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			assert.Nil(merged, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Equal(test.WordName, merged.Name(), test.Name)
+		assert.Equal(test.Rolls, merged.Rolls(), test.Name)
+		assert.Equal(test.Sides, merged.SidesOfDice().Int64(), test.Name)
+		assert.InDelta(test.Entropy, merged.Entropy(), 0.0001, test.Name)
+		assert.True(wordlist.Validate(merged).Valid(), test.Name)
+	}
+}
+
+func TestMergeKeepsEveryWord(t *testing.T) {
+	assert := assert.New(t)
+
+	merged, err := wordlist.Merge(wordlist.EFFLong, domainWordlist(224))
+	assert.NoError(err)
+	assert.Equal(8000, wordlist.Validate(merged).Words)
+	assert.Equal("abacus", merged.FetchWord(10101))
+	assert.Equal("zoom", merged.FetchWord(202020))
+	assert.Contains(wordlist.PrefixViolations(merged), wordlist.PrefixViolation{Prefix: "domain", Word: "domain0223"})
+}
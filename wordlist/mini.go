@@ -0,0 +1,32 @@
+//go:build !diceware_minimal && !diceware_no_mini
+
+package wordlist
+
+import _ "embed"
+
+// miniData represents the gzip compressed words of Mini, one on each line in
+// ascending order of their roll values.
+//
+//go:embed data/mini.txt.gz
+var miniData []byte
+
+// Mini defines a 4 dice word list of 1296 very common English words between 3
+// and 5 letters, selected from the words shared by the other built-in
+// wordlists, for low stakes passphrases that must be easy to remember and
+// type, such as guest WiFi passwords.  Each word provides only 10.34 bits of
+// entropy, compared to the 12.92 bits of a word from the EFF long wordlist, so
+// Mini passphrases need 5 words to match 4 words from the EFF long wordlist.
+var Mini = newEmbeddedMap(
+	"mini",
+	4,
+	6,
+	miniData,
+)
+
+// MiniSHA256 represents the SHA-256 digest of the words of Mini, as calculated
+// by `Digest` and checked by `VerifyIntegrity`.
+const MiniSHA256 = "2608b69eb253b546491f71b933f211fe40dbe086a4a1deff41ca966dab303c78"
+
+// miniBuiltins represents Mini as a built-in wordlist, along with its
+// expected digest.
+var miniBuiltins = []builtin{{wl: Mini, digest: MiniSHA256, selectable: true}}
@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_mini
+
+package wordlist
+
+// miniBuiltins represents no built-in wordlist, as Mini is left out of the
+// binary by a build tag.
+var miniBuiltins []builtin
@@ -0,0 +1,67 @@
+//go:build !diceware_minimal && !diceware_no_mini
+
+package wordlist_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiniFetchWord(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		DiceRoll int
+		Value    string
+	}{
+		{
+			Name:     "will return a value from the map",
+			DiceRoll: 1111,
+			Value:    "able",
+		}, {
+			Name:     "will return the last value from the map",
+			DiceRoll: 6666,
+			Value:    "zoom",
+		}, {
+			Name:     "will return a blank value",
+			DiceRoll: 1,
+			Value:    "",
+		},
+	}
+
+	for _, test := range tests {
+		fetchedValue := wordlist.Mini.FetchWord(test.DiceRoll)
+		assert.Equal(test.Value, fetchedValue, test.Name)
+	}
+}
+
+func TestMiniRolls(t *testing.T) {
+	assert.Equal(t, 4, wordlist.Mini.Rolls(), "Rolls should return 4")
+	assert.Equal(
+		t,
+		big.NewInt(int64(6)),
+		wordlist.Mini.SidesOfDice(),
+		"SidesOfDice should return 6",
+	)
+}
+
+func TestMiniWords(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(wordlist.Validate(wordlist.Mini).Valid())
+	assert.InDelta(10.3399, wordlist.Mini.Entropy(), 0.0001)
+
+	for index := 0; index < wordlist.Mini.Len(); index++ {
+		word := wordlist.Mini.WordAt(index)
+		assert.True(len(word) >= 3 && len(word) <= 5, word)
+		assert.False(wordlist.IsOffensive(word), word)
+	}
+}
+
+func TestMiniEmbedded(t *testing.T) {
+	assertEmbedded(t, wordlist.Mini, "mini", 1296, "able", "zoom")
+}
@@ -0,0 +1,46 @@
+package wordlist
+
+import "strings"
+
+// offensiveWords represents the words considered inappropriate for customer
+// facing passphrases, such as recovery phrases, covering profanity, slurs,
+// sexual and bodily terms, violence, death and drugs.  The set is
+// intentionally conservative, removing words that are harmless alone but
+// produce awkward combinations.
+var offensiveWords = map[string]bool{
+	"abort": true, "abuse": true, "addict": true, "arson": true, "ass": true, "assault": true,
+	"bastard": true, "bigot": true, "bitch": true, "bloody": true, "bomb": true, "booze": true,
+	"breast": true, "bullet": true, "butt": true, "casket": true, "cocaine": true, "coffin": true,
+	"corpse": true, "crack": true, "crap": true, "crotch": true, "cult": true, "damn": true,
+	"dead": true, "death": true, "die": true, "drunk": true, "dung": true, "erotic": true,
+	"execute": true, "fart": true, "feces": true, "fetish": true, "gonad": true, "gun": true,
+	"gunfire": true, "gunman": true, "gunshot": true, "hell": true, "heroin": true, "hitler": true,
+	"homicide": true, "hooker": true, "horny": true, "hostage": true, "junkie": true, "kill": true,
+	"killer": true, "kinky": true, "kkk": true, "knife": true, "lewd": true, "lust": true,
+	"massacre": true, "meth": true, "molest": true, "murder": true, "naked": true, "nazi": true,
+	"nude": true, "opium": true, "orgy": true, "pee": true, "penis": true, "pimp": true,
+	"piss": true, "poop": true, "porn": true, "pot": true, "prison": true, "puke": true,
+	"racism": true, "racist": true, "rape": true, "rapist": true, "rifle": true, "sadist": true,
+	"satan": true, "scum": true, "semen": true, "sex": true, "sexy": true, "shit": true,
+	"slaughter": true, "slut": true, "smut": true, "sniper": true, "sperm": true, "stab": true,
+	"strangle": true, "suicide": true, "terror": true, "terrorist": true, "testicle": true, "thug": true,
+	"toilet": true, "torture": true, "urine": true, "vomit": true, "weed": true, "whore": true,
+	"wino": true,
+}
+
+// IsOffensive returns a bool.
+// Implements the logic to check whether the word, ignoring its case, is
+// considered inappropriate for customer facing passphrases.
+func IsOffensive(word string) bool {
+	return offensiveWords[strings.ToLower(word)]
+}
+
+// FilterOffensive returns a Slice.
+// Implements the logic required to build a new wordlist from the given
+// wordlist without any of the words considered inappropriate for customer
+// facing passphrases, such as recovery phrases given out by banks or schools.
+// The filter is optional, as removing words slightly reduces the entropy of
+// each word rolled from the wordlist.
+func FilterOffensive(wl Source) (*Slice, error) {
+	return Filter(wl, func(word string) bool { return !IsOffensive(word) })
+}
@@ -0,0 +1,68 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOffensive(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Word  string
+		Value bool
+	}{
+		{
+			Name:  "will flag an offensive word",
+			Word:  "arson",
+			Value: true,
+		}, {
+			Name:  "will flag an offensive word regardless of case",
+			Word:  "Arson",
+			Value: true,
+		}, {
+			Name:  "will not flag an appropriate word",
+			Word:  "acid",
+			Value: false,
+		}, {
+			Name:  "will not flag a word containing an offensive word",
+			Word:  "potato",
+			Value: false,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.IsOffensive(test.Word), test.Name)
+	}
+}
+
+func TestFilterOffensive(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist *wordlist.Map
+		Words    int
+	}{
+		{
+			Name:     "will remove the offensive words of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Words:    7768,
+		},
+	}
+
+	for _, test := range tests {
+		filtered, err := wordlist.FilterOffensive(test.Wordlist)
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Equal(test.Words, wordlist.Validate(filtered).Words, test.Name)
+		for index := 0; index < filtered.Len(); index++ {
+			assert.False(wordlist.IsOffensive(filtered.WordAt(index)), test.Name)
+		}
+	}
+}
@@ -1,7787 +1,29 @@
+//go:build !diceware_minimal && !diceware_no_original
+
 package wordlist
 
+import _ "embed"
+
+// originalData represents the gzip compressed words of Original, one on each
+// line in ascending order of their roll values.
+//
+//go:embed data/original.txt.gz
+var originalData []byte
+
 // Original defines the original 5 dice word list to be utilized for
 // creating phrases for the diceware algorithm.
-var Original = NewMap(
+var Original = newEmbeddedMap(
+	"original",
 	5,
 	6,
 	// http://diceware.com
-	map[int]string{
-		11111: "a",
-		11112: "a&p",
-		11113: "a's",
-		11114: "aa",
-		11115: "aaa",
-		11116: "aaaa",
-		11121: "aaron",
-		11122: "ab",
-		11123: "aba",
-		11124: "ababa",
-		11125: "aback",
-		11126: "abase",
-		11131: "abash",
-		11132: "abate",
-		11133: "abbas",
-		11134: "abbe",
-		11135: "abbey",
-		11136: "abbot",
-		11141: "abbott",
-		11142: "abc",
-		11143: "abe",
-		11144: "abed",
-		11145: "abel",
-		11146: "abet",
-		11151: "abide",
-		11152: "abject",
-		11153: "ablaze",
-		11154: "able",
-		11155: "abner",
-		11156: "abo",
-		11161: "abode",
-		11162: "abort",
-		11163: "about",
-		11164: "above",
-		11165: "abrade",
-		11166: "abram",
-		11211: "absorb",
-		11212: "abuse",
-		11213: "abut",
-		11214: "abyss",
-		11215: "ac",
-		11216: "acadia",
-		11221: "accra",
-		11222: "accrue",
-		11223: "ace",
-		11224: "acetic",
-		11225: "ache",
-		11226: "acid",
-		11231: "acidic",
-		11232: "acm",
-		11233: "acme",
-		11234: "acorn",
-		11235: "acre",
-		11236: "acrid",
-		11241: "act",
-		11242: "acton",
-		11243: "actor",
-		11244: "acts",
-		11245: "acuity",
-		11246: "acute",
-		11251: "ad",
-		11252: "ada",
-		11253: "adage",
-		11254: "adagio",
-		11255: "adair",
-		11256: "adam",
-		11261: "adams",
-		11262: "adapt",
-		11263: "add",
-		11264: "added",
-		11265: "addict",
-		11266: "addis",
-		11311: "addle",
-		11312: "adele",
-		11313: "aden",
-		11314: "adept",
-		11315: "adieu",
-		11316: "adjust",
-		11321: "adler",
-		11322: "admit",
-		11323: "admix",
-		11324: "ado",
-		11325: "adobe",
-		11326: "adonis",
-		11331: "adopt",
-		11332: "adore",
-		11333: "adorn",
-		11334: "adult",
-		11335: "advent",
-		11336: "advert",
-		11341: "advise",
-		11342: "ae",
-		11343: "aegis",
-		11344: "aeneid",
-		11345: "af",
-		11346: "afar",
-		11351: "affair",
-		11352: "affine",
-		11353: "affix",
-		11354: "afire",
-		11355: "afoot",
-		11356: "afraid",
-		11361: "africa",
-		11362: "afro",
-		11363: "aft",
-		11364: "ag",
-		11365: "again",
-		11366: "agate",
-		11411: "agave",
-		11412: "age",
-		11413: "agee",
-		11414: "agenda",
-		11415: "agent",
-		11416: "agile",
-		11421: "aging",
-		11422: "agnes",
-		11423: "agnew",
-		11424: "ago",
-		11425: "agone",
-		11426: "agony",
-		11431: "agree",
-		11432: "ague",
-		11433: "agway",
-		11434: "ah",
-		11435: "ahead",
-		11436: "ahem",
-		11441: "ahoy",
-		11442: "ai",
-		11443: "aid",
-		11444: "aida",
-		11445: "aide",
-		11446: "aides",
-		11451: "aiken",
-		11452: "ail",
-		11453: "aile",
-		11454: "aim",
-		11455: "ain't",
-		11456: "ainu",
-		11461: "air",
-		11462: "aires",
-		11463: "airman",
-		11464: "airway",
-		11465: "airy",
-		11466: "aisle",
-		11511: "aj",
-		11512: "ajar",
-		11513: "ajax",
-		11514: "ak",
-		11515: "akers",
-		11516: "akin",
-		11521: "akron",
-		11522: "al",
-		11523: "ala",
-		11524: "alai",
-		11525: "alamo",
-		11526: "alan",
-		11531: "alarm",
-		11532: "alaska",
-		11533: "alb",
-		11534: "alba",
-		11535: "album",
-		11536: "alcoa",
-		11541: "alden",
-		11542: "alder",
-		11543: "ale",
-		11544: "alec",
-		11545: "aleck",
-		11546: "aleph",
-		11551: "alert",
-		11552: "alex",
-		11553: "alexei",
-		11554: "alga",
-		11555: "algae",
-		11556: "algal",
-		11561: "alger",
-		11562: "algol",
-		11563: "ali",
-		11564: "alia",
-		11565: "alias",
-		11566: "alibi",
-		11611: "alice",
-		11612: "alien",
-		11613: "alight",
-		11614: "align",
-		11615: "alike",
-		11616: "alive",
-		11621: "all",
-		11622: "allah",
-		11623: "allan",
-		11624: "allay",
-		11625: "allen",
-		11626: "alley",
-		11631: "allied",
-		11632: "allis",
-		11633: "allot",
-		11634: "allow",
-		11635: "alloy",
-		11636: "allure",
-		11641: "ally",
-		11642: "allyl",
-		11643: "allyn",
-		11644: "alma",
-		11645: "almost",
-		11646: "aloe",
-		11651: "aloft",
-		11652: "aloha",
-		11653: "alone",
-		11654: "along",
-		11655: "aloof",
-		11656: "aloud",
-		11661: "alp",
-		11662: "alpha",
-		11663: "alps",
-		11664: "also",
-		11665: "alsop",
-		11666: "altair",
-		12111: "altar",
-		12112: "alter",
-		12113: "alto",
-		12114: "alton",
-		12115: "alum",
-		12116: "alumni",
-		12121: "alva",
-		12122: "alvin",
-		12123: "alway",
-		12124: "am",
-		12125: "ama",
-		12126: "amass",
-		12131: "amaze",
-		12132: "amber",
-		12133: "amble",
-		12134: "ambush",
-		12135: "amen",
-		12136: "amend",
-		12141: "ames",
-		12142: "ami",
-		12143: "amid",
-		12144: "amide",
-		12145: "amigo",
-		12146: "amino",
-		12151: "amiss",
-		12152: "amity",
-		12153: "amman",
-		12154: "ammo",
-		12155: "amoco",
-		12156: "amok",
-		12161: "among",
-		12162: "amort",
-		12163: "amos",
-		12164: "amp",
-		12165: "ampere",
-		12166: "ampex",
-		12211: "ample",
-		12212: "amply",
-		12213: "amra",
-		12214: "amulet",
-		12215: "amuse",
-		12216: "amy",
-		12221: "an",
-		12222: "ana",
-		12223: "and",
-		12224: "andes",
-		12225: "andre",
-		12226: "andrew",
-		12231: "andy",
-		12232: "anent",
-		12233: "anew",
-		12234: "angel",
-		12235: "angelo",
-		12236: "anger",
-		12241: "angie",
-		12242: "angle",
-		12243: "anglo",
-		12244: "angola",
-		12245: "angry",
-		12246: "angst",
-		12251: "angus",
-		12252: "ani",
-		12253: "anion",
-		12254: "anise",
-		12255: "anita",
-		12256: "ankle",
-		12261: "ann",
-		12262: "anna",
-		12263: "annal",
-		12264: "anne",
-		12265: "annex",
-		12266: "annie",
-		12311: "annoy",
-		12312: "annul",
-		12313: "annuli",
-		12314: "annum",
-		12315: "anode",
-		12316: "ansi",
-		12321: "answer",
-		12322: "ant",
-		12323: "ante",
-		12324: "anti",
-		12325: "antic",
-		12326: "anton",
-		12331: "anus",
-		12332: "anvil",
-		12333: "any",
-		12334: "anyhow",
-		12335: "anyway",
-		12336: "ao",
-		12341: "aok",
-		12342: "aorta",
-		12343: "ap",
-		12344: "apart",
-		12345: "apathy",
-		12346: "ape",
-		12351: "apex",
-		12352: "aphid",
-		12353: "aplomb",
-		12354: "appeal",
-		12355: "append",
-		12356: "apple",
-		12361: "apply",
-		12362: "april",
-		12363: "apron",
-		12364: "apse",
-		12365: "apt",
-		12366: "aq",
-		12411: "aqua",
-		12412: "ar",
-		12413: "arab",
-		12414: "araby",
-		12415: "arc",
-		12416: "arcana",
-		12421: "arch",
-		12422: "archer",
-		12423: "arden",
-		12424: "ardent",
-		12425: "are",
-		12426: "area",
-		12431: "arena",
-		12432: "ares",
-		12433: "argive",
-		12434: "argo",
-		12435: "argon",
-		12436: "argot",
-		12441: "argue",
-		12442: "argus",
-		12443: "arhat",
-		12444: "arid",
-		12445: "aries",
-		12446: "arise",
-		12451: "ark",
-		12452: "arlen",
-		12453: "arlene",
-		12454: "arm",
-		12455: "armco",
-		12456: "army",
-		12461: "arnold",
-		12462: "aroma",
-		12463: "arose",
-		12464: "arpa",
-		12465: "array",
-		12466: "arrear",
-		12511: "arrow",
-		12512: "arson",
-		12513: "art",
-		12514: "artery",
-		12515: "arthur",
-		12516: "artie",
-		12521: "arty",
-		12522: "aruba",
-		12523: "arum",
-		12524: "aryl",
-		12525: "as",
-		12526: "ascend",
-		12531: "ash",
-		12532: "ashen",
-		12533: "asher",
-		12534: "ashley",
-		12535: "ashy",
-		12536: "asia",
-		12541: "aside",
-		12542: "ask",
-		12543: "askew",
-		12544: "asleep",
-		12545: "aspen",
-		12546: "aspire",
-		12551: "ass",
-		12552: "assai",
-		12553: "assam",
-		12554: "assay",
-		12555: "asset",
-		12556: "assort",
-		12561: "assure",
-		12562: "aster",
-		12563: "astm",
-		12564: "astor",
-		12565: "astral",
-		12566: "at",
-		12611: "at&t",
-		12612: "ate",
-		12613: "athens",
-		12614: "atlas",
-		12615: "atom",
-		12616: "atomic",
-		12621: "atone",
-		12622: "atop",
-		12623: "attic",
-		12624: "attire",
-		12625: "au",
-		12626: "aubrey",
-		12631: "audio",
-		12632: "audit",
-		12633: "aug",
-		12634: "auger",
-		12635: "augur",
-		12636: "august",
-		12641: "auk",
-		12642: "aunt",
-		12643: "aura",
-		12644: "aural",
-		12645: "auric",
-		12646: "austin",
-		12651: "auto",
-		12652: "autumn",
-		12653: "av",
-		12654: "avail",
-		12655: "ave",
-		12656: "aver",
-		12661: "avert",
-		12662: "avery",
-		12663: "aviate",
-		12664: "avid",
-		12665: "avis",
-		12666: "aviv",
-		13111: "avoid",
-		13112: "avon",
-		13113: "avow",
-		13114: "aw",
-		13115: "await",
-		13116: "awake",
-		13121: "award",
-		13122: "aware",
-		13123: "awash",
-		13124: "away",
-		13125: "awe",
-		13126: "awful",
-		13131: "awl",
-		13132: "awn",
-		13133: "awoke",
-		13134: "awry",
-		13135: "ax",
-		13136: "axe",
-		13141: "axes",
-		13142: "axial",
-		13143: "axiom",
-		13144: "axis",
-		13145: "axle",
-		13146: "axon",
-		13151: "ay",
-		13152: "aye",
-		13153: "ayers",
-		13154: "az",
-		13155: "aztec",
-		13156: "azure",
-		13161: "b",
-		13162: "b's",
-		13163: "ba",
-		13164: "babe",
-		13165: "babel",
-		13166: "baby",
-		13211: "bach",
-		13212: "back",
-		13213: "backup",
-		13214: "bacon",
-		13215: "bad",
-		13216: "bade",
-		13221: "baden",
-		13222: "badge",
-		13223: "baffle",
-		13224: "bag",
-		13225: "baggy",
-		13226: "bah",
-		13231: "bahama",
-		13232: "bail",
-		13233: "baird",
-		13234: "bait",
-		13235: "bake",
-		13236: "baku",
-		13241: "bald",
-		13242: "baldy",
-		13243: "bale",
-		13244: "bali",
-		13245: "balk",
-		13246: "balkan",
-		13251: "balky",
-		13252: "ball",
-		13253: "balled",
-		13254: "ballot",
-		13255: "balm",
-		13256: "balmy",
-		13261: "balsa",
-		13262: "bam",
-		13263: "bambi",
-		13264: "ban",
-		13265: "banal",
-		13266: "band",
-		13311: "bandit",
-		13312: "bandy",
-		13313: "bane",
-		13314: "bang",
-		13315: "banish",
-		13316: "banjo",
-		13321: "bank",
-		13322: "banks",
-		13323: "bantu",
-		13324: "bar",
-		13325: "barb",
-		13326: "bard",
-		13331: "bare",
-		13332: "barfly",
-		13333: "barge",
-		13334: "bark",
-		13335: "barley",
-		13336: "barn",
-		13341: "barnes",
-		13342: "baron",
-		13343: "barony",
-		13344: "barr",
-		13345: "barre",
-		13346: "barry",
-		13351: "barter",
-		13352: "barth",
-		13353: "barton",
-		13354: "basal",
-		13355: "base",
-		13356: "basel",
-		13361: "bash",
-		13362: "basic",
-		13363: "basil",
-		13364: "basin",
-		13365: "basis",
-		13366: "bask",
-		13411: "bass",
-		13412: "bassi",
-		13413: "basso",
-		13414: "baste",
-		13415: "bat",
-		13416: "batch",
-		13421: "bate",
-		13422: "bater",
-		13423: "bates",
-		13424: "bath",
-		13425: "bathe",
-		13426: "batik",
-		13431: "baton",
-		13432: "bator",
-		13433: "batt",
-		13434: "bauble",
-		13435: "baud",
-		13436: "bauer",
-		13441: "bawd",
-		13442: "bawdy",
-		13443: "bawl",
-		13444: "baxter",
-		13445: "bay",
-		13446: "bayda",
-		13451: "bayed",
-		13452: "bayou",
-		13453: "bazaar",
-		13454: "bb",
-		13455: "bbb",
-		13456: "bbbb",
-		13461: "bc",
-		13462: "bcd",
-		13463: "bd",
-		13464: "be",
-		13465: "beach",
-		13466: "bead",
-		13511: "beady",
-		13512: "beak",
-		13513: "beam",
-		13514: "bean",
-		13515: "bear",
-		13516: "beard",
-		13521: "beast",
-		13522: "beat",
-		13523: "beau",
-		13524: "beauty",
-		13525: "beaux",
-		13526: "bebop",
-		13531: "becalm",
-		13532: "beck",
-		13533: "becker",
-		13534: "becky",
-		13535: "bed",
-		13536: "bedim",
-		13541: "bee",
-		13542: "beebe",
-		13543: "beech",
-		13544: "beef",
-		13545: "beefy",
-		13546: "been",
-		13551: "beep",
-		13552: "beer",
-		13553: "beet",
-		13554: "befall",
-		13555: "befit",
-		13556: "befog",
-		13561: "beg",
-		13562: "began",
-		13563: "beget",
-		13564: "beggar",
-		13565: "begin",
-		13566: "begun",
-		13611: "behind",
-		13612: "beige",
-		13613: "being",
-		13614: "beirut",
-		13615: "bel",
-		13616: "bela",
-		13621: "belch",
-		13622: "belfry",
-		13623: "belie",
-		13624: "bell",
-		13625: "bella",
-		13626: "belle",
-		13631: "belly",
-		13632: "below",
-		13633: "belt",
-		13634: "bema",
-		13635: "beman",
-		13636: "bemoan",
-		13641: "ben",
-		13642: "bench",
-		13643: "bend",
-		13644: "bender",
-		13645: "benny",
-		13646: "bent",
-		13651: "benz",
-		13652: "berea",
-		13653: "bereft",
-		13654: "beret",
-		13655: "berg",
-		13656: "berlin",
-		13661: "bern",
-		13662: "berne",
-		13663: "bernet",
-		13664: "berra",
-		13665: "berry",
-		13666: "bert",
-		14111: "berth",
-		14112: "beryl",
-		14113: "beset",
-		14114: "bess",
-		14115: "bessel",
-		14116: "best",
-		14121: "bestir",
-		14122: "bet",
-		14123: "beta",
-		14124: "betel",
-		14125: "beth",
-		14126: "bethel",
-		14131: "betsy",
-		14132: "bette",
-		14133: "betty",
-		14134: "bevel",
-		14135: "bevy",
-		14136: "beware",
-		14141: "bey",
-		14142: "bezel",
-		14143: "bf",
-		14144: "bg",
-		14145: "bh",
-		14146: "bhoy",
-		14151: "bi",
-		14152: "bias",
-		14153: "bib",
-		14154: "bibb",
-		14155: "bible",
-		14156: "bicep",
-		14161: "biceps",
-		14162: "bid",
-		14163: "biddy",
-		14164: "bide",
-		14165: "bien",
-		14166: "big",
-		14211: "biggs",
-		14212: "bigot",
-		14213: "bile",
-		14214: "bilge",
-		14215: "bilk",
-		14216: "bill",
-		14221: "billow",
-		14222: "billy",
-		14223: "bin",
-		14224: "binary",
-		14225: "bind",
-		14226: "bing",
-		14231: "binge",
-		14232: "bingle",
-		14233: "bini",
-		14234: "biota",
-		14235: "birch",
-		14236: "bird",
-		14241: "birdie",
-		14242: "birth",
-		14243: "bison",
-		14244: "bisque",
-		14245: "bit",
-		14246: "bitch",
-		14251: "bite",
-		14252: "bitt",
-		14253: "bitten",
-		14254: "biz",
-		14255: "bizet",
-		14256: "bj",
-		14261: "bk",
-		14262: "bl",
-		14263: "blab",
-		14264: "black",
-		14265: "blade",
-		14266: "blair",
-		14311: "blake",
-		14312: "blame",
-		14313: "blanc",
-		14314: "bland",
-		14315: "blank",
-		14316: "blare",
-		14321: "blast",
-		14322: "blat",
-		14323: "blatz",
-		14324: "blaze",
-		14325: "bleak",
-		14326: "bleat",
-		14331: "bled",
-		14332: "bleed",
-		14333: "blend",
-		14334: "bless",
-		14335: "blest",
-		14336: "blew",
-		14341: "blimp",
-		14342: "blind",
-		14343: "blink",
-		14344: "blinn",
-		14345: "blip",
-		14346: "bliss",
-		14351: "blithe",
-		14352: "blitz",
-		14353: "bloat",
-		14354: "blob",
-		14355: "bloc",
-		14356: "bloch",
-		14361: "block",
-		14362: "bloke",
-		14363: "blond",
-		14364: "blonde",
-		14365: "blood",
-		14366: "bloom",
-		14411: "bloop",
-		14412: "blot",
-		14413: "blotch",
-		14414: "blow",
-		14415: "blown",
-		14416: "blue",
-		14421: "bluet",
-		14422: "bluff",
-		14423: "blum",
-		14424: "blunt",
-		14425: "blur",
-		14426: "blurt",
-		14431: "blush",
-		14432: "blvd",
-		14433: "blythe",
-		14434: "bm",
-		14435: "bmw",
-		14436: "bn",
-		14441: "bo",
-		14442: "boa",
-		14443: "boar",
-		14444: "board",
-		14445: "boast",
-		14446: "boat",
-		14451: "bob",
-		14452: "bobbin",
-		14453: "bobby",
-		14454: "bobcat",
-		14455: "boca",
-		14456: "bock",
-		14461: "bode",
-		14462: "body",
-		14463: "bog",
-		14464: "bogey",
-		14465: "boggy",
-		14466: "bogus",
-		14511: "bogy",
-		14512: "bohr",
-		14513: "boil",
-		14514: "bois",
-		14515: "boise",
-		14516: "bold",
-		14521: "bole",
-		14522: "bolo",
-		14523: "bolt",
-		14524: "bomb",
-		14525: "bombay",
-		14526: "bon",
-		14531: "bona",
-		14532: "bond",
-		14533: "bone",
-		14534: "bong",
-		14535: "bongo",
-		14536: "bonn",
-		14541: "bonus",
-		14542: "bony",
-		14543: "bonze",
-		14544: "boo",
-		14545: "booby",
-		14546: "boogie",
-		14551: "book",
-		14552: "booky",
-		14553: "boom",
-		14554: "boon",
-		14555: "boone",
-		14556: "boor",
-		14561: "boost",
-		14562: "boot",
-		14563: "booth",
-		14564: "booty",
-		14565: "booze",
-		14566: "bop",
-		14611: "borax",
-		14612: "border",
-		14613: "bore",
-		14614: "borg",
-		14615: "boric",
-		14616: "boris",
-		14621: "born",
-		14622: "borne",
-		14623: "borneo",
-		14624: "boron",
-		14625: "bosch",
-		14626: "bose",
-		14631: "bosom",
-		14632: "boson",
-		14633: "boss",
-		14634: "boston",
-		14635: "botch",
-		14636: "both",
-		14641: "bottle",
-		14642: "bough",
-		14643: "bouncy",
-		14644: "bound",
-		14645: "bourn",
-		14646: "bout",
-		14651: "bovine",
-		14652: "bow",
-		14653: "bowel",
-		14654: "bowen",
-		14655: "bowie",
-		14656: "bowl",
-		14661: "box",
-		14662: "boxy",
-		14663: "boy",
-		14664: "boyar",
-		14665: "boyce",
-		14666: "boyd",
-		15111: "boyle",
-		15112: "bp",
-		15113: "bq",
-		15114: "br",
-		15115: "brace",
-		15116: "bract",
-		15121: "brad",
-		15122: "brady",
-		15123: "brae",
-		15124: "brag",
-		15125: "bragg",
-		15126: "braid",
-		15131: "brain",
-		15132: "brainy",
-		15133: "brake",
-		15134: "bran",
-		15135: "brand",
-		15136: "brandt",
-		15141: "brant",
-		15142: "brash",
-		15143: "brass",
-		15144: "brassy",
-		15145: "braun",
-		15146: "brave",
-		15151: "bravo",
-		15152: "brawl",
-		15153: "bray",
-		15154: "bread",
-		15155: "break",
-		15156: "bream",
-		15161: "breath",
-		15162: "bred",
-		15163: "breed",
-		15164: "breeze",
-		15165: "bremen",
-		15166: "brent",
-		15211: "brest",
-		15212: "brett",
-		15213: "breve",
-		15214: "brew",
-		15215: "brian",
-		15216: "briar",
-		15221: "bribe",
-		15222: "brice",
-		15223: "brick",
-		15224: "bride",
-		15225: "brief",
-		15226: "brig",
-		15231: "briggs",
-		15232: "brim",
-		15233: "brine",
-		15234: "bring",
-		15235: "brink",
-		15236: "briny",
-		15241: "brisk",
-		15242: "broad",
-		15243: "brock",
-		15244: "broil",
-		15245: "broke",
-		15246: "broken",
-		15251: "bronx",
-		15252: "brood",
-		15253: "brook",
-		15254: "brooke",
-		15255: "broom",
-		15256: "broth",
-		15261: "brow",
-		15262: "brown",
-		15263: "browse",
-		15264: "bruce",
-		15265: "bruit",
-		15266: "brunch",
-		15311: "bruno",
-		15312: "brunt",
-		15313: "brush",
-		15314: "brute",
-		15315: "bryan",
-		15316: "bryant",
-		15321: "bryce",
-		15322: "bryn",
-		15323: "bs",
-		15324: "bstj",
-		15325: "bt",
-		15326: "btl",
-		15331: "bu",
-		15332: "bub",
-		15333: "buck",
-		15334: "bud",
-		15335: "budd",
-		15336: "buddy",
-		15341: "budge",
-		15342: "buena",
-		15343: "buenos",
-		15344: "buff",
-		15345: "bug",
-		15346: "buggy",
-		15351: "bugle",
-		15352: "buick",
-		15353: "build",
-		15354: "built",
-		15355: "bulb",
-		15356: "bulge",
-		15361: "bulk",
-		15362: "bulky",
-		15363: "bull",
-		15364: "bully",
-		15365: "bum",
-		15366: "bump",
-		15411: "bun",
-		15412: "bunch",
-		15413: "bundy",
-		15414: "bunk",
-		15415: "bunny",
-		15416: "bunt",
-		15421: "bunyan",
-		15422: "buoy",
-		15423: "burch",
-		15424: "bureau",
-		15425: "buret",
-		15426: "burg",
-		15431: "buried",
-		15432: "burke",
-		15433: "burl",
-		15434: "burly",
-		15435: "burma",
-		15436: "burn",
-		15441: "burnt",
-		15442: "burp",
-		15443: "burr",
-		15444: "burro",
-		15445: "burst",
-		15446: "burt",
-		15451: "burton",
-		15452: "burtt",
-		15453: "bury",
-		15454: "bus",
-		15455: "busch",
-		15456: "bush",
-		15461: "bushel",
-		15462: "bushy",
-		15463: "buss",
-		15464: "bust",
-		15465: "busy",
-		15466: "but",
-		15511: "butane",
-		15512: "butch",
-		15513: "buteo",
-		15514: "butt",
-		15515: "butte",
-		15516: "butyl",
-		15521: "buxom",
-		15522: "buy",
-		15523: "buyer",
-		15524: "buzz",
-		15525: "buzzy",
-		15526: "bv",
-		15531: "bw",
-		15532: "bx",
-		15533: "by",
-		15534: "bye",
-		15535: "byers",
-		15536: "bylaw",
-		15541: "byline",
-		15542: "byrd",
-		15543: "byrne",
-		15544: "byron",
-		15545: "byte",
-		15546: "byway",
-		15551: "byword",
-		15552: "bz",
-		15553: "c",
-		15554: "c's",
-		15555: "ca",
-		15556: "cab",
-		15561: "cabal",
-		15562: "cabin",
-		15563: "cable",
-		15564: "cabot",
-		15565: "cacao",
-		15566: "cache",
-		15611: "cacm",
-		15612: "cacti",
-		15613: "caddy",
-		15614: "cadent",
-		15615: "cadet",
-		15616: "cadre",
-		15621: "cady",
-		15622: "cafe",
-		15623: "cage",
-		15624: "cagey",
-		15625: "cahill",
-		15626: "caiman",
-		15631: "cain",
-		15632: "caine",
-		15633: "cairn",
-		15634: "cairo",
-		15635: "cake",
-		15636: "cal",
-		15641: "calder",
-		15642: "caleb",
-		15643: "calf",
-		15644: "call",
-		15645: "calla",
-		15646: "callus",
-		15651: "calm",
-		15652: "calve",
-		15653: "cam",
-		15654: "camber",
-		15655: "came",
-		15656: "camel",
-		15661: "cameo",
-		15662: "camp",
-		15663: "can",
-		15664: "can't",
-		15665: "canal",
-		15666: "canary",
-		16111: "cancer",
-		16112: "candle",
-		16113: "candy",
-		16114: "cane",
-		16115: "canis",
-		16116: "canna",
-		16121: "cannot",
-		16122: "canny",
-		16123: "canoe",
-		16124: "canon",
-		16125: "canopy",
-		16126: "cant",
-		16131: "canto",
-		16132: "canton",
-		16133: "cap",
-		16134: "cape",
-		16135: "caper",
-		16136: "capo",
-		16141: "car",
-		16142: "carbon",
-		16143: "card",
-		16144: "care",
-		16145: "caress",
-		16146: "caret",
-		16151: "carey",
-		16152: "cargo",
-		16153: "carib",
-		16154: "carl",
-		16155: "carla",
-		16156: "carlo",
-		16161: "carne",
-		16162: "carob",
-		16163: "carol",
-		16164: "carp",
-		16165: "carpet",
-		16166: "carr",
-		16211: "carrie",
-		16212: "carry",
-		16213: "carson",
-		16214: "cart",
-		16215: "carte",
-		16216: "caruso",
-		16221: "carve",
-		16222: "case",
-		16223: "casey",
-		16224: "cash",
-		16225: "cashew",
-		16226: "cask",
-		16231: "casket",
-		16232: "cast",
-		16233: "caste",
-		16234: "cat",
-		16235: "catch",
-		16236: "cater",
-		16241: "cathy",
-		16242: "catkin",
-		16243: "catsup",
-		16244: "cauchy",
-		16245: "caulk",
-		16246: "cause",
-		16251: "cave",
-		16252: "cavern",
-		16253: "cavil",
-		16254: "cavort",
-		16255: "caw",
-		16256: "cayuga",
-		16261: "cb",
-		16262: "cbs",
-		16263: "cc",
-		16264: "ccc",
-		16265: "cccc",
-		16266: "cd",
-		16311: "cdc",
-		16312: "ce",
-		16313: "cease",
-		16314: "cecil",
-		16315: "cedar",
-		16316: "cede",
-		16321: "ceil",
-		16322: "celia",
-		16323: "cell",
-		16324: "census",
-		16325: "cent",
-		16326: "ceres",
-		16331: "cern",
-		16332: "cetera",
-		16333: "cetus",
-		16334: "cf",
-		16335: "cg",
-		16336: "ch",
-		16341: "chad",
-		16342: "chafe",
-		16343: "chaff",
-		16344: "chai",
-		16345: "chain",
-		16346: "chair",
-		16351: "chalk",
-		16352: "champ",
-		16353: "chance",
-		16354: "chang",
-		16355: "chant",
-		16356: "chao",
-		16361: "chaos",
-		16362: "chap",
-		16363: "chapel",
-		16364: "char",
-		16365: "chard",
-		16366: "charm",
-		16411: "chart",
-		16412: "chase",
-		16413: "chasm",
-		16414: "chaste",
-		16415: "chat",
-		16416: "chaw",
-		16421: "cheap",
-		16422: "cheat",
-		16423: "check",
-		16424: "cheek",
-		16425: "cheeky",
-		16426: "cheer",
-		16431: "chef",
-		16432: "chen",
-		16433: "chert",
-		16434: "cherub",
-		16435: "chess",
-		16436: "chest",
-		16441: "chevy",
-		16442: "chew",
-		16443: "chi",
-		16444: "chic",
-		16445: "chick",
-		16446: "chide",
-		16451: "chief",
-		16452: "child",
-		16453: "chile",
-		16454: "chili",
-		16455: "chill",
-		16456: "chilly",
-		16461: "chime",
-		16462: "chin",
-		16463: "china",
-		16464: "chine",
-		16465: "chink",
-		16466: "chip",
-		16511: "chirp",
-		16512: "chisel",
-		16513: "chit",
-		16514: "chive",
-		16515: "chock",
-		16516: "choir",
-		16521: "choke",
-		16522: "chomp",
-		16523: "chop",
-		16524: "chopin",
-		16525: "choral",
-		16526: "chord",
-		16531: "chore",
-		16532: "chose",
-		16533: "chosen",
-		16534: "chou",
-		16535: "chow",
-		16536: "chris",
-		16541: "chub",
-		16542: "chuck",
-		16543: "chuff",
-		16544: "chug",
-		16545: "chum",
-		16546: "chump",
-		16551: "chunk",
-		16552: "churn",
-		16553: "chute",
-		16554: "ci",
-		16555: "cia",
-		16556: "cicada",
-		16561: "cider",
-		16562: "cigar",
-		16563: "cilia",
-		16564: "cinch",
-		16565: "cindy",
-		16566: "cipher",
-		16611: "circa",
-		16612: "circe",
-		16613: "cite",
-		16614: "citrus",
-		16615: "city",
-		16616: "civet",
-		16621: "civic",
-		16622: "civil",
-		16623: "cj",
-		16624: "ck",
-		16625: "cl",
-		16626: "clad",
-		16631: "claim",
-		16632: "clam",
-		16633: "clammy",
-		16634: "clamp",
-		16635: "clan",
-		16636: "clang",
-		16641: "clank",
-		16642: "clap",
-		16643: "clara",
-		16644: "clare",
-		16645: "clark",
-		16646: "clarke",
-		16651: "clash",
-		16652: "clasp",
-		16653: "class",
-		16654: "claus",
-		16655: "clause",
-		16656: "claw",
-		16661: "clay",
-		16662: "clean",
-		16663: "clear",
-		16664: "cleat",
-		16665: "cleft",
-		16666: "clerk",
-		21111: "cliche",
-		21112: "click",
-		21113: "cliff",
-		21114: "climb",
-		21115: "clime",
-		21116: "cling",
-		21121: "clink",
-		21122: "clint",
-		21123: "clio",
-		21124: "clip",
-		21125: "clive",
-		21126: "cloak",
-		21131: "clock",
-		21132: "clod",
-		21133: "clog",
-		21134: "clomp",
-		21135: "clone",
-		21136: "close",
-		21141: "closet",
-		21142: "clot",
-		21143: "cloth",
-		21144: "cloud",
-		21145: "clout",
-		21146: "clove",
-		21151: "clown",
-		21152: "cloy",
-		21153: "club",
-		21154: "cluck",
-		21155: "clue",
-		21156: "cluj",
-		21161: "clump",
-		21162: "clumsy",
-		21163: "clung",
-		21164: "clyde",
-		21165: "cm",
-		21166: "cn",
-		21211: "co",
-		21212: "coach",
-		21213: "coal",
-		21214: "coast",
-		21215: "coat",
-		21216: "coax",
-		21221: "cobb",
-		21222: "cobble",
-		21223: "cobol",
-		21224: "cobra",
-		21225: "coca",
-		21226: "cock",
-		21231: "cockle",
-		21232: "cocky",
-		21233: "coco",
-		21234: "cocoa",
-		21235: "cod",
-		21236: "coda",
-		21241: "coddle",
-		21242: "code",
-		21243: "codon",
-		21244: "cody",
-		21245: "coed",
-		21246: "cog",
-		21251: "cogent",
-		21252: "cohen",
-		21253: "cohn",
-		21254: "coil",
-		21255: "coin",
-		21256: "coke",
-		21261: "col",
-		21262: "cola",
-		21263: "colby",
-		21264: "cold",
-		21265: "cole",
-		21266: "colon",
-		21311: "colony",
-		21312: "colt",
-		21313: "colza",
-		21314: "coma",
-		21315: "comb",
-		21316: "combat",
-		21321: "come",
-		21322: "comet",
-		21323: "cometh",
-		21324: "comic",
-		21325: "comma",
-		21326: "con",
-		21331: "conch",
-		21332: "cone",
-		21333: "coney",
-		21334: "congo",
-		21335: "conic",
-		21336: "conn",
-		21341: "conner",
-		21342: "conway",
-		21343: "cony",
-		21344: "coo",
-		21345: "cook",
-		21346: "cooke",
-		21351: "cooky",
-		21352: "cool",
-		21353: "cooley",
-		21354: "coon",
-		21355: "coop",
-		21356: "coors",
-		21361: "coot",
-		21362: "cop",
-		21363: "cope",
-		21364: "copra",
-		21365: "copy",
-		21366: "coral",
-		21411: "corbel",
-		21412: "cord",
-		21413: "core",
-		21414: "corey",
-		21415: "cork",
-		21416: "corn",
-		21421: "corny",
-		21422: "corp",
-		21423: "corps",
-		21424: "corvus",
-		21425: "cos",
-		21426: "cosec",
-		21431: "coset",
-		21432: "cosh",
-		21433: "cost",
-		21434: "costa",
-		21435: "cosy",
-		21436: "cot",
-		21441: "cotta",
-		21442: "cotty",
-		21443: "couch",
-		21444: "cough",
-		21445: "could",
-		21446: "count",
-		21451: "coup",
-		21452: "coupe",
-		21453: "court",
-		21454: "cousin",
-		21455: "cove",
-		21456: "coven",
-		21461: "cover",
-		21462: "covet",
-		21463: "cow",
-		21464: "cowan",
-		21465: "cowl",
-		21466: "cowman",
-		21511: "cowry",
-		21512: "cox",
-		21513: "coy",
-		21514: "coyote",
-		21515: "coypu",
-		21516: "cozen",
-		21521: "cozy",
-		21522: "cp",
-		21523: "cpa",
-		21524: "cq",
-		21525: "cr",
-		21526: "crab",
-		21531: "crack",
-		21532: "craft",
-		21533: "crag",
-		21534: "craig",
-		21535: "cram",
-		21536: "cramp",
-		21541: "crane",
-		21542: "crank",
-		21543: "crap",
-		21544: "crash",
-		21545: "crass",
-		21546: "crate",
-		21551: "crater",
-		21552: "crave",
-		21553: "craw",
-		21554: "crawl",
-		21555: "craze",
-		21556: "crazy",
-		21561: "creak",
-		21562: "cream",
-		21563: "credit",
-		21564: "credo",
-		21565: "creed",
-		21566: "creek",
-		21611: "creep",
-		21612: "creole",
-		21613: "creon",
-		21614: "crepe",
-		21615: "crept",
-		21616: "cress",
-		21621: "crest",
-		21622: "crete",
-		21623: "crew",
-		21624: "crib",
-		21625: "cried",
-		21626: "crime",
-		21631: "crimp",
-		21632: "crisp",
-		21633: "criss",
-		21634: "croak",
-		21635: "crock",
-		21636: "crocus",
-		21641: "croft",
-		21642: "croix",
-		21643: "crone",
-		21644: "crony",
-		21645: "crook",
-		21646: "croon",
-		21651: "crop",
-		21652: "cross",
-		21653: "crow",
-		21654: "crowd",
-		21655: "crown",
-		21656: "crt",
-		21661: "crud",
-		21662: "crude",
-		21663: "cruel",
-		21664: "crumb",
-		21665: "crump",
-		21666: "crush",
-		22111: "crust",
-		22112: "crux",
-		22113: "cruz",
-		22114: "cry",
-		22115: "crypt",
-		22116: "cs",
-		22121: "ct",
-		22122: "cu",
-		22123: "cub",
-		22124: "cuba",
-		22125: "cube",
-		22126: "cubic",
-		22131: "cud",
-		22132: "cuddle",
-		22133: "cue",
-		22134: "cuff",
-		22135: "cull",
-		22136: "culpa",
-		22141: "cult",
-		22142: "cumin",
-		22143: "cuny",
-		22144: "cup",
-		22145: "cupful",
-		22146: "cupid",
-		22151: "cur",
-		22152: "curb",
-		22153: "curd",
-		22154: "cure",
-		22155: "curfew",
-		22156: "curia",
-		22161: "curie",
-		22162: "curio",
-		22163: "curl",
-		22164: "curry",
-		22165: "curse",
-		22166: "curt",
-		22211: "curve",
-		22212: "cusp",
-		22213: "cut",
-		22214: "cute",
-		22215: "cutlet",
-		22216: "cv",
-		22221: "cw",
-		22222: "cx",
-		22223: "cy",
-		22224: "cycad",
-		22225: "cycle",
-		22226: "cynic",
-		22231: "cyril",
-		22232: "cyrus",
-		22233: "cyst",
-		22234: "cz",
-		22235: "czar",
-		22236: "czech",
-		22241: "d",
-		22242: "d'art",
-		22243: "d's",
-		22244: "da",
-		22245: "dab",
-		22246: "dacca",
-		22251: "dactyl",
-		22252: "dad",
-		22253: "dada",
-		22254: "daddy",
-		22255: "dade",
-		22256: "daffy",
-		22261: "dahl",
-		22262: "dahlia",
-		22263: "dairy",
-		22264: "dais",
-		22265: "daisy",
-		22266: "dakar",
-		22311: "dale",
-		22312: "daley",
-		22313: "dally",
-		22314: "daly",
-		22315: "dam",
-		22316: "dame",
-		22321: "damn",
-		22322: "damon",
-		22323: "damp",
-		22324: "damsel",
-		22325: "dan",
-		22326: "dana",
-		22331: "dance",
-		22332: "dandy",
-		22333: "dane",
-		22334: "dang",
-		22335: "dank",
-		22336: "danny",
-		22341: "dante",
-		22342: "dar",
-		22343: "dare",
-		22344: "dark",
-		22345: "darken",
-		22346: "darn",
-		22351: "darry",
-		22352: "dart",
-		22353: "dash",
-		22354: "data",
-		22355: "date",
-		22356: "dater",
-		22361: "datum",
-		22362: "daub",
-		22363: "daunt",
-		22364: "dave",
-		22365: "david",
-		22366: "davis",
-		22411: "davit",
-		22412: "davy",
-		22413: "dawn",
-		22414: "dawson",
-		22415: "day",
-		22416: "daze",
-		22421: "db",
-		22422: "dc",
-		22423: "dd",
-		22424: "ddd",
-		22425: "dddd",
-		22426: "de",
-		22431: "deacon",
-		22432: "dead",
-		22433: "deaf",
-		22434: "deal",
-		22435: "dealt",
-		22436: "dean",
-		22441: "deane",
-		22442: "dear",
-		22443: "death",
-		22444: "debar",
-		22445: "debby",
-		22446: "debit",
-		22451: "debra",
-		22452: "debris",
-		22453: "debt",
-		22454: "debug",
-		22455: "debut",
-		22456: "dec",
-		22461: "decal",
-		22462: "decay",
-		22463: "decca",
-		22464: "deck",
-		22465: "decker",
-		22466: "decor",
-		22511: "decree",
-		22512: "decry",
-		22513: "dee",
-		22514: "deed",
-		22515: "deem",
-		22516: "deep",
-		22521: "deer",
-		22522: "deere",
-		22523: "def",
-		22524: "defer",
-		22525: "deform",
-		22526: "deft",
-		22531: "defy",
-		22532: "degas",
-		22533: "degum",
-		22534: "deify",
-		22535: "deign",
-		22536: "deity",
-		22541: "deja",
-		22542: "del",
-		22543: "delay",
-		22544: "delft",
-		22545: "delhi",
-		22546: "delia",
-		22551: "dell",
-		22552: "della",
-		22553: "delta",
-		22554: "delve",
-		22555: "demark",
-		22556: "demit",
-		22561: "demon",
-		22562: "demur",
-		22563: "den",
-		22564: "deneb",
-		22565: "denial",
-		22566: "denny",
-		22611: "dense",
-		22612: "dent",
-		22613: "denton",
-		22614: "deny",
-		22615: "depot",
-		22616: "depth",
-		22621: "depute",
-		22622: "derby",
-		22623: "derek",
-		22624: "des",
-		22625: "desist",
-		22626: "desk",
-		22631: "detach",
-		22632: "deter",
-		22633: "deuce",
-		22634: "deus",
-		22635: "devil",
-		22636: "devoid",
-		22641: "devon",
-		22642: "dew",
-		22643: "dewar",
-		22644: "dewey",
-		22645: "dewy",
-		22646: "dey",
-		22651: "df",
-		22652: "dg",
-		22653: "dh",
-		22654: "dhabi",
-		22655: "di",
-		22656: "dial",
-		22661: "diana",
-		22662: "diane",
-		22663: "diary",
-		22664: "dibble",
-		22665: "dice",
-		22666: "dick",
-		23111: "dicta",
-		23112: "did",
-		23113: "dido",
-		23114: "die",
-		23115: "died",
-		23116: "diego",
-		23121: "diem",
-		23122: "diesel",
-		23123: "diet",
-		23124: "diety",
-		23125: "dietz",
-		23126: "dig",
-		23131: "digit",
-		23132: "dilate",
-		23133: "dill",
-		23134: "dim",
-		23135: "dime",
-		23136: "din",
-		23141: "dinah",
-		23142: "dine",
-		23143: "ding",
-		23144: "dingo",
-		23145: "dingy",
-		23146: "dint",
-		23151: "diode",
-		23152: "dip",
-		23153: "dirac",
-		23154: "dire",
-		23155: "dirge",
-		23156: "dirt",
-		23161: "dirty",
-		23162: "dis",
-		23163: "disc",
-		23164: "dish",
-		23165: "disk",
-		23166: "disney",
-		23211: "ditch",
-		23212: "ditto",
-		23213: "ditty",
-		23214: "diva",
-		23215: "divan",
-		23216: "dive",
-		23221: "dixie",
-		23222: "dixon",
-		23223: "dizzy",
-		23224: "dj",
-		23225: "dk",
-		23226: "dl",
-		23231: "dm",
-		23232: "dn",
-		23233: "dna",
-		23234: "do",
-		23235: "dobbs",
-		23236: "dobson",
-		23241: "dock",
-		23242: "docket",
-		23243: "dod",
-		23244: "dodd",
-		23245: "dodge",
-		23246: "dodo",
-		23251: "doe",
-		23252: "doff",
-		23253: "dog",
-		23254: "doge",
-		23255: "dogma",
-		23256: "dolan",
-		23261: "dolce",
-		23262: "dole",
-		23263: "doll",
-		23264: "dolly",
-		23265: "dolt",
-		23266: "dome",
-		23311: "don",
-		23312: "don't",
-		23313: "done",
-		23314: "doneck",
-		23315: "donna",
-		23316: "donor",
-		23321: "doom",
-		23322: "door",
-		23323: "dope",
-		23324: "dora",
-		23325: "doria",
-		23326: "doric",
-		23331: "doris",
-		23332: "dose",
-		23333: "dot",
-		23334: "dote",
-		23335: "double",
-		23336: "doubt",
-		23341: "douce",
-		23342: "doug",
-		23343: "dough",
-		23344: "dour",
-		23345: "douse",
-		23346: "dove",
-		23351: "dow",
-		23352: "dowel",
-		23353: "down",
-		23354: "downs",
-		23355: "dowry",
-		23356: "doyle",
-		23361: "doze",
-		23362: "dozen",
-		23363: "dp",
-		23364: "dq",
-		23365: "dr",
-		23366: "drab",
-		23411: "draco",
-		23412: "draft",
-		23413: "drag",
-		23414: "drain",
-		23415: "drake",
-		23416: "dram",
-		23421: "drama",
-		23422: "drank",
-		23423: "drape",
-		23424: "draw",
-		23425: "drawl",
-		23426: "drawn",
-		23431: "dread",
-		23432: "dream",
-		23433: "dreamy",
-		23434: "dreg",
-		23435: "dress",
-		23436: "dressy",
-		23441: "drew",
-		23442: "drib",
-		23443: "dried",
-		23444: "drier",
-		23445: "drift",
-		23446: "drill",
-		23451: "drink",
-		23452: "drip",
-		23453: "drive",
-		23454: "droll",
-		23455: "drone",
-		23456: "drool",
-		23461: "droop",
-		23462: "drop",
-		23463: "dross",
-		23464: "drove",
-		23465: "drown",
-		23466: "drub",
-		23511: "drug",
-		23512: "druid",
-		23513: "drum",
-		23514: "drunk",
-		23515: "drury",
-		23516: "dry",
-		23521: "dryad",
-		23522: "ds",
-		23523: "dt",
-		23524: "du",
-		23525: "dual",
-		23526: "duane",
-		23531: "dub",
-		23532: "dubhe",
-		23533: "dublin",
-		23534: "ducat",
-		23535: "duck",
-		23536: "duct",
-		23541: "dud",
-		23542: "due",
-		23543: "duel",
-		23544: "duet",
-		23545: "duff",
-		23546: "duffy",
-		23551: "dug",
-		23552: "dugan",
-		23553: "duke",
-		23554: "dull",
-		23555: "dully",
-		23556: "dulse",
-		23561: "duly",
-		23562: "duma",
-		23563: "dumb",
-		23564: "dummy",
-		23565: "dump",
-		23566: "dumpy",
-		23611: "dun",
-		23612: "dunce",
-		23613: "dune",
-		23614: "dung",
-		23615: "dunham",
-		23616: "dunk",
-		23621: "dunlop",
-		23622: "dunn",
-		23623: "dupe",
-		23624: "durer",
-		23625: "dusk",
-		23626: "dusky",
-		23631: "dust",
-		23632: "dusty",
-		23633: "dutch",
-		23634: "duty",
-		23635: "dv",
-		23636: "dw",
-		23641: "dwarf",
-		23642: "dwell",
-		23643: "dwelt",
-		23644: "dwight",
-		23645: "dwyer",
-		23646: "dx",
-		23651: "dy",
-		23652: "dyad",
-		23653: "dye",
-		23654: "dyer",
-		23655: "dying",
-		23656: "dyke",
-		23661: "dylan",
-		23662: "dyne",
-		23663: "dz",
-		23664: "e",
-		23665: "e'er",
-		23666: "e's",
-		24111: "ea",
-		24112: "each",
-		24113: "eagan",
-		24114: "eager",
-		24115: "eagle",
-		24116: "ear",
-		24121: "earl",
-		24122: "earn",
-		24123: "earth",
-		24124: "ease",
-		24125: "easel",
-		24126: "east",
-		24131: "easy",
-		24132: "eat",
-		24133: "eaten",
-		24134: "eater",
-		24135: "eaton",
-		24136: "eave",
-		24141: "eb",
-		24142: "ebb",
-		24143: "eben",
-		24144: "ebony",
-		24145: "ec",
-		24146: "echo",
-		24151: "eclat",
-		24152: "ecole",
-		24153: "ed",
-		24154: "eddie",
-		24155: "eddy",
-		24156: "eden",
-		24161: "edgar",
-		24162: "edge",
-		24163: "edgy",
-		24164: "edict",
-		24165: "edify",
-		24166: "edit",
-		24211: "edith",
-		24212: "editor",
-		24213: "edna",
-		24214: "edt",
-		24215: "edwin",
-		24216: "ee",
-		24221: "eee",
-		24222: "eeee",
-		24223: "eel",
-		24224: "eeoc",
-		24225: "eerie",
-		24226: "ef",
-		24231: "efface",
-		24232: "effie",
-		24233: "efg",
-		24234: "eft",
-		24235: "eg",
-		24236: "egan",
-		24241: "egg",
-		24242: "ego",
-		24243: "egress",
-		24244: "egret",
-		24245: "egypt",
-		24246: "eh",
-		24251: "ei",
-		24252: "eider",
-		24253: "eight",
-		24254: "eire",
-		24255: "ej",
-		24256: "eject",
-		24261: "ek",
-		24262: "eke",
-		24263: "el",
-		24264: "elan",
-		24265: "elate",
-		24266: "elba",
-		24311: "elbow",
-		24312: "elder",
-		24313: "eldon",
-		24314: "elect",
-		24315: "elegy",
-		24316: "elena",
-		24321: "eleven",
-		24322: "elfin",
-		24323: "elgin",
-		24324: "eli",
-		24325: "elide",
-		24326: "eliot",
-		24331: "elite",
-		24332: "elk",
-		24333: "ell",
-		24334: "ella",
-		24335: "ellen",
-		24336: "ellis",
-		24341: "elm",
-		24342: "elmer",
-		24343: "elope",
-		24344: "else",
-		24345: "elsie",
-		24346: "elton",
-		24351: "elude",
-		24352: "elute",
-		24353: "elves",
-		24354: "ely",
-		24355: "em",
-		24356: "embalm",
-		24361: "embark",
-		24362: "embed",
-		24363: "ember",
-		24364: "emcee",
-		24365: "emery",
-		24366: "emil",
-		24411: "emile",
-		24412: "emily",
-		24413: "emit",
-		24414: "emma",
-		24415: "emory",
-		24416: "empty",
-		24421: "en",
-		24422: "enact",
-		24423: "enamel",
-		24424: "end",
-		24425: "endow",
-		24426: "enemy",
-		24431: "eng",
-		24432: "engel",
-		24433: "engle",
-		24434: "engulf",
-		24435: "enid",
-		24436: "enjoy",
-		24441: "enmity",
-		24442: "enoch",
-		24443: "enol",
-		24444: "enos",
-		24445: "enrico",
-		24446: "ensue",
-		24451: "enter",
-		24452: "entrap",
-		24453: "entry",
-		24454: "envoy",
-		24455: "envy",
-		24456: "eo",
-		24461: "ep",
-		24462: "epa",
-		24463: "epic",
-		24464: "epoch",
-		24465: "epoxy",
-		24466: "epsom",
-		24511: "eq",
-		24512: "equal",
-		24513: "equip",
-		24514: "er",
-		24515: "era",
-		24516: "erase",
-		24521: "erato",
-		24522: "erda",
-		24523: "ere",
-		24524: "erect",
-		24525: "erg",
-		24526: "eric",
-		24531: "erich",
-		24532: "erie",
-		24533: "erik",
-		24534: "ernest",
-		24535: "ernie",
-		24536: "ernst",
-		24541: "erode",
-		24542: "eros",
-		24543: "err",
-		24544: "errand",
-		24545: "errol",
-		24546: "error",
-		24551: "erupt",
-		24552: "ervin",
-		24553: "erwin",
-		24554: "es",
-		24555: "essay",
-		24556: "essen",
-		24561: "essex",
-		24562: "est",
-		24563: "ester",
-		24564: "estes",
-		24565: "estop",
-		24566: "et",
-		24611: "eta",
-		24612: "etc",
-		24613: "etch",
-		24614: "ethan",
-		24615: "ethel",
-		24616: "ether",
-		24621: "ethic",
-		24622: "ethos",
-		24623: "ethyl",
-		24624: "etude",
-		24625: "eu",
-		24626: "eucre",
-		24631: "euler",
-		24632: "eureka",
-		24633: "ev",
-		24634: "eva",
-		24635: "evade",
-		24636: "evans",
-		24641: "eve",
-		24642: "even",
-		24643: "event",
-		24644: "every",
-		24645: "evict",
-		24646: "evil",
-		24651: "evoke",
-		24652: "evolve",
-		24653: "ew",
-		24654: "ewe",
-		24655: "ewing",
-		24656: "ex",
-		24661: "exact",
-		24662: "exalt",
-		24663: "exam",
-		24664: "excel",
-		24665: "excess",
-		24666: "exert",
-		25111: "exile",
-		25112: "exist",
-		25113: "exit",
-		25114: "exodus",
-		25115: "expel",
-		25116: "extant",
-		25121: "extent",
-		25122: "extol",
-		25123: "extra",
-		25124: "exude",
-		25125: "exult",
-		25126: "exxon",
-		25131: "ey",
-		25132: "eye",
-		25133: "eyed",
-		25134: "ez",
-		25135: "ezra",
-		25136: "f",
-		25141: "f's",
-		25142: "fa",
-		25143: "faa",
-		25144: "faber",
-		25145: "fable",
-		25146: "face",
-		25151: "facet",
-		25152: "facile",
-		25153: "fact",
-		25154: "facto",
-		25155: "fad",
-		25156: "fade",
-		25161: "faery",
-		25162: "fag",
-		25163: "fahey",
-		25164: "fail",
-		25165: "fain",
-		25166: "faint",
-		25211: "fair",
-		25212: "fairy",
-		25213: "faith",
-		25214: "fake",
-		25215: "fall",
-		25216: "false",
-		25221: "fame",
-		25222: "fan",
-		25223: "fancy",
-		25224: "fang",
-		25225: "fanny",
-		25226: "fanout",
-		25231: "far",
-		25232: "farad",
-		25233: "farce",
-		25234: "fare",
-		25235: "fargo",
-		25236: "farley",
-		25241: "farm",
-		25242: "faro",
-		25243: "fast",
-		25244: "fat",
-		25245: "fatal",
-		25246: "fate",
-		25251: "fatty",
-		25252: "fault",
-		25253: "faun",
-		25254: "fauna",
-		25255: "faust",
-		25256: "fawn",
-		25261: "fay",
-		25262: "faze",
-		25263: "fb",
-		25264: "fbi",
-		25265: "fc",
-		25266: "fcc",
-		25311: "fd",
-		25312: "fda",
-		25313: "fe",
-		25314: "fear",
-		25315: "feast",
-		25316: "feat",
-		25321: "feb",
-		25322: "fed",
-		25323: "fee",
-		25324: "feed",
-		25325: "feel",
-		25326: "feet",
-		25331: "feign",
-		25332: "feint",
-		25333: "felice",
-		25334: "felix",
-		25335: "fell",
-		25336: "felon",
-		25341: "felt",
-		25342: "femur",
-		25343: "fence",
-		25344: "fend",
-		25345: "fermi",
-		25346: "fern",
-		25351: "ferric",
-		25352: "ferry",
-		25353: "fest",
-		25354: "fetal",
-		25355: "fetch",
-		25356: "fete",
-		25361: "fetid",
-		25362: "fetus",
-		25363: "feud",
-		25364: "fever",
-		25365: "few",
-		25366: "ff",
-		25411: "fff",
-		25412: "ffff",
-		25413: "fg",
-		25414: "fgh",
-		25415: "fh",
-		25416: "fi",
-		25421: "fiat",
-		25422: "fib",
-		25423: "fibrin",
-		25424: "fiche",
-		25425: "fide",
-		25426: "fief",
-		25431: "field",
-		25432: "fiend",
-		25433: "fiery",
-		25434: "fife",
-		25435: "fifo",
-		25436: "fifth",
-		25441: "fifty",
-		25442: "fig",
-		25443: "fight",
-		25444: "filch",
-		25445: "file",
-		25446: "filet",
-		25451: "fill",
-		25452: "filler",
-		25453: "filly",
-		25454: "film",
-		25455: "filmy",
-		25456: "filth",
-		25461: "fin",
-		25462: "final",
-		25463: "finale",
-		25464: "finch",
-		25465: "find",
-		25466: "fine",
-		25511: "finite",
-		25512: "fink",
-		25513: "finn",
-		25514: "finny",
-		25515: "fir",
-		25516: "fire",
-		25521: "firm",
-		25522: "first",
-		25523: "fish",
-		25524: "fishy",
-		25525: "fisk",
-		25526: "fiske",
-		25531: "fist",
-		25532: "fit",
-		25533: "fitch",
-		25534: "five",
-		25535: "fix",
-		25536: "fj",
-		25541: "fjord",
-		25542: "fk",
-		25543: "fl",
-		25544: "flack",
-		25545: "flag",
-		25546: "flail",
-		25551: "flair",
-		25552: "flak",
-		25553: "flake",
-		25554: "flaky",
-		25555: "flam",
-		25556: "flame",
-		25561: "flank",
-		25562: "flap",
-		25563: "flare",
-		25564: "flash",
-		25565: "flask",
-		25566: "flat",
-		25611: "flatus",
-		25612: "flaw",
-		25613: "flax",
-		25614: "flea",
-		25615: "fleck",
-		25616: "fled",
-		25621: "flee",
-		25622: "fleet",
-		25623: "flesh",
-		25624: "flew",
-		25625: "flex",
-		25626: "flick",
-		25631: "flier",
-		25632: "flinch",
-		25633: "fling",
-		25634: "flint",
-		25635: "flip",
-		25636: "flirt",
-		25641: "flit",
-		25642: "flo",
-		25643: "float",
-		25644: "floc",
-		25645: "flock",
-		25646: "floe",
-		25651: "flog",
-		25652: "flood",
-		25653: "floor",
-		25654: "flop",
-		25655: "floppy",
-		25656: "flora",
-		25661: "flour",
-		25662: "flout",
-		25663: "flow",
-		25664: "flown",
-		25665: "floyd",
-		25666: "flu",
-		26111: "flub",
-		26112: "flue",
-		26113: "fluff",
-		26114: "fluid",
-		26115: "fluke",
-		26116: "flung",
-		26121: "flush",
-		26122: "flute",
-		26123: "flux",
-		26124: "fly",
-		26125: "flyer",
-		26126: "flynn",
-		26131: "fm",
-		26132: "fmc",
-		26133: "fn",
-		26134: "fo",
-		26135: "foal",
-		26136: "foam",
-		26141: "foamy",
-		26142: "fob",
-		26143: "focal",
-		26144: "foci",
-		26145: "focus",
-		26146: "fodder",
-		26151: "foe",
-		26152: "fog",
-		26153: "foggy",
-		26154: "fogy",
-		26155: "foil",
-		26156: "foist",
-		26161: "fold",
-		26162: "foley",
-		26163: "folio",
-		26164: "folk",
-		26165: "folly",
-		26166: "fond",
-		26211: "font",
-		26212: "food",
-		26213: "fool",
-		26214: "foot",
-		26215: "foote",
-		26216: "fop",
-		26221: "for",
-		26222: "foray",
-		26223: "force",
-		26224: "ford",
-		26225: "fore",
-		26226: "forge",
-		26231: "forgot",
-		26232: "fork",
-		26233: "form",
-		26234: "fort",
-		26235: "forte",
-		26236: "forth",
-		26241: "forty",
-		26242: "forum",
-		26243: "foss",
-		26244: "fossil",
-		26245: "foul",
-		26246: "found",
-		26251: "fount",
-		26252: "four",
-		26253: "fovea",
-		26254: "fowl",
-		26255: "fox",
-		26256: "foxy",
-		26261: "foyer",
-		26262: "fp",
-		26263: "fpc",
-		26264: "fq",
-		26265: "fr",
-		26266: "frail",
-		26311: "frame",
-		26312: "fran",
-		26313: "franc",
-		26314: "franca",
-		26315: "frank",
-		26316: "franz",
-		26321: "frau",
-		26322: "fraud",
-		26323: "fray",
-		26324: "freak",
-		26325: "fred",
-		26326: "free",
-		26331: "freed",
-		26332: "freer",
-		26333: "frenzy",
-		26334: "freon",
-		26335: "fresh",
-		26336: "fret",
-		26341: "freud",
-		26342: "frey",
-		26343: "freya",
-		26344: "friar",
-		26345: "frick",
-		26346: "fried",
-		26351: "frill",
-		26352: "frilly",
-		26353: "frisky",
-		26354: "fritz",
-		26355: "fro",
-		26356: "frock",
-		26361: "frog",
-		26362: "from",
-		26363: "front",
-		26364: "frost",
-		26365: "froth",
-		26366: "frown",
-		26411: "froze",
-		26412: "fruit",
-		26413: "fry",
-		26414: "frye",
-		26415: "fs",
-		26416: "ft",
-		26421: "ftc",
-		26422: "fu",
-		26423: "fuchs",
-		26424: "fudge",
-		26425: "fuel",
-		26426: "fugal",
-		26431: "fugue",
-		26432: "fuji",
-		26433: "full",
-		26434: "fully",
-		26435: "fum",
-		26436: "fume",
-		26441: "fun",
-		26442: "fund",
-		26443: "fungal",
-		26444: "fungi",
-		26445: "funk",
-		26446: "funny",
-		26451: "fur",
-		26452: "furl",
-		26453: "furry",
-		26454: "fury",
-		26455: "furze",
-		26456: "fuse",
-		26461: "fuss",
-		26462: "fussy",
-		26463: "fusty",
-		26464: "fuzz",
-		26465: "fuzzy",
-		26466: "fv",
-		26511: "fw",
-		26512: "fx",
-		26513: "fy",
-		26514: "fz",
-		26515: "g",
-		26516: "g's",
-		26521: "ga",
-		26522: "gab",
-		26523: "gable",
-		26524: "gabon",
-		26525: "gad",
-		26526: "gadget",
-		26531: "gaff",
-		26532: "gaffe",
-		26533: "gag",
-		26534: "gage",
-		26535: "gail",
-		26536: "gain",
-		26541: "gait",
-		26542: "gal",
-		26543: "gala",
-		26544: "galaxy",
-		26545: "gale",
-		26546: "galen",
-		26551: "gall",
-		26552: "gallop",
-		26553: "galt",
-		26554: "gam",
-		26555: "game",
-		26556: "gamin",
-		26561: "gamma",
-		26562: "gamut",
-		26563: "gander",
-		26564: "gang",
-		26565: "gao",
-		26566: "gap",
-		26611: "gape",
-		26612: "gar",
-		26613: "garb",
-		26614: "garish",
-		26615: "garner",
-		26616: "garry",
-		26621: "garth",
-		26622: "gary",
-		26623: "gas",
-		26624: "gash",
-		26625: "gasp",
-		26626: "gassy",
-		26631: "gate",
-		26632: "gates",
-		26633: "gator",
-		26634: "gauche",
-		26635: "gaudy",
-		26636: "gauge",
-		26641: "gaul",
-		26642: "gaunt",
-		26643: "gaur",
-		26644: "gauss",
-		26645: "gauze",
-		26646: "gave",
-		26651: "gavel",
-		26652: "gavin",
-		26653: "gawk",
-		26654: "gawky",
-		26655: "gay",
-		26656: "gaze",
-		26661: "gb",
-		26662: "gc",
-		26663: "gd",
-		26664: "ge",
-		26665: "gear",
-		26666: "gecko",
-		31111: "gee",
-		31112: "geese",
-		31113: "geigy",
-		31114: "gel",
-		31115: "geld",
-		31116: "gem",
-		31121: "gemma",
-		31122: "gene",
-		31123: "genie",
-		31124: "genii",
-		31125: "genoa",
-		31126: "genre",
-		31131: "gent",
-		31132: "gentry",
-		31133: "genus",
-		31134: "gerbil",
-		31135: "germ",
-		31136: "gerry",
-		31141: "get",
-		31142: "getty",
-		31143: "gf",
-		31144: "gg",
-		31145: "ggg",
-		31146: "gggg",
-		31151: "gh",
-		31152: "ghana",
-		31153: "ghent",
-		31154: "ghetto",
-		31155: "ghi",
-		31156: "ghost",
-		31161: "ghoul",
-		31162: "gi",
-		31163: "giant",
-		31164: "gibbs",
-		31165: "gibby",
-		31166: "gibe",
-		31211: "giddy",
-		31212: "gift",
-		31213: "gig",
-		31214: "gil",
-		31215: "gila",
-		31216: "gild",
-		31221: "giles",
-		31222: "gill",
-		31223: "gilt",
-		31224: "gimbal",
-		31225: "gimpy",
-		31226: "gin",
-		31231: "gina",
-		31232: "ginn",
-		31233: "gino",
-		31234: "gird",
-		31235: "girl",
-		31236: "girth",
-		31241: "gist",
-		31242: "give",
-		31243: "given",
-		31244: "gj",
-		31245: "gk",
-		31246: "gl",
-		31251: "glad",
-		31252: "gladdy",
-		31253: "glade",
-		31254: "glamor",
-		31255: "gland",
-		31256: "glans",
-		31261: "glare",
-		31262: "glass",
-		31263: "glaze",
-		31264: "gleam",
-		31265: "glean",
-		31266: "glee",
-		31311: "glen",
-		31312: "glenn",
-		31313: "glib",
-		31314: "glide",
-		31315: "glint",
-		31316: "gloat",
-		31321: "glob",
-		31322: "globe",
-		31323: "glom",
-		31324: "gloom",
-		31325: "glory",
-		31326: "gloss",
-		31331: "glove",
-		31332: "glow",
-		31333: "glue",
-		31334: "glued",
-		31335: "gluey",
-		31336: "gluing",
-		31341: "glum",
-		31342: "glut",
-		31343: "glyph",
-		31344: "gm",
-		31345: "gmt",
-		31346: "gn",
-		31351: "gnarl",
-		31352: "gnash",
-		31353: "gnat",
-		31354: "gnaw",
-		31355: "gnome",
-		31356: "gnp",
-		31361: "gnu",
-		31362: "go",
-		31363: "goa",
-		31364: "goad",
-		31365: "goal",
-		31366: "goat",
-		31411: "gob",
-		31412: "goer",
-		31413: "goes",
-		31414: "goff",
-		31415: "gog",
-		31416: "goggle",
-		31421: "gogh",
-		31422: "gogo",
-		31423: "gold",
-		31424: "golf",
-		31425: "golly",
-		31426: "gone",
-		31431: "gong",
-		31432: "goo",
-		31433: "good",
-		31434: "goode",
-		31435: "goody",
-		31436: "goof",
-		31441: "goofy",
-		31442: "goose",
-		31443: "gop",
-		31444: "gordon",
-		31445: "gore",
-		31446: "goren",
-		31451: "gorge",
-		31452: "gorky",
-		31453: "gorse",
-		31454: "gory",
-		31455: "gosh",
-		31456: "gospel",
-		31461: "got",
-		31462: "gouda",
-		31463: "gouge",
-		31464: "gould",
-		31465: "gourd",
-		31466: "gout",
-		31511: "gown",
-		31512: "gp",
-		31513: "gpo",
-		31514: "gq",
-		31515: "gr",
-		31516: "grab",
-		31521: "grace",
-		31522: "grad",
-		31523: "grade",
-		31524: "grady",
-		31525: "graff",
-		31526: "graft",
-		31531: "grail",
-		31532: "grain",
-		31533: "grand",
-		31534: "grant",
-		31535: "grape",
-		31536: "graph",
-		31541: "grasp",
-		31542: "grass",
-		31543: "grata",
-		31544: "grate",
-		31545: "grater",
-		31546: "grave",
-		31551: "gravy",
-		31552: "gray",
-		31553: "graze",
-		31554: "great",
-		31555: "grebe",
-		31556: "greed",
-		31561: "greedy",
-		31562: "greek",
-		31563: "green",
-		31564: "greer",
-		31565: "greet",
-		31566: "greg",
-		31611: "gregg",
-		31612: "greta",
-		31613: "grew",
-		31614: "grey",
-		31615: "grid",
-		31616: "grief",
-		31621: "grieve",
-		31622: "grill",
-		31623: "grim",
-		31624: "grime",
-		31625: "grimm",
-		31626: "grin",
-		31631: "grind",
-		31632: "grip",
-		31633: "gripe",
-		31634: "grist",
-		31635: "grit",
-		31636: "groan",
-		31641: "groat",
-		31642: "groin",
-		31643: "groom",
-		31644: "grope",
-		31645: "gross",
-		31646: "groton",
-		31651: "group",
-		31652: "grout",
-		31653: "grove",
-		31654: "grow",
-		31655: "growl",
-		31656: "grown",
-		31661: "grub",
-		31662: "gruff",
-		31663: "grunt",
-		31664: "gs",
-		31665: "gsa",
-		31666: "gt",
-		32111: "gu",
-		32112: "guam",
-		32113: "guano",
-		32114: "guard",
-		32115: "guess",
-		32116: "guest",
-		32121: "guide",
-		32122: "guild",
-		32123: "guile",
-		32124: "guilt",
-		32125: "guise",
-		32126: "guitar",
-		32131: "gules",
-		32132: "gulf",
-		32133: "gull",
-		32134: "gully",
-		32135: "gulp",
-		32136: "gum",
-		32141: "gumbo",
-		32142: "gummy",
-		32143: "gun",
-		32144: "gunk",
-		32145: "gunky",
-		32146: "gunny",
-		32151: "gurgle",
-		32152: "guru",
-		32153: "gus",
-		32154: "gush",
-		32155: "gust",
-		32156: "gusto",
-		32161: "gusty",
-		32162: "gut",
-		32163: "gutsy",
-		32164: "guy",
-		32165: "guyana",
-		32166: "gv",
-		32211: "gw",
-		32212: "gwen",
-		32213: "gwyn",
-		32214: "gx",
-		32215: "gy",
-		32216: "gym",
-		32221: "gyp",
-		32222: "gypsy",
-		32223: "gyro",
-		32224: "gz",
-		32225: "h",
-		32226: "h's",
-		32231: "ha",
-		32232: "haag",
-		32233: "haas",
-		32234: "habib",
-		32235: "habit",
-		32236: "hack",
-		32241: "had",
-		32242: "hades",
-		32243: "hadron",
-		32244: "hagen",
-		32245: "hager",
-		32246: "hague",
-		32251: "hahn",
-		32252: "haifa",
-		32253: "haiku",
-		32254: "hail",
-		32255: "hair",
-		32256: "hairy",
-		32261: "haiti",
-		32262: "hal",
-		32263: "hale",
-		32264: "haley",
-		32265: "half",
-		32266: "hall",
-		32311: "halma",
-		32312: "halo",
-		32313: "halt",
-		32314: "halvah",
-		32315: "halve",
-		32316: "ham",
-		32321: "hamal",
-		32322: "hamlin",
-		32323: "han",
-		32324: "hand",
-		32325: "handy",
-		32326: "haney",
-		32331: "hang",
-		32332: "hank",
-		32333: "hanna",
-		32334: "hanoi",
-		32335: "hans",
-		32336: "hansel",
-		32341: "hap",
-		32342: "happy",
-		32343: "hard",
-		32344: "hardy",
-		32345: "hare",
-		32346: "harem",
-		32351: "hark",
-		32352: "harley",
-		32353: "harm",
-		32354: "harp",
-		32355: "harpy",
-		32356: "harry",
-		32361: "harsh",
-		32362: "hart",
-		32363: "harvey",
-		32364: "hash",
-		32365: "hasp",
-		32366: "hast",
-		32411: "haste",
-		32412: "hasty",
-		32413: "hat",
-		32414: "hatch",
-		32415: "hate",
-		32416: "hater",
-		32421: "hath",
-		32422: "hatred",
-		32423: "haul",
-		32424: "haunt",
-		32425: "have",
-		32426: "haven",
-		32431: "havoc",
-		32432: "haw",
-		32433: "hawk",
-		32434: "hay",
-		32435: "haydn",
-		32436: "hayes",
-		32441: "hays",
-		32442: "hazard",
-		32443: "haze",
-		32444: "hazel",
-		32445: "hazy",
-		32446: "hb",
-		32451: "hc",
-		32452: "hd",
-		32453: "he",
-		32454: "he'd",
-		32455: "he'll",
-		32456: "head",
-		32461: "heady",
-		32462: "heal",
-		32463: "healy",
-		32464: "heap",
-		32465: "hear",
-		32466: "heard",
-		32511: "heart",
-		32512: "heat",
-		32513: "heath",
-		32514: "heave",
-		32515: "heavy",
-		32516: "hebe",
-		32521: "hebrew",
-		32522: "heck",
-		32523: "heckle",
-		32524: "hedge",
-		32525: "heed",
-		32526: "heel",
-		32531: "heft",
-		32532: "hefty",
-		32533: "heigh",
-		32534: "heine",
-		32535: "heinz",
-		32536: "heir",
-		32541: "held",
-		32542: "helen",
-		32543: "helga",
-		32544: "helix",
-		32545: "hell",
-		32546: "hello",
-		32551: "helm",
-		32552: "helmut",
-		32553: "help",
-		32554: "hem",
-		32555: "hemp",
-		32556: "hen",
-		32561: "hence",
-		32562: "henri",
-		32563: "henry",
-		32564: "her",
-		32565: "hera",
-		32566: "herb",
-		32611: "herd",
-		32612: "here",
-		32613: "hero",
-		32614: "heroic",
-		32615: "heron",
-		32616: "herr",
-		32621: "hertz",
-		32622: "hess",
-		32623: "hesse",
-		32624: "hettie",
-		32625: "hetty",
-		32626: "hew",
-		32631: "hewitt",
-		32632: "hewn",
-		32633: "hex",
-		32634: "hey",
-		32635: "hf",
-		32636: "hg",
-		32641: "hh",
-		32642: "hhh",
-		32643: "hhhh",
-		32644: "hi",
-		32645: "hiatt",
-		32646: "hick",
-		32651: "hicks",
-		32652: "hid",
-		32653: "hide",
-		32654: "high",
-		32655: "hij",
-		32656: "hike",
-		32661: "hill",
-		32662: "hilly",
-		32663: "hilt",
-		32664: "hilum",
-		32665: "him",
-		32666: "hind",
-		33111: "hindu",
-		33112: "hines",
-		33113: "hinge",
-		33114: "hint",
-		33115: "hip",
-		33116: "hippo",
-		33121: "hippy",
-		33122: "hiram",
-		33123: "hire",
-		33124: "hirsch",
-		33125: "his",
-		33126: "hiss",
-		33131: "hit",
-		33132: "hitch",
-		33133: "hive",
-		33134: "hj",
-		33135: "hk",
-		33136: "hl",
-		33141: "hm",
-		33142: "hn",
-		33143: "ho",
-		33144: "hoagy",
-		33145: "hoar",
-		33146: "hoard",
-		33151: "hob",
-		33152: "hobbs",
-		33153: "hobby",
-		33154: "hobo",
-		33155: "hoc",
-		33156: "hock",
-		33161: "hodge",
-		33162: "hodges",
-		33163: "hoe",
-		33164: "hoff",
-		33165: "hog",
-		33166: "hogan",
-		33211: "hoi",
-		33212: "hokan",
-		33213: "hold",
-		33214: "holdup",
-		33215: "hole",
-		33216: "holly",
-		33221: "holm",
-		33222: "holst",
-		33223: "holt",
-		33224: "home",
-		33225: "homo",
-		33226: "honda",
-		33231: "hondo",
-		33232: "hone",
-		33233: "honey",
-		33234: "hong",
-		33235: "honk",
-		33236: "hooch",
-		33241: "hood",
-		33242: "hoof",
-		33243: "hook",
-		33244: "hookup",
-		33245: "hoop",
-		33246: "hoot",
-		33251: "hop",
-		33252: "hope",
-		33253: "horde",
-		33254: "horn",
-		33255: "horny",
-		33256: "horse",
-		33261: "horus",
-		33262: "hose",
-		33263: "host",
-		33264: "hot",
-		33265: "hotbox",
-		33266: "hotel",
-		33311: "hough",
-		33312: "hound",
-		33313: "hour",
-		33314: "house",
-		33315: "hove",
-		33316: "hovel",
-		33321: "hover",
-		33322: "how",
-		33323: "howdy",
-		33324: "howe",
-		33325: "howl",
-		33326: "hoy",
-		33331: "hoyt",
-		33332: "hp",
-		33333: "hq",
-		33334: "hr",
-		33335: "hs",
-		33336: "ht",
-		33341: "hu",
-		33342: "hub",
-		33343: "hubbub",
-		33344: "hubby",
-		33345: "huber",
-		33346: "huck",
-		33351: "hue",
-		33352: "hued",
-		33353: "huff",
-		33354: "hug",
-		33355: "huge",
-		33356: "hugh",
-		33361: "hughes",
-		33362: "hugo",
-		33363: "huh",
-		33364: "hulk",
-		33365: "hull",
-		33366: "hum",
-		33411: "human",
-		33412: "humid",
-		33413: "hump",
-		33414: "humus",
-		33415: "hun",
-		33416: "hunch",
-		33421: "hung",
-		33422: "hunk",
-		33423: "hunt",
-		33424: "hurd",
-		33425: "hurl",
-		33426: "huron",
-		33431: "hurrah",
-		33432: "hurry",
-		33433: "hurst",
-		33434: "hurt",
-		33435: "hurty",
-		33436: "hush",
-		33441: "husky",
-		33442: "hut",
-		33443: "hutch",
-		33444: "hv",
-		33445: "hw",
-		33446: "hx",
-		33451: "hy",
-		33452: "hyde",
-		33453: "hydra",
-		33454: "hydro",
-		33455: "hyena",
-		33456: "hying",
-		33461: "hyman",
-		33462: "hymen",
-		33463: "hymn",
-		33464: "hymnal",
-		33465: "hz",
-		33466: "i",
-		33511: "i'd",
-		33512: "i'll",
-		33513: "i'm",
-		33514: "i's",
-		33515: "i've",
-		33516: "ia",
-		33521: "iambic",
-		33522: "ian",
-		33523: "ib",
-		33524: "ibex",
-		33525: "ibid",
-		33526: "ibis",
-		33531: "ibm",
-		33532: "ibn",
-		33533: "ic",
-		33534: "icc",
-		33535: "ice",
-		33536: "icing",
-		33541: "icky",
-		33542: "icon",
-		33543: "icy",
-		33544: "id",
-		33545: "ida",
-		33546: "idaho",
-		33551: "idea",
-		33552: "ideal",
-		33553: "idiom",
-		33554: "idiot",
-		33555: "idle",
-		33556: "idol",
-		33561: "idyll",
-		33562: "ie",
-		33563: "ieee",
-		33564: "if",
-		33565: "iffy",
-		33566: "ifni",
-		33611: "ig",
-		33612: "igloo",
-		33613: "igor",
-		33614: "ih",
-		33615: "ii",
-		33616: "iii",
-		33621: "iiii",
-		33622: "ij",
-		33623: "ijk",
-		33624: "ik",
-		33625: "ike",
-		33626: "il",
-		33631: "ileum",
-		33632: "iliac",
-		33633: "iliad",
-		33634: "ill",
-		33635: "illume",
-		33636: "ilona",
-		33641: "im",
-		33642: "image",
-		33643: "imbue",
-		33644: "imp",
-		33645: "impel",
-		33646: "import",
-		33651: "impute",
-		33652: "in",
-		33653: "inane",
-		33654: "inapt",
-		33655: "inc",
-		33656: "inca",
-		33661: "incest",
-		33662: "inch",
-		33663: "incur",
-		33664: "index",
-		33665: "india",
-		33666: "indies",
-		34111: "indy",
-		34112: "inept",
-		34113: "inert",
-		34114: "infect",
-		34115: "infer",
-		34116: "infima",
-		34121: "infix",
-		34122: "infra",
-		34123: "ingot",
-		34124: "inhere",
-		34125: "injun",
-		34126: "ink",
-		34131: "inlay",
-		34132: "inlet",
-		34133: "inman",
-		34134: "inn",
-		34135: "inner",
-		34136: "input",
-		34141: "insect",
-		34142: "inset",
-		34143: "insult",
-		34144: "intend",
-		34145: "inter",
-		34146: "into",
-		34151: "inure",
-		34152: "invoke",
-		34153: "io",
-		34154: "ion",
-		34155: "ionic",
-		34156: "iota",
-		34161: "iowa",
-		34162: "ip",
-		34163: "ipso",
-		34164: "iq",
-		34165: "ir",
-		34166: "ira",
-		34211: "iran",
-		34212: "iraq",
-		34213: "irate",
-		34214: "ire",
-		34215: "irene",
-		34216: "iris",
-		34221: "irish",
-		34222: "irk",
-		34223: "irma",
-		34224: "iron",
-		34225: "irony",
-		34226: "irs",
-		34231: "irvin",
-		34232: "irwin",
-		34233: "is",
-		34234: "isaac",
-		34235: "isabel",
-		34236: "ising",
-		34241: "isis",
-		34242: "islam",
-		34243: "island",
-		34244: "isle",
-		34245: "isn't",
-		34246: "israel",
-		34251: "issue",
-		34252: "it",
-		34253: "it&t",
-		34254: "it'd",
-		34255: "it'll",
-		34256: "italy",
-		34261: "itch",
-		34262: "item",
-		34263: "ito",
-		34264: "itt",
-		34265: "iu",
-		34266: "iv",
-		34311: "ivan",
-		34312: "ive",
-		34313: "ivory",
-		34314: "ivy",
-		34315: "iw",
-		34316: "ix",
-		34321: "iy",
-		34322: "iz",
-		34323: "j",
-		34324: "j's",
-		34325: "ja",
-		34326: "jab",
-		34331: "jack",
-		34332: "jacky",
-		34333: "jacm",
-		34334: "jacob",
-		34335: "jacobi",
-		34336: "jade",
-		34341: "jag",
-		34342: "jail",
-		34343: "jaime",
-		34344: "jake",
-		34345: "jam",
-		34346: "james",
-		34351: "jan",
-		34352: "jane",
-		34353: "janet",
-		34354: "janos",
-		34355: "janus",
-		34356: "japan",
-		34361: "jar",
-		34362: "jason",
-		34363: "java",
-		34364: "jaw",
-		34365: "jay",
-		34366: "jazz",
-		34411: "jazzy",
-		34412: "jb",
-		34413: "jc",
-		34414: "jd",
-		34415: "je",
-		34416: "jean",
-		34421: "jed",
-		34422: "jeep",
-		34423: "jeff",
-		34424: "jejune",
-		34425: "jelly",
-		34426: "jenny",
-		34431: "jeres",
-		34432: "jerk",
-		34433: "jerky",
-		34434: "jerry",
-		34435: "jersey",
-		34436: "jess",
-		34441: "jesse",
-		34442: "jest",
-		34443: "jesus",
-		34444: "jet",
-		34445: "jew",
-		34446: "jewel",
-		34451: "jewett",
-		34452: "jewish",
-		34453: "jf",
-		34454: "jg",
-		34455: "jh",
-		34456: "ji",
-		34461: "jibe",
-		34462: "jiffy",
-		34463: "jig",
-		34464: "jill",
-		34465: "jilt",
-		34466: "jim",
-		34511: "jimmy",
-		34512: "jinx",
-		34513: "jive",
-		34514: "jj",
-		34515: "jjj",
-		34516: "jjjj",
-		34521: "jk",
-		34522: "jkl",
-		34523: "jl",
-		34524: "jm",
-		34525: "jn",
-		34526: "jo",
-		34531: "joan",
-		34532: "job",
-		34533: "jock",
-		34534: "jockey",
-		34535: "joe",
-		34536: "joel",
-		34541: "joey",
-		34542: "jog",
-		34543: "john",
-		34544: "johns",
-		34545: "join",
-		34546: "joint",
-		34551: "joke",
-		34552: "jolla",
-		34553: "jolly",
-		34554: "jolt",
-		34555: "jon",
-		34556: "jonas",
-		34561: "jones",
-		34562: "jorge",
-		34563: "jose",
-		34564: "josef",
-		34565: "joshua",
-		34566: "joss",
-		34611: "jostle",
-		34612: "jot",
-		34613: "joule",
-		34614: "joust",
-		34615: "jove",
-		34616: "jowl",
-		34621: "jowly",
-		34622: "joy",
-		34623: "joyce",
-		34624: "jp",
-		34625: "jq",
-		34626: "jr",
-		34631: "js",
-		34632: "jt",
-		34633: "ju",
-		34634: "juan",
-		34635: "judas",
-		34636: "judd",
-		34641: "jude",
-		34642: "judge",
-		34643: "judo",
-		34644: "judy",
-		34645: "jug",
-		34646: "juggle",
-		34651: "juice",
-		34652: "juicy",
-		34653: "juju",
-		34654: "juke",
-		34655: "jukes",
-		34656: "julep",
-		34661: "jules",
-		34662: "julia",
-		34663: "julie",
-		34664: "julio",
-		34665: "july",
-		34666: "jumbo",
-		35111: "jump",
-		35112: "jumpy",
-		35113: "junco",
-		35114: "june",
-		35115: "junk",
-		35116: "junky",
-		35121: "juno",
-		35122: "junta",
-		35123: "jura",
-		35124: "jure",
-		35125: "juror",
-		35126: "jury",
-		35131: "just",
-		35132: "jut",
-		35133: "jute",
-		35134: "jv",
-		35135: "jw",
-		35136: "jx",
-		35141: "jy",
-		35142: "jz",
-		35143: "k",
-		35144: "k's",
-		35145: "ka",
-		35146: "kabul",
-		35151: "kafka",
-		35152: "kahn",
-		35153: "kajar",
-		35154: "kale",
-		35155: "kalmia",
-		35156: "kane",
-		35161: "kant",
-		35162: "kapok",
-		35163: "kappa",
-		35164: "karate",
-		35165: "karen",
-		35166: "karl",
-		35211: "karma",
-		35212: "karol",
-		35213: "karp",
-		35214: "kate",
-		35215: "kathy",
-		35216: "katie",
-		35221: "katz",
-		35222: "kava",
-		35223: "kay",
-		35224: "kayo",
-		35225: "kazoo",
-		35226: "kb",
-		35231: "kc",
-		35232: "kd",
-		35233: "ke",
-		35234: "keats",
-		35235: "keel",
-		35236: "keen",
-		35241: "keep",
-		35242: "keg",
-		35243: "keith",
-		35244: "keller",
-		35245: "kelly",
-		35246: "kelp",
-		35251: "kemp",
-		35252: "ken",
-		35253: "keno",
-		35254: "kent",
-		35255: "kenya",
-		35256: "kepler",
-		35261: "kept",
-		35262: "kern",
-		35263: "kerr",
-		35264: "kerry",
-		35265: "ketch",
-		35266: "kevin",
-		35311: "key",
-		35312: "keyed",
-		35313: "keyes",
-		35314: "keys",
-		35315: "kf",
-		35316: "kg",
-		35321: "kh",
-		35322: "khaki",
-		35323: "khan",
-		35324: "khmer",
-		35325: "ki",
-		35326: "kick",
-		35331: "kid",
-		35332: "kidde",
-		35333: "kidney",
-		35334: "kiev",
-		35335: "kigali",
-		35336: "kill",
-		35341: "kim",
-		35342: "kin",
-		35343: "kind",
-		35344: "king",
-		35345: "kink",
-		35346: "kinky",
-		35351: "kiosk",
-		35352: "kiowa",
-		35353: "kirby",
-		35354: "kirk",
-		35355: "kirov",
-		35356: "kiss",
-		35361: "kit",
-		35362: "kite",
-		35363: "kitty",
-		35364: "kiva",
-		35365: "kivu",
-		35366: "kiwi",
-		35411: "kj",
-		35412: "kk",
-		35413: "kkk",
-		35414: "kkkk",
-		35415: "kl",
-		35416: "klan",
-		35421: "klaus",
-		35422: "klein",
-		35423: "kline",
-		35424: "klm",
-		35425: "klux",
-		35426: "km",
-		35431: "kn",
-		35432: "knack",
-		35433: "knapp",
-		35434: "knauer",
-		35435: "knead",
-		35436: "knee",
-		35441: "kneel",
-		35442: "knelt",
-		35443: "knew",
-		35444: "knick",
-		35445: "knife",
-		35446: "knit",
-		35451: "knob",
-		35452: "knock",
-		35453: "knoll",
-		35454: "knot",
-		35455: "knott",
-		35456: "know",
-		35461: "known",
-		35462: "knox",
-		35463: "knurl",
-		35464: "ko",
-		35465: "koala",
-		35466: "koch",
-		35511: "kodak",
-		35512: "kola",
-		35513: "kombu",
-		35514: "kong",
-		35515: "koran",
-		35516: "korea",
-		35521: "kp",
-		35522: "kq",
-		35523: "kr",
-		35524: "kraft",
-		35525: "krause",
-		35526: "kraut",
-		35531: "krebs",
-		35532: "kruse",
-		35533: "ks",
-		35534: "kt",
-		35535: "ku",
-		35536: "kudo",
-		35541: "kudzu",
-		35542: "kuhn",
-		35543: "kulak",
-		35544: "kurd",
-		35545: "kurt",
-		35546: "kv",
-		35551: "kw",
-		35552: "kx",
-		35553: "ky",
-		35554: "kyle",
-		35555: "kyoto",
-		35556: "kz",
-		35561: "l",
-		35562: "l's",
-		35563: "la",
-		35564: "lab",
-		35565: "laban",
-		35566: "label",
-		35611: "labia",
-		35612: "labile",
-		35613: "lac",
-		35614: "lace",
-		35615: "lack",
-		35616: "lacy",
-		35621: "lad",
-		35622: "laden",
-		35623: "ladle",
-		35624: "lady",
-		35625: "lag",
-		35626: "lager",
-		35631: "lagoon",
-		35632: "lagos",
-		35633: "laid",
-		35634: "lain",
-		35635: "lair",
-		35636: "laity",
-		35641: "lake",
-		35642: "lam",
-		35643: "lamar",
-		35644: "lamb",
-		35645: "lame",
-		35646: "lamp",
-		35651: "lana",
-		35652: "lance",
-		35653: "land",
-		35654: "lane",
-		35655: "lang",
-		35656: "lange",
-		35661: "lanka",
-		35662: "lanky",
-		35663: "lao",
-		35664: "laos",
-		35665: "lap",
-		35666: "lapel",
-		36111: "lapse",
-		36112: "larch",
-		36113: "lard",
-		36114: "lares",
-		36115: "large",
-		36116: "lark",
-		36121: "larkin",
-		36122: "larry",
-		36123: "lars",
-		36124: "larva",
-		36125: "lase",
-		36126: "lash",
-		36131: "lass",
-		36132: "lasso",
-		36133: "last",
-		36134: "latch",
-		36135: "late",
-		36136: "later",
-		36141: "latest",
-		36142: "latex",
-		36143: "lath",
-		36144: "lathe",
-		36145: "latin",
-		36146: "latus",
-		36151: "laud",
-		36152: "laue",
-		36153: "laugh",
-		36154: "launch",
-		36155: "laura",
-		36156: "lava",
-		36161: "law",
-		36162: "lawn",
-		36163: "lawson",
-		36164: "lax",
-		36165: "lay",
-		36166: "layup",
-		36211: "laze",
-		36212: "lazy",
-		36213: "lb",
-		36214: "lc",
-		36215: "ld",
-		36216: "le",
-		36221: "lea",
-		36222: "leach",
-		36223: "lead",
-		36224: "leaf",
-		36225: "leafy",
-		36226: "leak",
-		36231: "leaky",
-		36232: "lean",
-		36233: "leap",
-		36234: "leapt",
-		36235: "lear",
-		36236: "learn",
-		36241: "lease",
-		36242: "leash",
-		36243: "least",
-		36244: "leave",
-		36245: "led",
-		36246: "ledge",
-		36251: "lee",
-		36252: "leech",
-		36253: "leeds",
-		36254: "leek",
-		36255: "leer",
-		36256: "leery",
-		36261: "leeway",
-		36262: "left",
-		36263: "lefty",
-		36264: "leg",
-		36265: "legal",
-		36266: "leggy",
-		36311: "legion",
-		36312: "leigh",
-		36313: "leila",
-		36314: "leland",
-		36315: "lemma",
-		36316: "lemon",
-		36321: "len",
-		36322: "lena",
-		36323: "lend",
-		36324: "lenin",
-		36325: "lenny",
-		36326: "lens",
-		36331: "lent",
-		36332: "leo",
-		36333: "leon",
-		36334: "leona",
-		36335: "leone",
-		36336: "leper",
-		36341: "leroy",
-		36342: "less",
-		36343: "lessee",
-		36344: "lest",
-		36345: "let",
-		36346: "lethe",
-		36351: "lev",
-		36352: "levee",
-		36353: "level",
-		36354: "lever",
-		36355: "levi",
-		36356: "levin",
-		36361: "levis",
-		36362: "levy",
-		36363: "lew",
-		36364: "lewd",
-		36365: "lewis",
-		36366: "leyden",
-		36411: "lf",
-		36412: "lg",
-		36413: "lh",
-		36414: "li",
-		36415: "liar",
-		36416: "libel",
-		36421: "libido",
-		36422: "libya",
-		36423: "lice",
-		36424: "lick",
-		36425: "lid",
-		36426: "lie",
-		36431: "lied",
-		36432: "lien",
-		36433: "lieu",
-		36434: "life",
-		36435: "lifo",
-		36436: "lift",
-		36441: "light",
-		36442: "like",
-		36443: "liken",
-		36444: "lila",
-		36445: "lilac",
-		36446: "lilly",
-		36451: "lilt",
-		36452: "lily",
-		36453: "lima",
-		36454: "limb",
-		36455: "limbo",
-		36456: "lime",
-		36461: "limit",
-		36462: "limp",
-		36463: "lin",
-		36464: "lind",
-		36465: "linda",
-		36466: "linden",
-		36511: "line",
-		36512: "linen",
-		36513: "lingo",
-		36514: "link",
-		36515: "lint",
-		36516: "linus",
-		36521: "lion",
-		36522: "lip",
-		36523: "lipid",
-		36524: "lisa",
-		36525: "lise",
-		36526: "lisle",
-		36531: "lisp",
-		36532: "list",
-		36533: "listen",
-		36534: "lit",
-		36535: "lithe",
-		36536: "litton",
-		36541: "live",
-		36542: "liven",
-		36543: "livid",
-		36544: "livre",
-		36545: "liz",
-		36546: "lizzie",
-		36551: "lj",
-		36552: "lk",
-		36553: "ll",
-		36554: "lll",
-		36555: "llll",
-		36556: "lloyd",
-		36561: "lm",
-		36562: "lmn",
-		36563: "ln",
-		36564: "lo",
-		36565: "load",
-		36566: "loaf",
-		36611: "loam",
-		36612: "loamy",
-		36613: "loan",
-		36614: "loath",
-		36615: "lob",
-		36616: "lobar",
-		36621: "lobby",
-		36622: "lobe",
-		36623: "lobo",
-		36624: "local",
-		36625: "loci",
-		36626: "lock",
-		36631: "locke",
-		36632: "locus",
-		36633: "lodge",
-		36634: "loeb",
-		36635: "loess",
-		36636: "loft",
-		36641: "lofty",
-		36642: "log",
-		36643: "logan",
-		36644: "loge",
-		36645: "logic",
-		36646: "loin",
-		36651: "loire",
-		36652: "lois",
-		36653: "loiter",
-		36654: "loki",
-		36655: "lola",
-		36656: "loll",
-		36661: "lolly",
-		36662: "lomb",
-		36663: "lome",
-		36664: "lone",
-		36665: "long",
-		36666: "look",
-		41111: "loom",
-		41112: "loon",
-		41113: "loop",
-		41114: "loose",
-		41115: "loot",
-		41116: "lop",
-		41121: "lope",
-		41122: "lopez",
-		41123: "lord",
-		41124: "lore",
-		41125: "loren",
-		41126: "los",
-		41131: "lose",
-		41132: "loss",
-		41133: "lossy",
-		41134: "lost",
-		41135: "lot",
-		41136: "lotte",
-		41141: "lotus",
-		41142: "lou",
-		41143: "loud",
-		41144: "louis",
-		41145: "louise",
-		41146: "louse",
-		41151: "lousy",
-		41152: "louver",
-		41153: "love",
-		41154: "low",
-		41155: "lowe",
-		41156: "lower",
-		41161: "lowry",
-		41162: "loy",
-		41163: "loyal",
-		41164: "lp",
-		41165: "lq",
-		41166: "lr",
-		41211: "ls",
-		41212: "lsi",
-		41213: "lt",
-		41214: "ltv",
-		41215: "lu",
-		41216: "lucas",
-		41221: "lucia",
-		41222: "lucid",
-		41223: "luck",
-		41224: "lucky",
-		41225: "lucre",
-		41226: "lucy",
-		41231: "lug",
-		41232: "luge",
-		41233: "luger",
-		41234: "luis",
-		41235: "luke",
-		41236: "lull",
-		41241: "lulu",
-		41242: "lumbar",
-		41243: "lumen",
-		41244: "lump",
-		41245: "lumpy",
-		41246: "lunar",
-		41251: "lunch",
-		41252: "lund",
-		41253: "lung",
-		41254: "lunge",
-		41255: "lura",
-		41256: "lurch",
-		41261: "lure",
-		41262: "lurid",
-		41263: "lurk",
-		41264: "lush",
-		41265: "lust",
-		41266: "lusty",
-		41311: "lute",
-		41312: "lutz",
-		41313: "lux",
-		41314: "luxe",
-		41315: "luzon",
-		41316: "lv",
-		41321: "lw",
-		41322: "lx",
-		41323: "ly",
-		41324: "lydia",
-		41325: "lye",
-		41326: "lying",
-		41331: "lykes",
-		41332: "lyle",
-		41333: "lyman",
-		41334: "lymph",
-		41335: "lynch",
-		41336: "lynn",
-		41341: "lynx",
-		41342: "lyon",
-		41343: "lyons",
-		41344: "lyra",
-		41345: "lyric",
-		41346: "lz",
-		41351: "m",
-		41352: "m&m",
-		41353: "m's",
-		41354: "ma",
-		41355: "mabel",
-		41356: "mac",
-		41361: "mace",
-		41362: "mach",
-		41363: "macho",
-		41364: "mack",
-		41365: "mackey",
-		41366: "macon",
-		41411: "macro",
-		41412: "mad",
-		41413: "madam",
-		41414: "made",
-		41415: "madman",
-		41416: "madsen",
-		41421: "mae",
-		41422: "magi",
-		41423: "magic",
-		41424: "magma",
-		41425: "magna",
-		41426: "magog",
-		41431: "maid",
-		41432: "maier",
-		41433: "mail",
-		41434: "maim",
-		41435: "main",
-		41436: "maine",
-		41441: "major",
-		41442: "make",
-		41443: "malady",
-		41444: "malay",
-		41445: "male",
-		41446: "mali",
-		41451: "mall",
-		41452: "malt",
-		41453: "malta",
-		41454: "mambo",
-		41455: "mamma",
-		41456: "mammal",
-		41461: "man",
-		41462: "mana",
-		41463: "manama",
-		41464: "mane",
-		41465: "mange",
-		41466: "mania",
-		41511: "manic",
-		41512: "mann",
-		41513: "manna",
-		41514: "manor",
-		41515: "mans",
-		41516: "manse",
-		41521: "mantle",
-		41522: "many",
-		41523: "mao",
-		41524: "maori",
-		41525: "map",
-		41526: "maple",
-		41531: "mar",
-		41532: "marc",
-		41533: "march",
-		41534: "marco",
-		41535: "marcy",
-		41536: "mardi",
-		41541: "mare",
-		41542: "margo",
-		41543: "maria",
-		41544: "marie",
-		41545: "marin",
-		41546: "marine",
-		41551: "mario",
-		41552: "mark",
-		41553: "marks",
-		41554: "marlin",
-		41555: "marrow",
-		41556: "marry",
-		41561: "mars",
-		41562: "marsh",
-		41563: "mart",
-		41564: "marty",
-		41565: "marx",
-		41566: "mary",
-		41611: "maser",
-		41612: "mash",
-		41613: "mask",
-		41614: "mason",
-		41615: "masque",
-		41616: "mass",
-		41621: "mast",
-		41622: "mat",
-		41623: "match",
-		41624: "mate",
-		41625: "mateo",
-		41626: "mater",
-		41631: "math",
-		41632: "matte",
-		41633: "maul",
-		41634: "mauve",
-		41635: "mavis",
-		41636: "maw",
-		41641: "mawr",
-		41642: "max",
-		41643: "maxim",
-		41644: "maxima",
-		41645: "may",
-		41646: "maya",
-		41651: "maybe",
-		41652: "mayer",
-		41653: "mayhem",
-		41654: "mayo",
-		41655: "mayor",
-		41656: "mayst",
-		41661: "mazda",
-		41662: "maze",
-		41663: "mb",
-		41664: "mba",
-		41665: "mc",
-		41666: "mccoy",
-		42111: "mcgee",
-		42112: "mckay",
-		42113: "mckee",
-		42114: "mcleod",
-		42115: "md",
-		42116: "me",
-		42121: "mead",
-		42122: "meal",
-		42123: "mealy",
-		42124: "mean",
-		42125: "meant",
-		42126: "meat",
-		42131: "meaty",
-		42132: "mecca",
-		42133: "mecum",
-		42134: "medal",
-		42135: "medea",
-		42136: "media",
-		42141: "medic",
-		42142: "medley",
-		42143: "meek",
-		42144: "meet",
-		42145: "meg",
-		42146: "mega",
-		42151: "meier",
-		42152: "meir",
-		42153: "mel",
-		42154: "meld",
-		42155: "melee",
-		42156: "mellow",
-		42161: "melon",
-		42162: "melt",
-		42163: "memo",
-		42164: "memoir",
-		42165: "men",
-		42166: "mend",
-		42211: "menlo",
-		42212: "menu",
-		42213: "merck",
-		42214: "mercy",
-		42215: "mere",
-		42216: "merge",
-		42221: "merit",
-		42222: "merle",
-		42223: "merry",
-		42224: "mesa",
-		42225: "mescal",
-		42226: "mesh",
-		42231: "meson",
-		42232: "mess",
-		42233: "messy",
-		42234: "met",
-		42235: "metal",
-		42236: "mete",
-		42241: "meter",
-		42242: "metro",
-		42243: "mew",
-		42244: "meyer",
-		42245: "meyers",
-		42246: "mezzo",
-		42251: "mf",
-		42252: "mg",
-		42253: "mh",
-		42254: "mi",
-		42255: "miami",
-		42256: "mica",
-		42261: "mice",
-		42262: "mickey",
-		42263: "micky",
-		42264: "micro",
-		42265: "mid",
-		42266: "midas",
-		42311: "midge",
-		42312: "midst",
-		42313: "mien",
-		42314: "miff",
-		42315: "mig",
-		42316: "might",
-		42321: "mike",
-		42322: "mila",
-		42323: "milan",
-		42324: "milch",
-		42325: "mild",
-		42326: "mildew",
-		42331: "mile",
-		42332: "miles",
-		42333: "milk",
-		42334: "milky",
-		42335: "mill",
-		42336: "mills",
-		42341: "milt",
-		42342: "mimi",
-		42343: "mimic",
-		42344: "mince",
-		42345: "mind",
-		42346: "mine",
-		42351: "mini",
-		42352: "minim",
-		42353: "mink",
-		42354: "minnow",
-		42355: "minor",
-		42356: "minos",
-		42361: "minot",
-		42362: "minsk",
-		42363: "mint",
-		42364: "minus",
-		42365: "mira",
-		42366: "mirage",
-		42411: "mire",
-		42412: "mirth",
-		42413: "miser",
-		42414: "misery",
-		42415: "miss",
-		42416: "missy",
-		42421: "mist",
-		42422: "misty",
-		42423: "mit",
-		42424: "mite",
-		42425: "mitre",
-		42426: "mitt",
-		42431: "mix",
-		42432: "mixup",
-		42433: "mizar",
-		42434: "mj",
-		42435: "mk",
-		42436: "ml",
-		42441: "mm",
-		42442: "mmm",
-		42443: "mmmm",
-		42444: "mn",
-		42445: "mno",
-		42446: "mo",
-		42451: "moan",
-		42452: "moat",
-		42453: "mob",
-		42454: "mobil",
-		42455: "mock",
-		42456: "modal",
-		42461: "mode",
-		42462: "model",
-		42463: "modem",
-		42464: "modish",
-		42465: "moe",
-		42466: "moen",
-		42511: "mohr",
-		42512: "moire",
-		42513: "moist",
-		42514: "molal",
-		42515: "molar",
-		42516: "mold",
-		42521: "mole",
-		42522: "moll",
-		42523: "mollie",
-		42524: "molly",
-		42525: "molt",
-		42526: "molten",
-		42531: "mommy",
-		42532: "mona",
-		42533: "monad",
-		42534: "mondo",
-		42535: "monel",
-		42536: "money",
-		42541: "monic",
-		42542: "monk",
-		42543: "mont",
-		42544: "monte",
-		42545: "month",
-		42546: "monty",
-		42551: "moo",
-		42552: "mood",
-		42553: "moody",
-		42554: "moon",
-		42555: "moor",
-		42556: "moore",
-		42561: "moose",
-		42562: "moot",
-		42563: "mop",
-		42564: "moral",
-		42565: "morale",
-		42566: "moran",
-		42611: "more",
-		42612: "morel",
-		42613: "morn",
-		42614: "moron",
-		42615: "morse",
-		42616: "morsel",
-		42621: "mort",
-		42622: "mosaic",
-		42623: "moser",
-		42624: "moses",
-		42625: "moss",
-		42626: "mossy",
-		42631: "most",
-		42632: "mot",
-		42633: "motel",
-		42634: "motet",
-		42635: "moth",
-		42636: "mother",
-		42641: "motif",
-		42642: "motor",
-		42643: "motto",
-		42644: "mould",
-		42645: "mound",
-		42646: "mount",
-		42651: "mourn",
-		42652: "mouse",
-		42653: "mousy",
-		42654: "mouth",
-		42655: "move",
-		42656: "movie",
-		42661: "mow",
-		42662: "moyer",
-		42663: "mp",
-		42664: "mph",
-		42665: "mq",
-		42666: "mr",
-		43111: "mrs",
-		43112: "ms",
-		43113: "mt",
-		43114: "mu",
-		43115: "much",
-		43116: "muck",
-		43121: "mucus",
-		43122: "mud",
-		43123: "mudd",
-		43124: "muddy",
-		43125: "muff",
-		43126: "muffin",
-		43131: "mug",
-		43132: "muggy",
-		43133: "mugho",
-		43134: "muir",
-		43135: "mulch",
-		43136: "mulct",
-		43141: "mule",
-		43142: "mull",
-		43143: "multi",
-		43144: "mum",
-		43145: "mummy",
-		43146: "munch",
-		43151: "mung",
-		43152: "munson",
-		43153: "muon",
-		43154: "muong",
-		43155: "mural",
-		43156: "muriel",
-		43161: "murk",
-		43162: "murky",
-		43163: "murre",
-		43164: "muse",
-		43165: "mush",
-		43166: "mushy",
-		43211: "music",
-		43212: "musk",
-		43213: "muslim",
-		43214: "must",
-		43215: "musty",
-		43216: "mute",
-		43221: "mutt",
-		43222: "muzak",
-		43223: "muzo",
-		43224: "mv",
-		43225: "mw",
-		43226: "mx",
-		43231: "my",
-		43232: "myel",
-		43233: "myers",
-		43234: "mylar",
-		43235: "mynah",
-		43236: "myopia",
-		43241: "myra",
-		43242: "myron",
-		43243: "myrrh",
-		43244: "myself",
-		43245: "myth",
-		43246: "mz",
-		43251: "n",
-		43252: "n's",
-		43253: "na",
-		43254: "naacp",
-		43255: "nab",
-		43256: "nadir",
-		43261: "nag",
-		43262: "nagoya",
-		43263: "nagy",
-		43264: "naiad",
-		43265: "nail",
-		43266: "nair",
-		43311: "naive",
-		43312: "naked",
-		43313: "name",
-		43314: "nan",
-		43315: "nancy",
-		43316: "naomi",
-		43321: "nap",
-		43322: "nary",
-		43323: "nasa",
-		43324: "nasal",
-		43325: "nash",
-		43326: "nasty",
-		43331: "nat",
-		43332: "natal",
-		43333: "nate",
-		43334: "nato",
-		43335: "natty",
-		43336: "nature",
-		43341: "naval",
-		43342: "nave",
-		43343: "navel",
-		43344: "navy",
-		43345: "nay",
-		43346: "nazi",
-		43351: "nb",
-		43352: "nbc",
-		43353: "nbs",
-		43354: "nc",
-		43355: "ncaa",
-		43356: "ncr",
-		43361: "nd",
-		43362: "ne",
-		43363: "neal",
-		43364: "near",
-		43365: "neat",
-		43366: "neath",
-		43411: "neck",
-		43412: "ned",
-		43413: "nee",
-		43414: "need",
-		43415: "needy",
-		43416: "neff",
-		43421: "negate",
-		43422: "negro",
-		43423: "nehru",
-		43424: "neil",
-		43425: "nell",
-		43426: "nelsen",
-		43431: "neon",
-		43432: "nepal",
-		43433: "nero",
-		43434: "nerve",
-		43435: "ness",
-		43436: "nest",
-		43441: "net",
-		43442: "neuron",
-		43443: "neva",
-		43444: "neve",
-		43445: "new",
-		43446: "newel",
-		43451: "newt",
-		43452: "next",
-		43453: "nf",
-		43454: "ng",
-		43455: "nh",
-		43456: "ni",
-		43461: "nib",
-		43462: "nibs",
-		43463: "nice",
-		43464: "nicety",
-		43465: "niche",
-		43466: "nick",
-		43511: "niece",
-		43512: "niger",
-		43513: "nigh",
-		43514: "night",
-		43515: "nih",
-		43516: "nikko",
-		43521: "nil",
-		43522: "nile",
-		43523: "nimbus",
-		43524: "nimh",
-		43525: "nina",
-		43526: "nine",
-		43531: "ninth",
-		43532: "niobe",
-		43533: "nip",
-		43534: "nit",
-		43535: "nitric",
-		43536: "nitty",
-		43541: "nixon",
-		43542: "nj",
-		43543: "nk",
-		43544: "nl",
-		43545: "nm",
-		43546: "nn",
-		43551: "nnn",
-		43552: "nnnn",
-		43553: "no",
-		43554: "noaa",
-		43555: "noah",
-		43556: "nob",
-		43561: "nobel",
-		43562: "noble",
-		43563: "nod",
-		43564: "nodal",
-		43565: "node",
-		43566: "noel",
-		43611: "noise",
-		43612: "noisy",
-		43613: "nolan",
-		43614: "noll",
-		43615: "nolo",
-		43616: "nomad",
-		43621: "non",
-		43622: "nonce",
-		43623: "none",
-		43624: "nook",
-		43625: "noon",
-		43626: "noose",
-		43631: "nop",
-		43632: "nor",
-		43633: "nora",
-		43634: "norm",
-		43635: "norma",
-		43636: "north",
-		43641: "norway",
-		43642: "nose",
-		43643: "not",
-		43644: "notch",
-		43645: "note",
-		43646: "notre",
-		43651: "noun",
-		43652: "nov",
-		43653: "nova",
-		43654: "novak",
-		43655: "novel",
-		43656: "novo",
-		43661: "now",
-		43662: "np",
-		43663: "nq",
-		43664: "nr",
-		43665: "nrc",
-		43666: "ns",
-		44111: "nsf",
-		44112: "nt",
-		44113: "ntis",
-		44114: "nu",
-		44115: "nuance",
-		44116: "nubia",
-		44121: "nuclei",
-		44122: "nude",
-		44123: "nudge",
-		44124: "null",
-		44125: "numb",
-		44126: "nun",
-		44131: "nurse",
-		44132: "nut",
-		44133: "nv",
-		44134: "nw",
-		44135: "nx",
-		44136: "ny",
-		44141: "nyc",
-		44142: "nylon",
-		44143: "nymph",
-		44144: "nyu",
-		44145: "nz",
-		44146: "o",
-		44151: "o'er",
-		44152: "o's",
-		44153: "oa",
-		44154: "oaf",
-		44155: "oak",
-		44156: "oaken",
-		44161: "oakley",
-		44162: "oar",
-		44163: "oases",
-		44164: "oasis",
-		44165: "oat",
-		44166: "oath",
-		44211: "ob",
-		44212: "obese",
-		44213: "obey",
-		44214: "objet",
-		44215: "oboe",
-		44216: "oc",
-		44221: "occur",
-		44222: "ocean",
-		44223: "oct",
-		44224: "octal",
-		44225: "octave",
-		44226: "octet",
-		44231: "od",
-		44232: "odd",
-		44233: "ode",
-		44234: "odin",
-		44235: "odium",
-		44236: "oe",
-		44241: "of",
-		44242: "off",
-		44243: "offal",
-		44244: "offend",
-		44245: "offer",
-		44246: "oft",
-		44251: "often",
-		44252: "og",
-		44253: "ogden",
-		44254: "ogle",
-		44255: "ogre",
-		44256: "oh",
-		44261: "ohio",
-		44262: "ohm",
-		44263: "ohmic",
-		44264: "oi",
-		44265: "oil",
-		44266: "oily",
-		44311: "oint",
-		44312: "oj",
-		44313: "ok",
-		44314: "okay",
-		44315: "ol",
-		44316: "olaf",
-		44321: "olav",
-		44322: "old",
-		44323: "olden",
-		44324: "oldy",
-		44325: "olga",
-		44326: "olin",
-		44331: "olive",
-		44332: "olsen",
-		44333: "olson",
-		44334: "om",
-		44335: "omaha",
-		44336: "oman",
-		44341: "omega",
-		44342: "omen",
-		44343: "omit",
-		44344: "on",
-		44345: "once",
-		44346: "one",
-		44351: "onion",
-		44352: "only",
-		44353: "onset",
-		44354: "onto",
-		44355: "onus",
-		44356: "onward",
-		44361: "onyx",
-		44362: "oo",
-		44363: "ooo",
-		44364: "oooo",
-		44365: "ooze",
-		44366: "op",
-		44411: "opal",
-		44412: "opec",
-		44413: "opel",
-		44414: "open",
-		44415: "opera",
-		44416: "opium",
-		44421: "opt",
-		44422: "optic",
-		44423: "opus",
-		44424: "oq",
-		44425: "or",
-		44426: "oral",
-		44431: "orate",
-		44432: "orb",
-		44433: "orbit",
-		44434: "orchid",
-		44435: "ordain",
-		44436: "order",
-		44441: "ore",
-		44442: "organ",
-		44443: "orgy",
-		44444: "orin",
-		44445: "orion",
-		44446: "ornery",
-		44451: "orono",
-		44452: "orr",
-		44453: "os",
-		44454: "osaka",
-		44455: "oscar",
-		44456: "osier",
-		44461: "oslo",
-		44462: "ot",
-		44463: "other",
-		44464: "otis",
-		44465: "ott",
-		44466: "otter",
-		44511: "otto",
-		44512: "ou",
-		44513: "ouch",
-		44514: "ought",
-		44515: "ounce",
-		44516: "our",
-		44521: "oust",
-		44522: "out",
-		44523: "ouvre",
-		44524: "ouzel",
-		44525: "ouzo",
-		44526: "ov",
-		44531: "ova",
-		44532: "oval",
-		44533: "ovary",
-		44534: "ovate",
-		44535: "oven",
-		44536: "over",
-		44541: "overt",
-		44542: "ovid",
-		44543: "ow",
-		44544: "owe",
-		44545: "owens",
-		44546: "owing",
-		44551: "owl",
-		44552: "owly",
-		44553: "own",
-		44554: "ox",
-		44555: "oxen",
-		44556: "oxeye",
-		44561: "oxide",
-		44562: "oxnard",
-		44563: "oy",
-		44564: "oz",
-		44565: "ozark",
-		44566: "ozone",
-		44611: "p",
-		44612: "p's",
-		44613: "pa",
-		44614: "pablo",
-		44615: "pabst",
-		44616: "pace",
-		44621: "pack",
-		44622: "packet",
-		44623: "pact",
-		44624: "pad",
-		44625: "paddy",
-		44626: "padre",
-		44631: "paean",
-		44632: "pagan",
-		44633: "page",
-		44634: "paid",
-		44635: "pail",
-		44636: "pain",
-		44641: "paine",
-		44642: "paint",
-		44643: "pair",
-		44644: "pal",
-		44645: "pale",
-		44646: "pall",
-		44651: "palm",
-		44652: "palo",
-		44653: "palsy",
-		44654: "pam",
-		44655: "pampa",
-		44656: "pan",
-		44661: "panama",
-		44662: "panda",
-		44663: "pane",
-		44664: "panel",
-		44665: "pang",
-		44666: "panic",
-		45111: "pansy",
-		45112: "pant",
-		45113: "panty",
-		45114: "paoli",
-		45115: "pap",
-		45116: "papa",
-		45121: "papal",
-		45122: "papaw",
-		45123: "paper",
-		45124: "pappy",
-		45125: "papua",
-		45126: "par",
-		45131: "parch",
-		45132: "pardon",
-		45133: "pare",
-		45134: "pareto",
-		45135: "paris",
-		45136: "park",
-		45141: "parke",
-		45142: "parks",
-		45143: "parr",
-		45144: "parry",
-		45145: "parse",
-		45146: "part",
-		45151: "party",
-		45152: "pascal",
-		45153: "pasha",
-		45154: "paso",
-		45155: "pass",
-		45156: "passe",
-		45161: "past",
-		45162: "paste",
-		45163: "pasty",
-		45164: "pat",
-		45165: "patch",
-		45166: "pate",
-		45211: "pater",
-		45212: "path",
-		45213: "patio",
-		45214: "patsy",
-		45215: "patti",
-		45216: "patton",
-		45221: "patty",
-		45222: "paul",
-		45223: "paula",
-		45224: "pauli",
-		45225: "paulo",
-		45226: "pause",
-		45231: "pave",
-		45232: "paw",
-		45233: "pawn",
-		45234: "pax",
-		45235: "pay",
-		45236: "payday",
-		45241: "payne",
-		45242: "paz",
-		45243: "pb",
-		45244: "pbs",
-		45245: "pc",
-		45246: "pd",
-		45251: "pe",
-		45252: "pea",
-		45253: "peace",
-		45254: "peach",
-		45255: "peak",
-		45256: "peaky",
-		45261: "peal",
-		45262: "peale",
-		45263: "pear",
-		45264: "pearl",
-		45265: "pease",
-		45266: "peat",
-		45311: "pebble",
-		45312: "pecan",
-		45313: "peck",
-		45314: "pecos",
-		45315: "pedal",
-		45316: "pedro",
-		45321: "pee",
-		45322: "peed",
-		45323: "peek",
-		45324: "peel",
-		45325: "peep",
-		45326: "peepy",
-		45331: "peer",
-		45332: "peg",
-		45333: "peggy",
-		45334: "pelt",
-		45335: "pen",
-		45336: "penal",
-		45341: "pence",
-		45342: "pencil",
-		45343: "pend",
-		45344: "penh",
-		45345: "penn",
-		45346: "penna",
-		45351: "penny",
-		45352: "pent",
-		45353: "peony",
-		45354: "pep",
-		45355: "peppy",
-		45356: "pepsi",
-		45361: "per",
-		45362: "perch",
-		45363: "percy",
-		45364: "perez",
-		45365: "peril",
-		45366: "perk",
-		45411: "perky",
-		45412: "perle",
-		45413: "perry",
-		45414: "persia",
-		45415: "pert",
-		45416: "perth",
-		45421: "peru",
-		45422: "peruse",
-		45423: "pest",
-		45424: "peste",
-		45425: "pet",
-		45426: "petal",
-		45431: "pete",
-		45432: "peter",
-		45433: "petit",
-		45434: "petri",
-		45435: "petty",
-		45436: "pew",
-		45441: "pewee",
-		45442: "pf",
-		45443: "pg",
-		45444: "ph",
-		45445: "ph.d",
-		45446: "phage",
-		45451: "phase",
-		45452: "phd",
-		45453: "phenol",
-		45454: "phi",
-		45455: "phil",
-		45456: "phlox",
-		45461: "phon",
-		45462: "phone",
-		45463: "phony",
-		45464: "photo",
-		45465: "phyla",
-		45466: "physic",
-		45511: "pi",
-		45512: "piano",
-		45513: "pica",
-		45514: "pick",
-		45515: "pickup",
-		45516: "picky",
-		45521: "pie",
-		45522: "piece",
-		45523: "pier",
-		45524: "pierce",
-		45525: "piety",
-		45526: "pig",
-		45531: "piggy",
-		45532: "pike",
-		45533: "pile",
-		45534: "pill",
-		45535: "pilot",
-		45536: "pimp",
-		45541: "pin",
-		45542: "pinch",
-		45543: "pine",
-		45544: "ping",
-		45545: "pinion",
-		45546: "pink",
-		45551: "pint",
-		45552: "pinto",
-		45553: "pion",
-		45554: "piotr",
-		45555: "pious",
-		45556: "pip",
-		45561: "pipe",
-		45562: "piper",
-		45563: "pique",
-		45564: "pit",
-		45565: "pitch",
-		45566: "pith",
-		45611: "pithy",
-		45612: "pitney",
-		45613: "pitt",
-		45614: "pity",
-		45615: "pius",
-		45616: "pivot",
-		45621: "pixel",
-		45622: "pixy",
-		45623: "pizza",
-		45624: "pj",
-		45625: "pk",
-		45626: "pl",
-		45631: "place",
-		45632: "plague",
-		45633: "plaid",
-		45634: "plain",
-		45635: "plan",
-		45636: "plane",
-		45641: "plank",
-		45642: "plant",
-		45643: "plasm",
-		45644: "plat",
-		45645: "plate",
-		45646: "plato",
-		45651: "play",
-		45652: "playa",
-		45653: "plaza",
-		45654: "plea",
-		45655: "plead",
-		45656: "pleat",
-		45661: "pledge",
-		45662: "pliny",
-		45663: "plod",
-		45664: "plop",
-		45665: "plot",
-		45666: "plow",
-		46111: "pluck",
-		46112: "plug",
-		46113: "plum",
-		46114: "plumb",
-		46115: "plume",
-		46116: "plump",
-		46121: "plunk",
-		46122: "plus",
-		46123: "plush",
-		46124: "plushy",
-		46125: "pluto",
-		46126: "ply",
-		46131: "pm",
-		46132: "pn",
-		46133: "po",
-		46134: "poach",
-		46135: "pobox",
-		46136: "pod",
-		46141: "podge",
-		46142: "podia",
-		46143: "poe",
-		46144: "poem",
-		46145: "poesy",
-		46146: "poet",
-		46151: "poetry",
-		46152: "pogo",
-		46153: "poi",
-		46154: "point",
-		46155: "poise",
-		46156: "poke",
-		46161: "pol",
-		46162: "polar",
-		46163: "pole",
-		46164: "police",
-		46165: "polio",
-		46166: "polis",
-		46211: "polk",
-		46212: "polka",
-		46213: "poll",
-		46214: "polo",
-		46215: "pomona",
-		46216: "pomp",
-		46221: "ponce",
-		46222: "pond",
-		46223: "pong",
-		46224: "pont",
-		46225: "pony",
-		46226: "pooch",
-		46231: "pooh",
-		46232: "pool",
-		46233: "poole",
-		46234: "poop",
-		46235: "poor",
-		46236: "pop",
-		46241: "pope",
-		46242: "poppy",
-		46243: "porch",
-		46244: "pore",
-		46245: "pork",
-		46246: "porous",
-		46251: "port",
-		46252: "porte",
-		46253: "portia",
-		46254: "porto",
-		46255: "pose",
-		46256: "posey",
-		46261: "posh",
-		46262: "posit",
-		46263: "posse",
-		46264: "post",
-		46265: "posy",
-		46266: "pot",
-		46311: "potts",
-		46312: "pouch",
-		46313: "pound",
-		46314: "pour",
-		46315: "pout",
-		46316: "pow",
-		46321: "powder",
-		46322: "power",
-		46323: "pp",
-		46324: "ppm",
-		46325: "ppp",
-		46326: "pppp",
-		46331: "pq",
-		46332: "pqr",
-		46333: "pr",
-		46334: "prado",
-		46335: "pram",
-		46336: "prank",
-		46341: "pratt",
-		46342: "pray",
-		46343: "preen",
-		46344: "prefix",
-		46345: "prep",
-		46346: "press",
-		46351: "prexy",
-		46352: "prey",
-		46353: "priam",
-		46354: "price",
-		46355: "prick",
-		46356: "pride",
-		46361: "prig",
-		46362: "prim",
-		46363: "prima",
-		46364: "prime",
-		46365: "primp",
-		46366: "prince",
-		46411: "print",
-		46412: "prior",
-		46413: "prism",
-		46414: "prissy",
-		46415: "privy",
-		46416: "prize",
-		46421: "pro",
-		46422: "probe",
-		46423: "prod",
-		46424: "prof",
-		46425: "prom",
-		46426: "prone",
-		46431: "prong",
-		46432: "proof",
-		46433: "prop",
-		46434: "propyl",
-		46435: "prose",
-		46436: "proud",
-		46441: "prove",
-		46442: "prow",
-		46443: "prowl",
-		46444: "proxy",
-		46445: "prune",
-		46446: "pry",
-		46451: "ps",
-		46452: "psalm",
-		46453: "psi",
-		46454: "psych",
-		46455: "pt",
-		46456: "pta",
-		46461: "pu",
-		46462: "pub",
-		46463: "puck",
-		46464: "puddly",
-		46465: "puerto",
-		46466: "puff",
-		46511: "puffy",
-		46512: "pug",
-		46513: "pugh",
-		46514: "puke",
-		46515: "pull",
-		46516: "pulp",
-		46521: "pulse",
-		46522: "puma",
-		46523: "pump",
-		46524: "pun",
-		46525: "punch",
-		46526: "punic",
-		46531: "punish",
-		46532: "punk",
-		46533: "punky",
-		46534: "punt",
-		46535: "puny",
-		46536: "pup",
-		46541: "pupal",
-		46542: "pupil",
-		46543: "puppy",
-		46544: "pure",
-		46545: "purge",
-		46546: "purl",
-		46551: "purr",
-		46552: "purse",
-		46553: "pus",
-		46554: "pusan",
-		46555: "pusey",
-		46556: "push",
-		46561: "pussy",
-		46562: "put",
-		46563: "putt",
-		46564: "putty",
-		46565: "pv",
-		46566: "pvc",
-		46611: "pw",
-		46612: "px",
-		46613: "py",
-		46614: "pygmy",
-		46615: "pyle",
-		46616: "pyre",
-		46621: "pyrex",
-		46622: "pyrite",
-		46623: "pz",
-		46624: "q",
-		46625: "q's",
-		46626: "qa",
-		46631: "qatar",
-		46632: "qb",
-		46633: "qc",
-		46634: "qd",
-		46635: "qe",
-		46636: "qed",
-		46641: "qf",
-		46642: "qg",
-		46643: "qh",
-		46644: "qi",
-		46645: "qj",
-		46646: "qk",
-		46651: "ql",
-		46652: "qm",
-		46653: "qn",
-		46654: "qo",
-		46655: "qp",
-		46656: "qq",
-		46661: "qqq",
-		46662: "qqqq",
-		46663: "qr",
-		46664: "qrs",
-		46665: "qs",
-		46666: "qt",
-		51111: "qu",
-		51112: "qua",
-		51113: "quack",
-		51114: "quad",
-		51115: "quaff",
-		51116: "quail",
-		51121: "quake",
-		51122: "qualm",
-		51123: "quark",
-		51124: "quarry",
-		51125: "quart",
-		51126: "quash",
-		51131: "quasi",
-		51132: "quay",
-		51133: "queasy",
-		51134: "queen",
-		51135: "queer",
-		51136: "quell",
-		51141: "query",
-		51142: "quest",
-		51143: "queue",
-		51144: "quick",
-		51145: "quid",
-		51146: "quiet",
-		51151: "quill",
-		51152: "quilt",
-		51153: "quinn",
-		51154: "quint",
-		51155: "quip",
-		51156: "quirk",
-		51161: "quirt",
-		51162: "quit",
-		51163: "quite",
-		51164: "quito",
-		51165: "quiz",
-		51166: "quo",
-		51211: "quod",
-		51212: "quota",
-		51213: "quote",
-		51214: "qv",
-		51215: "qw",
-		51216: "qx",
-		51221: "qy",
-		51222: "qz",
-		51223: "r",
-		51224: "r&d",
-		51225: "r's",
-		51226: "ra",
-		51231: "rabat",
-		51232: "rabbi",
-		51233: "rabbit",
-		51234: "rabid",
-		51235: "rabin",
-		51236: "race",
-		51241: "rack",
-		51242: "racy",
-		51243: "radar",
-		51244: "radii",
-		51245: "radio",
-		51246: "radium",
-		51251: "radix",
-		51252: "radon",
-		51253: "rae",
-		51254: "rafael",
-		51255: "raft",
-		51256: "rag",
-		51261: "rage",
-		51262: "raid",
-		51263: "rail",
-		51264: "rain",
-		51265: "rainy",
-		51266: "raise",
-		51311: "raj",
-		51312: "rajah",
-		51313: "rake",
-		51314: "rally",
-		51315: "ralph",
-		51316: "ram",
-		51321: "raman",
-		51322: "ramo",
-		51323: "ramp",
-		51324: "ramsey",
-		51325: "ran",
-		51326: "ranch",
-		51331: "rand",
-		51332: "randy",
-		51333: "rang",
-		51334: "range",
-		51335: "rangy",
-		51336: "rank",
-		51341: "rant",
-		51342: "raoul",
-		51343: "rap",
-		51344: "rape",
-		51345: "rapid",
-		51346: "rapt",
-		51351: "rare",
-		51352: "rasa",
-		51353: "rascal",
-		51354: "rash",
-		51355: "rasp",
-		51356: "rat",
-		51361: "rata",
-		51362: "rate",
-		51363: "rater",
-		51364: "ratio",
-		51365: "rattle",
-		51366: "raul",
-		51411: "rave",
-		51412: "ravel",
-		51413: "raven",
-		51414: "raw",
-		51415: "ray",
-		51416: "raze",
-		51421: "razor",
-		51422: "rb",
-		51423: "rc",
-		51424: "rca",
-		51425: "rd",
-		51426: "re",
-		51431: "reach",
-		51432: "read",
-		51433: "ready",
-		51434: "reagan",
-		51435: "real",
-		51436: "realm",
-		51441: "ream",
-		51442: "reap",
-		51443: "rear",
-		51444: "reave",
-		51445: "reb",
-		51446: "rebel",
-		51451: "rebut",
-		51452: "recipe",
-		51453: "reck",
-		51454: "recur",
-		51455: "red",
-		51456: "redeem",
-		51461: "reduce",
-		51462: "reed",
-		51463: "reedy",
-		51464: "reef",
-		51465: "reek",
-		51466: "reel",
-		51511: "reese",
-		51512: "reeve",
-		51513: "refer",
-		51514: "regal",
-		51515: "regina",
-		51516: "regis",
-		51521: "reich",
-		51522: "reid",
-		51523: "reign",
-		51524: "rein",
-		51525: "relax",
-		51526: "relay",
-		51531: "relic",
-		51532: "reman",
-		51533: "remedy",
-		51534: "remit",
-		51535: "remus",
-		51536: "rena",
-		51541: "renal",
-		51542: "rend",
-		51543: "rene",
-		51544: "renown",
-		51545: "rent",
-		51546: "rep",
-		51551: "repel",
-		51552: "repent",
-		51553: "resin",
-		51554: "resort",
-		51555: "rest",
-		51556: "ret",
-		51561: "retch",
-		51562: "return",
-		51563: "reub",
-		51564: "rev",
-		51565: "reveal",
-		51566: "revel",
-		51611: "rever",
-		51612: "revet",
-		51613: "revved",
-		51614: "rex",
-		51615: "rf",
-		51616: "rg",
-		51621: "rh",
-		51622: "rhea",
-		51623: "rheum",
-		51624: "rhine",
-		51625: "rhino",
-		51626: "rho",
-		51631: "rhoda",
-		51632: "rhode",
-		51633: "rhyme",
-		51634: "ri",
-		51635: "rib",
-		51636: "rica",
-		51641: "rice",
-		51642: "rich",
-		51643: "rick",
-		51644: "rico",
-		51645: "rid",
-		51646: "ride",
-		51651: "ridge",
-		51652: "rifle",
-		51653: "rift",
-		51654: "rig",
-		51655: "riga",
-		51656: "rigel",
-		51661: "riggs",
-		51662: "right",
-		51663: "rigid",
-		51664: "riley",
-		51665: "rill",
-		51666: "rilly",
-		52111: "rim",
-		52112: "rime",
-		52113: "rimy",
-		52114: "ring",
-		52115: "rink",
-		52116: "rinse",
-		52121: "rio",
-		52122: "riot",
-		52123: "rip",
-		52124: "ripe",
-		52125: "ripen",
-		52126: "ripley",
-		52131: "rise",
-		52132: "risen",
-		52133: "risk",
-		52134: "risky",
-		52135: "rite",
-		52136: "ritz",
-		52141: "rival",
-		52142: "riven",
-		52143: "river",
-		52144: "rivet",
-		52145: "riyadh",
-		52146: "rj",
-		52151: "rk",
-		52152: "rl",
-		52153: "rm",
-		52154: "rn",
-		52155: "ro",
-		52156: "roach",
-		52161: "road",
-		52162: "roam",
-		52163: "roar",
-		52164: "roast",
-		52165: "rob",
-		52166: "robe",
-		52211: "robin",
-		52212: "robot",
-		52213: "rock",
-		52214: "rocket",
-		52215: "rocky",
-		52216: "rod",
-		52221: "rode",
-		52222: "rodeo",
-		52223: "roe",
-		52224: "roger",
-		52225: "rogue",
-		52226: "roil",
-		52231: "role",
-		52232: "roll",
-		52233: "roman",
-		52234: "rome",
-		52235: "romeo",
-		52236: "romp",
-		52241: "ron",
-		52242: "rondo",
-		52243: "rood",
-		52244: "roof",
-		52245: "rook",
-		52246: "rookie",
-		52251: "rooky",
-		52252: "room",
-		52253: "roomy",
-		52254: "roost",
-		52255: "root",
-		52256: "rope",
-		52261: "rosa",
-		52262: "rose",
-		52263: "rosen",
-		52264: "ross",
-		52265: "rosy",
-		52266: "rot",
-		52311: "rotc",
-		52312: "roth",
-		52313: "rotor",
-		52314: "rouge",
-		52315: "rough",
-		52316: "round",
-		52321: "rouse",
-		52322: "rout",
-		52323: "route",
-		52324: "rove",
-		52325: "row",
-		52326: "rowdy",
-		52331: "rowe",
-		52332: "roy",
-		52333: "royal",
-		52334: "royce",
-		52335: "rp",
-		52336: "rpm",
-		52341: "rq",
-		52342: "rr",
-		52343: "rrr",
-		52344: "rrrr",
-		52345: "rs",
-		52346: "rst",
-		52351: "rsvp",
-		52352: "rt",
-		52353: "ru",
-		52354: "ruanda",
-		52355: "rub",
-		52356: "rube",
-		52361: "ruben",
-		52362: "rubin",
-		52363: "rubric",
-		52364: "ruby",
-		52365: "ruddy",
-		52366: "rude",
-		52411: "rudy",
-		52412: "rue",
-		52413: "rufus",
-		52414: "rug",
-		52415: "ruin",
-		52416: "rule",
-		52421: "rum",
-		52422: "rumen",
-		52423: "rummy",
-		52424: "rump",
-		52425: "rumpus",
-		52426: "run",
-		52431: "rune",
-		52432: "rung",
-		52433: "runge",
-		52434: "runic",
-		52435: "runt",
-		52436: "runty",
-		52441: "rupee",
-		52442: "rural",
-		52443: "ruse",
-		52444: "rush",
-		52445: "rusk",
-		52446: "russ",
-		52451: "russo",
-		52452: "rust",
-		52453: "rusty",
-		52454: "rut",
-		52455: "ruth",
-		52456: "rutty",
-		52461: "rv",
-		52462: "rw",
-		52463: "rx",
-		52464: "ry",
-		52465: "ryan",
-		52466: "ryder",
-		52511: "rye",
-		52512: "rz",
-		52513: "s",
-		52514: "s's",
-		52515: "sa",
-		52516: "sabine",
-		52521: "sable",
-		52522: "sabra",
-		52523: "sac",
-		52524: "sachs",
-		52525: "sack",
-		52526: "sad",
-		52531: "saddle",
-		52532: "sadie",
-		52533: "safari",
-		52534: "safe",
-		52535: "sag",
-		52536: "saga",
-		52541: "sage",
-		52542: "sago",
-		52543: "said",
-		52544: "sail",
-		52545: "saint",
-		52546: "sake",
-		52551: "sal",
-		52552: "salad",
-		52553: "sale",
-		52554: "salem",
-		52555: "saline",
-		52556: "salk",
-		52561: "salle",
-		52562: "sally",
-		52563: "salon",
-		52564: "salt",
-		52565: "salty",
-		52566: "salve",
-		52611: "salvo",
-		52612: "sam",
-		52613: "samba",
-		52614: "same",
-		52615: "sammy",
-		52616: "samoa",
-		52621: "samuel",
-		52622: "san",
-		52623: "sana",
-		52624: "sand",
-		52625: "sandal",
-		52626: "sandy",
-		52631: "sane",
-		52632: "sang",
-		52633: "sank",
-		52634: "sans",
-		52635: "santa",
-		52636: "santo",
-		52641: "sao",
-		52642: "sap",
-		52643: "sappy",
-		52644: "sara",
-		52645: "sarah",
-		52646: "saran",
-		52651: "sari",
-		52652: "sash",
-		52653: "sat",
-		52654: "satan",
-		52655: "satin",
-		52656: "satyr",
-		52661: "sauce",
-		52662: "saucy",
-		52663: "saud",
-		52664: "saudi",
-		52665: "saul",
-		52666: "sault",
-		53111: "saute",
-		53112: "save",
-		53113: "savoy",
-		53114: "savvy",
-		53115: "saw",
-		53116: "sawyer",
-		53121: "sax",
-		53122: "saxon",
-		53123: "say",
-		53124: "sb",
-		53125: "sc",
-		53126: "scab",
-		53131: "scala",
-		53132: "scald",
-		53133: "scale",
-		53134: "scalp",
-		53135: "scam",
-		53136: "scamp",
-		53141: "scan",
-		53142: "scant",
-		53143: "scar",
-		53144: "scare",
-		53145: "scarf",
-		53146: "scary",
-		53151: "scat",
-		53152: "scaup",
-		53153: "scene",
-		53154: "scent",
-		53155: "school",
-		53156: "scion",
-		53161: "scm",
-		53162: "scoff",
-		53163: "scold",
-		53164: "scoop",
-		53165: "scoot",
-		53166: "scope",
-		53211: "scops",
-		53212: "score",
-		53213: "scoria",
-		53214: "scorn",
-		53215: "scot",
-		53216: "scott",
-		53221: "scour",
-		53222: "scout",
-		53223: "scowl",
-		53224: "scram",
-		53225: "scrap",
-		53226: "scrape",
-		53231: "screw",
-		53232: "scrim",
-		53233: "scrub",
-		53234: "scuba",
-		53235: "scud",
-		53236: "scuff",
-		53241: "scull",
-		53242: "scum",
-		53243: "scurry",
-		53244: "sd",
-		53245: "se",
-		53246: "sea",
-		53251: "seal",
-		53252: "seam",
-		53253: "seamy",
-		53254: "sean",
-		53255: "sear",
-		53256: "sears",
-		53261: "season",
-		53262: "seat",
-		53263: "sec",
-		53264: "secant",
-		53265: "sect",
-		53266: "sedan",
-		53311: "seder",
-		53312: "sedge",
-		53313: "see",
-		53314: "seed",
-		53315: "seedy",
-		53316: "seek",
-		53321: "seem",
-		53322: "seen",
-		53323: "seep",
-		53324: "seethe",
-		53325: "seize",
-		53326: "self",
-		53331: "sell",
-		53332: "selma",
-		53333: "semi",
-		53334: "sen",
-		53335: "send",
-		53336: "seneca",
-		53341: "senor",
-		53342: "sense",
-		53343: "sent",
-		53344: "sentry",
-		53345: "seoul",
-		53346: "sepal",
-		53351: "sepia",
-		53352: "sepoy",
-		53353: "sept",
-		53354: "septa",
-		53355: "sequin",
-		53356: "sera",
-		53361: "serf",
-		53362: "serge",
-		53363: "serif",
-		53364: "serum",
-		53365: "serve",
-		53366: "servo",
-		53411: "set",
-		53412: "seth",
-		53413: "seton",
-		53414: "setup",
-		53415: "seven",
-		53416: "sever",
-		53421: "severe",
-		53422: "sew",
-		53423: "sewn",
-		53424: "sex",
-		53425: "sexy",
-		53426: "sf",
-		53431: "sg",
-		53432: "sh",
-		53433: "shack",
-		53434: "shad",
-		53435: "shade",
-		53436: "shady",
-		53441: "shafer",
-		53442: "shaft",
-		53443: "shag",
-		53444: "shah",
-		53445: "shake",
-		53446: "shaken",
-		53451: "shako",
-		53452: "shaky",
-		53453: "shale",
-		53454: "shall",
-		53455: "sham",
-		53456: "shame",
-		53461: "shank",
-		53462: "shape",
-		53463: "shard",
-		53464: "share",
-		53465: "shari",
-		53466: "shark",
-		53511: "sharp",
-		53512: "shave",
-		53513: "shaw",
-		53514: "shawl",
-		53515: "shay",
-		53516: "she",
-		53521: "she'd",
-		53522: "shea",
-		53523: "sheaf",
-		53524: "shear",
-		53525: "sheath",
-		53526: "shed",
-		53531: "sheen",
-		53532: "sheep",
-		53533: "sheer",
-		53534: "sheet",
-		53535: "sheik",
-		53536: "shelf",
-		53541: "shell",
-		53542: "shied",
-		53543: "shift",
-		53544: "shill",
-		53545: "shim",
-		53546: "shin",
-		53551: "shine",
-		53552: "shinto",
-		53553: "shiny",
-		53554: "ship",
-		53555: "shire",
-		53556: "shirk",
-		53561: "shirt",
-		53562: "shish",
-		53563: "shiv",
-		53564: "shoal",
-		53565: "shock",
-		53566: "shod",
-		53611: "shoe",
-		53612: "shoji",
-		53613: "shone",
-		53614: "shoo",
-		53615: "shook",
-		53616: "shoot",
-		53621: "shop",
-		53622: "shore",
-		53623: "short",
-		53624: "shot",
-		53625: "shout",
-		53626: "shove",
-		53631: "show",
-		53632: "shown",
-		53633: "showy",
-		53634: "shrank",
-		53635: "shred",
-		53636: "shrew",
-		53641: "shrike",
-		53642: "shrub",
-		53643: "shrug",
-		53644: "shu",
-		53645: "shuck",
-		53646: "shun",
-		53651: "shunt",
-		53652: "shut",
-		53653: "shy",
-		53654: "si",
-		53655: "sial",
-		53656: "siam",
-		53661: "sian",
-		53662: "sib",
-		53663: "sibley",
-		53664: "sibyl",
-		53665: "sic",
-		53666: "sick",
-		54111: "side",
-		54112: "sidle",
-		54113: "siege",
-		54114: "siena",
-		54115: "sieve",
-		54116: "sift",
-		54121: "sigh",
-		54122: "sight",
-		54123: "sigma",
-		54124: "sign",
-		54125: "signal",
-		54126: "signor",
-		54131: "silas",
-		54132: "silk",
-		54133: "silky",
-		54134: "sill",
-		54135: "silly",
-		54136: "silo",
-		54141: "silt",
-		54142: "silty",
-		54143: "sima",
-		54144: "simon",
-		54145: "simons",
-		54146: "sims",
-		54151: "sin",
-		54152: "sinai",
-		54153: "since",
-		54154: "sine",
-		54155: "sinew",
-		54156: "sing",
-		54161: "singe",
-		54162: "sinh",
-		54163: "sink",
-		54164: "sinus",
-		54165: "sioux",
-		54166: "sip",
-		54211: "sir",
-		54212: "sire",
-		54213: "siren",
-		54214: "sis",
-		54215: "sisal",
-		54216: "sit",
-		54221: "site",
-		54222: "situ",
-		54223: "situs",
-		54224: "siva",
-		54225: "six",
-		54226: "sixgun",
-		54231: "sixth",
-		54232: "sixty",
-		54233: "size",
-		54234: "sj",
-		54235: "sk",
-		54236: "skat",
-		54241: "skate",
-		54242: "skeet",
-		54243: "skew",
-		54244: "ski",
-		54245: "skid",
-		54246: "skied",
-		54251: "skiff",
-		54252: "skill",
-		54253: "skim",
-		54254: "skimp",
-		54255: "skimpy",
-		54256: "skin",
-		54261: "skip",
-		54262: "skirt",
-		54263: "skit",
-		54264: "skulk",
-		54265: "skull",
-		54266: "skunk",
-		54311: "sky",
-		54312: "skye",
-		54313: "sl",
-		54314: "slab",
-		54315: "slack",
-		54316: "slag",
-		54321: "slain",
-		54322: "slake",
-		54323: "slam",
-		54324: "slang",
-		54325: "slant",
-		54326: "slap",
-		54331: "slash",
-		54332: "slat",
-		54333: "slate",
-		54334: "slater",
-		54335: "slav",
-		54336: "slave",
-		54341: "slay",
-		54342: "sled",
-		54343: "sleek",
-		54344: "sleep",
-		54345: "sleet",
-		54346: "slept",
-		54351: "slew",
-		54352: "slice",
-		54353: "slick",
-		54354: "slid",
-		54355: "slide",
-		54356: "slim",
-		54361: "slime",
-		54362: "slimy",
-		54363: "sling",
-		54364: "slip",
-		54365: "slit",
-		54366: "sliver",
-		54411: "sloan",
-		54412: "slob",
-		54413: "sloe",
-		54414: "slog",
-		54415: "sloop",
-		54416: "slop",
-		54421: "slope",
-		54422: "slosh",
-		54423: "slot",
-		54424: "sloth",
-		54425: "slow",
-		54426: "slug",
-		54431: "sluice",
-		54432: "slum",
-		54433: "slump",
-		54434: "slung",
-		54435: "slur",
-		54436: "slurp",
-		54441: "sly",
-		54442: "sm",
-		54443: "smack",
-		54444: "small",
-		54445: "smart",
-		54446: "smash",
-		54451: "smear",
-		54452: "smell",
-		54453: "smelt",
-		54454: "smile",
-		54455: "smirk",
-		54456: "smith",
-		54461: "smithy",
-		54462: "smog",
-		54463: "smoke",
-		54464: "smoky",
-		54465: "smug",
-		54466: "smut",
-		54511: "sn",
-		54512: "snack",
-		54513: "snafu",
-		54514: "snag",
-		54515: "snail",
-		54516: "snake",
-		54521: "snap",
-		54522: "snare",
-		54523: "snark",
-		54524: "snarl",
-		54525: "snatch",
-		54526: "sneak",
-		54531: "sneer",
-		54532: "snell",
-		54533: "snick",
-		54534: "sniff",
-		54535: "snip",
-		54536: "snipe",
-		54541: "snob",
-		54542: "snook",
-		54543: "snoop",
-		54544: "snore",
-		54545: "snort",
-		54546: "snout",
-		54551: "snow",
-		54552: "snowy",
-		54553: "snub",
-		54554: "snuff",
-		54555: "snug",
-		54556: "so",
-		54561: "soak",
-		54562: "soap",
-		54563: "soapy",
-		54564: "soar",
-		54565: "sob",
-		54566: "sober",
-		54611: "social",
-		54612: "sock",
-		54613: "sod",
-		54614: "soda",
-		54615: "sofa",
-		54616: "sofia",
-		54621: "soft",
-		54622: "soften",
-		54623: "soggy",
-		54624: "soil",
-		54625: "sol",
-		54626: "solar",
-		54631: "sold",
-		54632: "sole",
-		54633: "solemn",
-		54634: "solid",
-		54635: "solo",
-		54636: "solon",
-		54641: "solve",
-		54642: "soma",
-		54643: "somal",
-		54644: "some",
-		54645: "son",
-		54646: "sonar",
-		54651: "song",
-		54652: "sonic",
-		54653: "sonny",
-		54654: "sonora",
-		54655: "sony",
-		54656: "soon",
-		54661: "soot",
-		54662: "sooth",
-		54663: "sop",
-		54664: "sora",
-		54665: "sorb",
-		54666: "sore",
-		55111: "sorry",
-		55112: "sort",
-		55113: "sos",
-		55114: "sou",
-		55115: "sough",
-		55116: "soul",
-		55121: "sound",
-		55122: "soup",
-		55123: "sour",
-		55124: "source",
-		55125: "sousa",
-		55126: "south",
-		55131: "sow",
-		55132: "sown",
-		55133: "soy",
-		55134: "soya",
-		55135: "sp",
-		55136: "spa",
-		55141: "space",
-		55142: "spade",
-		55143: "spain",
-		55144: "span",
-		55145: "spar",
-		55146: "spare",
-		55151: "sparge",
-		55152: "spark",
-		55153: "spasm",
-		55154: "spat",
-		55155: "spate",
-		55156: "spawn",
-		55161: "spay",
-		55162: "speak",
-		55163: "spear",
-		55164: "spec",
-		55165: "speck",
-		55166: "sped",
-		55211: "speed",
-		55212: "spell",
-		55213: "spend",
-		55214: "spent",
-		55215: "sperm",
-		55216: "sperry",
-		55221: "spew",
-		55222: "spica",
-		55223: "spice",
-		55224: "spicy",
-		55225: "spike",
-		55226: "spiky",
-		55231: "spill",
-		55232: "spilt",
-		55233: "spin",
-		55234: "spine",
-		55235: "spiny",
-		55236: "spire",
-		55241: "spiro",
-		55242: "spit",
-		55243: "spite",
-		55244: "spitz",
-		55245: "splat",
-		55246: "splay",
-		55251: "spline",
-		55252: "split",
-		55253: "spoil",
-		55254: "spoke",
-		55255: "spoof",
-		55256: "spook",
-		55261: "spooky",
-		55262: "spool",
-		55263: "spoon",
-		55264: "spore",
-		55265: "sport",
-		55266: "spot",
-		55311: "spout",
-		55312: "sprain",
-		55313: "spray",
-		55314: "spree",
-		55315: "sprig",
-		55316: "spruce",
-		55321: "sprue",
-		55322: "spud",
-		55323: "spume",
-		55324: "spun",
-		55325: "spunk",
-		55326: "spur",
-		55331: "spurn",
-		55332: "spurt",
-		55333: "spy",
-		55334: "sq",
-		55335: "squad",
-		55336: "squat",
-		55341: "squaw",
-		55342: "squibb",
-		55343: "squid",
-		55344: "squint",
-		55345: "sr",
-		55346: "sri",
-		55351: "ss",
-		55352: "sss",
-		55353: "ssss",
-		55354: "sst",
-		55355: "st",
-		55356: "st.",
-		55361: "stab",
-		55362: "stack",
-		55363: "stacy",
-		55364: "staff",
-		55365: "stag",
-		55366: "stage",
-		55411: "stagy",
-		55412: "stahl",
-		55413: "staid",
-		55414: "stain",
-		55415: "stair",
-		55416: "stake",
-		55421: "stale",
-		55422: "stalk",
-		55423: "stall",
-		55424: "stamp",
-		55425: "stan",
-		55426: "stance",
-		55431: "stand",
-		55432: "stank",
-		55433: "staph",
-		55434: "star",
-		55435: "stare",
-		55436: "stark",
-		55441: "starr",
-		55442: "start",
-		55443: "stash",
-		55444: "state",
-		55445: "statue",
-		55446: "stave",
-		55451: "stay",
-		55452: "stead",
-		55453: "steak",
-		55454: "steal",
-		55455: "steam",
-		55456: "steed",
-		55461: "steel",
-		55462: "steele",
-		55463: "steen",
-		55464: "steep",
-		55465: "steer",
-		55466: "stein",
-		55511: "stella",
-		55512: "stem",
-		55513: "step",
-		55514: "stern",
-		55515: "steve",
-		55516: "stew",
-		55521: "stick",
-		55522: "stiff",
-		55523: "stile",
-		55524: "still",
-		55525: "stilt",
-		55526: "sting",
-		55531: "stingy",
-		55532: "stink",
-		55533: "stint",
-		55534: "stir",
-		55535: "stock",
-		55536: "stoic",
-		55541: "stoke",
-		55542: "stole",
-		55543: "stomp",
-		55544: "stone",
-		55545: "stony",
-		55546: "stood",
-		55551: "stool",
-		55552: "stoop",
-		55553: "stop",
-		55554: "store",
-		55555: "storey",
-		55556: "stork",
-		55561: "storm",
-		55562: "story",
-		55563: "stout",
-		55564: "stove",
-		55565: "stow",
-		55566: "strafe",
-		55611: "strap",
-		55612: "straw",
-		55613: "stray",
-		55614: "strewn",
-		55615: "strip",
-		55616: "stroll",
-		55621: "strom",
-		55622: "strop",
-		55623: "strum",
-		55624: "strut",
-		55625: "stu",
-		55626: "stuart",
-		55631: "stub",
-		55632: "stuck",
-		55633: "stud",
-		55634: "study",
-		55635: "stuff",
-		55636: "stuffy",
-		55641: "stump",
-		55642: "stun",
-		55643: "stung",
-		55644: "stunk",
-		55645: "stunt",
-		55646: "sturm",
-		55651: "style",
-		55652: "styli",
-		55653: "styx",
-		55654: "su",
-		55655: "suave",
-		55656: "sub",
-		55661: "subtly",
-		55662: "such",
-		55663: "suck",
-		55664: "sud",
-		55665: "sudan",
-		55666: "suds",
-		56111: "sue",
-		56112: "suey",
-		56113: "suez",
-		56114: "sugar",
-		56115: "suit",
-		56116: "suite",
-		56121: "sulfa",
-		56122: "sulk",
-		56123: "sulky",
-		56124: "sully",
-		56125: "sultry",
-		56126: "sum",
-		56131: "sumac",
-		56132: "summon",
-		56133: "sun",
-		56134: "sung",
-		56135: "sunk",
-		56136: "sunny",
-		56141: "sunset",
-		56142: "suny",
-		56143: "sup",
-		56144: "super",
-		56145: "supra",
-		56146: "sure",
-		56151: "surf",
-		56152: "surge",
-		56153: "sus",
-		56154: "susan",
-		56155: "sushi",
-		56156: "susie",
-		56161: "sutton",
-		56162: "sv",
-		56163: "sw",
-		56164: "swab",
-		56165: "swag",
-		56166: "swain",
-		56211: "swam",
-		56212: "swami",
-		56213: "swamp",
-		56214: "swampy",
-		56215: "swan",
-		56216: "swank",
-		56221: "swap",
-		56222: "swarm",
-		56223: "swart",
-		56224: "swat",
-		56225: "swath",
-		56226: "sway",
-		56231: "swear",
-		56232: "sweat",
-		56233: "sweaty",
-		56234: "swede",
-		56235: "sweep",
-		56236: "sweet",
-		56241: "swell",
-		56242: "swelt",
-		56243: "swept",
-		56244: "swift",
-		56245: "swig",
-		56246: "swim",
-		56251: "swine",
-		56252: "swing",
-		56253: "swipe",
-		56254: "swirl",
-		56255: "swish",
-		56256: "swiss",
-		56261: "swoop",
-		56262: "sword",
-		56263: "swore",
-		56264: "sworn",
-		56265: "swum",
-		56266: "swung",
-		56311: "sx",
-		56312: "sy",
-		56313: "sybil",
-		56314: "sykes",
-		56315: "sylow",
-		56316: "sylvan",
-		56321: "synge",
-		56322: "synod",
-		56323: "syria",
-		56324: "syrup",
-		56325: "sz",
-		56326: "t",
-		56331: "t's",
-		56332: "ta",
-		56333: "tab",
-		56334: "table",
-		56335: "taboo",
-		56336: "tabu",
-		56341: "tabula",
-		56342: "tacit",
-		56343: "tack",
-		56344: "tacky",
-		56345: "tacoma",
-		56346: "tact",
-		56351: "tad",
-		56352: "taffy",
-		56353: "taft",
-		56354: "tag",
-		56355: "tahoe",
-		56356: "tail",
-		56361: "taint",
-		56362: "take",
-		56363: "taken",
-		56364: "talc",
-		56365: "tale",
-		56366: "talk",
-		56411: "talky",
-		56412: "tall",
-		56413: "tallow",
-		56414: "tally",
-		56415: "talon",
-		56416: "talus",
-		56421: "tam",
-		56422: "tame",
-		56423: "tamp",
-		56424: "tampa",
-		56425: "tan",
-		56426: "tang",
-		56431: "tango",
-		56432: "tangy",
-		56433: "tanh",
-		56434: "tank",
-		56435: "tansy",
-		56436: "tanya",
-		56441: "tao",
-		56442: "taos",
-		56443: "tap",
-		56444: "tapa",
-		56445: "tape",
-		56446: "taper",
-		56451: "tapir",
-		56452: "tapis",
-		56453: "tappa",
-		56454: "tar",
-		56455: "tara",
-		56456: "tardy",
-		56461: "tariff",
-		56462: "tarry",
-		56463: "tart",
-		56464: "task",
-		56465: "tass",
-		56466: "taste",
-		56511: "tasty",
-		56512: "tat",
-		56513: "tate",
-		56514: "tater",
-		56515: "tattle",
-		56516: "tatty",
-		56521: "tau",
-		56522: "taunt",
-		56523: "taut",
-		56524: "tavern",
-		56525: "tawny",
-		56526: "tax",
-		56531: "taxi",
-		56532: "tb",
-		56533: "tc",
-		56534: "td",
-		56535: "te",
-		56536: "tea",
-		56541: "teach",
-		56542: "teal",
-		56543: "team",
-		56544: "tear",
-		56545: "tease",
-		56546: "teat",
-		56551: "tech",
-		56552: "tecum",
-		56553: "ted",
-		56554: "teddy",
-		56555: "tee",
-		56556: "teem",
-		56561: "teen",
-		56562: "teensy",
-		56563: "teet",
-		56564: "teeth",
-		56565: "telex",
-		56566: "tell",
-		56611: "tempo",
-		56612: "tempt",
-		56613: "ten",
-		56614: "tend",
-		56615: "tenet",
-		56616: "tenney",
-		56621: "tenon",
-		56622: "tenor",
-		56623: "tense",
-		56624: "tensor",
-		56625: "tent",
-		56626: "tenth",
-		56631: "tepee",
-		56632: "tepid",
-		56633: "term",
-		56634: "tern",
-		56635: "terra",
-		56636: "terre",
-		56641: "terry",
-		56642: "terse",
-		56643: "tess",
-		56644: "test",
-		56645: "testy",
-		56646: "tete",
-		56651: "texan",
-		56652: "texas",
-		56653: "text",
-		56654: "tf",
-		56655: "tg",
-		56656: "th",
-		56661: "thai",
-		56662: "than",
-		56663: "thank",
-		56664: "that",
-		56665: "thaw",
-		56666: "the",
-		61111: "thea",
-		61112: "thee",
-		61113: "theft",
-		61114: "their",
-		61115: "them",
-		61116: "theme",
-		61121: "then",
-		61122: "there",
-		61123: "these",
-		61124: "theta",
-		61125: "they",
-		61126: "thick",
-		61131: "thief",
-		61132: "thigh",
-		61133: "thin",
-		61134: "thine",
-		61135: "thing",
-		61136: "think",
-		61141: "third",
-		61142: "this",
-		61143: "thong",
-		61144: "thor",
-		61145: "thorn",
-		61146: "thorny",
-		61151: "those",
-		61152: "thou",
-		61153: "thread",
-		61154: "three",
-		61155: "threw",
-		61156: "throb",
-		61161: "throes",
-		61162: "throw",
-		61163: "thrum",
-		61164: "thud",
-		61165: "thug",
-		61166: "thule",
-		61211: "thumb",
-		61212: "thump",
-		61213: "thus",
-		61214: "thy",
-		61215: "thyme",
-		61216: "ti",
-		61221: "tiber",
-		61222: "tibet",
-		61223: "tibia",
-		61224: "tic",
-		61225: "tick",
-		61226: "ticket",
-		61231: "tid",
-		61232: "tidal",
-		61233: "tidbit",
-		61234: "tide",
-		61235: "tidy",
-		61236: "tie",
-		61241: "tied",
-		61242: "tier",
-		61243: "tift",
-		61244: "tiger",
-		61245: "tight",
-		61246: "til",
-		61251: "tilde",
-		61252: "tile",
-		61253: "till",
-		61254: "tilt",
-		61255: "tilth",
-		61256: "tim",
-		61261: "time",
-		61262: "timex",
-		61263: "timid",
-		61264: "timon",
-		61265: "tin",
-		61266: "tina",
-		61311: "tine",
-		61312: "tinge",
-		61313: "tint",
-		61314: "tiny",
-		61315: "tioga",
-		61316: "tip",
-		61321: "tipoff",
-		61322: "tippy",
-		61323: "tipsy",
-		61324: "tire",
-		61325: "tit",
-		61326: "titan",
-		61331: "tithe",
-		61332: "title",
-		61333: "titus",
-		61334: "tj",
-		61335: "tk",
-		61336: "tl",
-		61341: "tm",
-		61342: "tn",
-		61343: "tnt",
-		61344: "to",
-		61345: "toad",
-		61346: "toady",
-		61351: "toast",
-		61352: "toby",
-		61353: "today",
-		61354: "todd",
-		61355: "toe",
-		61356: "tofu",
-		61361: "tog",
-		61362: "togo",
-		61363: "togs",
-		61364: "toil",
-		61365: "toilet",
-		61366: "token",
-		61411: "tokyo",
-		61412: "told",
-		61413: "toll",
-		61414: "tom",
-		61415: "tomb",
-		61416: "tome",
-		61421: "tommy",
-		61422: "ton",
-		61423: "tonal",
-		61424: "tone",
-		61425: "tong",
-		61426: "toni",
-		61431: "tonic",
-		61432: "tonk",
-		61433: "tonsil",
-		61434: "tony",
-		61435: "too",
-		61436: "took",
-		61441: "tool",
-		61442: "toot",
-		61443: "tooth",
-		61444: "top",
-		61445: "topaz",
-		61446: "topic",
-		61451: "topple",
-		61452: "topsy",
-		61453: "tor",
-		61454: "torah",
-		61455: "torch",
-		61456: "tore",
-		61461: "tori",
-		61462: "torn",
-		61463: "torr",
-		61464: "torso",
-		61465: "tort",
-		61466: "torus",
-		61511: "tory",
-		61512: "toss",
-		61513: "tot",
-		61514: "total",
-		61515: "tote",
-		61516: "totem",
-		61521: "touch",
-		61522: "tough",
-		61523: "tour",
-		61524: "tout",
-		61525: "tow",
-		61526: "towel",
-		61531: "tower",
-		61532: "town",
-		61533: "toxic",
-		61534: "toxin",
-		61535: "toy",
-		61536: "tp",
-		61541: "tq",
-		61542: "tr",
-		61543: "trace",
-		61544: "track",
-		61545: "tract",
-		61546: "tracy",
-		61551: "trade",
-		61552: "trag",
-		61553: "trail",
-		61554: "train",
-		61555: "trait",
-		61556: "tram",
-		61561: "tramp",
-		61562: "trap",
-		61563: "trash",
-		61564: "trawl",
-		61565: "tray",
-		61566: "tread",
-		61611: "treat",
-		61612: "treble",
-		61613: "tree",
-		61614: "trek",
-		61615: "trench",
-		61616: "trend",
-		61621: "tress",
-		61622: "triad",
-		61623: "trial",
-		61624: "tribe",
-		61625: "trick",
-		61626: "tried",
-		61631: "trig",
-		61632: "trill",
-		61633: "trim",
-		61634: "trio",
-		61635: "trip",
-		61636: "tripe",
-		61641: "trite",
-		61642: "triton",
-		61643: "trod",
-		61644: "troll",
-		61645: "troop",
-		61646: "trot",
-		61651: "trout",
-		61652: "troy",
-		61653: "truce",
-		61654: "truck",
-		61655: "trudge",
-		61656: "trudy",
-		61661: "true",
-		61662: "truly",
-		61663: "trump",
-		61664: "trunk",
-		61665: "truss",
-		61666: "trust",
-		62111: "truth",
-		62112: "trw",
-		62113: "try",
-		62114: "ts",
-		62115: "tsar",
-		62116: "tt",
-		62121: "ttl",
-		62122: "ttt",
-		62123: "tttt",
-		62124: "tty",
-		62125: "tu",
-		62126: "tub",
-		62131: "tuba",
-		62132: "tube",
-		62133: "tuck",
-		62134: "tudor",
-		62135: "tuff",
-		62136: "tuft",
-		62141: "tug",
-		62142: "tulane",
-		62143: "tulip",
-		62144: "tulle",
-		62145: "tulsa",
-		62146: "tum",
-		62151: "tun",
-		62152: "tuna",
-		62153: "tune",
-		62154: "tung",
-		62155: "tunic",
-		62156: "tunis",
-		62161: "tunnel",
-		62162: "tuple",
-		62163: "turf",
-		62164: "turin",
-		62165: "turk",
-		62166: "turn",
-		62211: "turvy",
-		62212: "tusk",
-		62213: "tussle",
-		62214: "tutor",
-		62215: "tutu",
-		62216: "tuv",
-		62221: "tv",
-		62222: "tva",
-		62223: "tw",
-		62224: "twa",
-		62225: "twain",
-		62226: "tweak",
-		62231: "tweed",
-		62232: "twice",
-		62233: "twig",
-		62234: "twill",
-		62235: "twin",
-		62236: "twine",
-		62241: "twirl",
-		62242: "twist",
-		62243: "twisty",
-		62244: "twit",
-		62245: "two",
-		62246: "twx",
-		62251: "tx",
-		62252: "ty",
-		62253: "tyburn",
-		62254: "tying",
-		62255: "tyler",
-		62256: "type",
-		62261: "typic",
-		62262: "typo",
-		62263: "tyson",
-		62264: "tz",
-		62265: "u",
-		62266: "u's",
-		62311: "ua",
-		62312: "ub",
-		62313: "uc",
-		62314: "ucla",
-		62315: "ud",
-		62316: "ue",
-		62321: "uf",
-		62322: "ug",
-		62323: "ugh",
-		62324: "ugly",
-		62325: "uh",
-		62326: "ui",
-		62331: "uj",
-		62332: "uk",
-		62333: "ul",
-		62334: "ulan",
-		62335: "ulcer",
-		62336: "ultra",
-		62341: "um",
-		62342: "umber",
-		62343: "umbra",
-		62344: "umpire",
-		62345: "un",
-		62346: "unary",
-		62351: "uncle",
-		62352: "under",
-		62353: "unify",
-		62354: "union",
-		62355: "unit",
-		62356: "unite",
-		62361: "unity",
-		62362: "unix",
-		62363: "until",
-		62364: "uo",
-		62365: "up",
-		62366: "upend",
-		62411: "uphold",
-		62412: "upon",
-		62413: "upper",
-		62414: "uproar",
-		62415: "upset",
-		62416: "uptake",
-		62421: "upton",
-		62422: "uq",
-		62423: "ur",
-		62424: "urban",
-		62425: "urbane",
-		62426: "urea",
-		62431: "urge",
-		62432: "uri",
-		62433: "urine",
-		62434: "uris",
-		62435: "urn",
-		62436: "ursa",
-		62441: "us",
-		62442: "usa",
-		62443: "usaf",
-		62444: "usage",
-		62445: "usc",
-		62446: "usda",
-		62451: "use",
-		62452: "useful",
-		62453: "usgs",
-		62454: "usher",
-		62455: "usia",
-		62456: "usn",
-		62461: "usps",
-		62462: "ussr",
-		62463: "usual",
-		62464: "usurp",
-		62465: "usury",
-		62466: "ut",
-		62511: "utah",
-		62512: "utica",
-		62513: "utile",
-		62514: "utmost",
-		62515: "utter",
-		62516: "uu",
-		62521: "uuu",
-		62522: "uuuu",
-		62523: "uv",
-		62524: "uvw",
-		62525: "uw",
-		62526: "ux",
-		62531: "uy",
-		62532: "uz",
-		62533: "v",
-		62534: "v's",
-		62535: "va",
-		62536: "vacua",
-		62541: "vacuo",
-		62542: "vade",
-		62543: "vaduz",
-		62544: "vague",
-		62545: "vail",
-		62546: "vain",
-		62551: "vale",
-		62552: "valet",
-		62553: "valeur",
-		62554: "valid",
-		62555: "value",
-		62556: "valve",
-		62561: "vamp",
-		62562: "van",
-		62563: "vance",
-		62564: "vane",
-		62565: "vary",
-		62566: "vase",
-		62611: "vast",
-		62612: "vat",
-		62613: "vault",
-		62614: "vb",
-		62615: "vc",
-		62616: "vd",
-		62621: "ve",
-		62622: "veal",
-		62623: "veda",
-		62624: "vee",
-		62625: "veer",
-		62626: "veery",
-		62631: "vega",
-		62632: "veil",
-		62633: "vein",
-		62634: "velar",
-		62635: "veldt",
-		62636: "vella",
-		62641: "vellum",
-		62642: "venal",
-		62643: "vend",
-		62644: "venial",
-		62645: "venom",
-		62646: "vent",
-		62651: "venus",
-		62652: "vera",
-		62653: "verb",
-		62654: "verde",
-		62655: "verdi",
-		62656: "verge",
-		62661: "verity",
-		62662: "verna",
-		62663: "verne",
-		62664: "versa",
-		62665: "verse",
-		62666: "verve",
-		63111: "very",
-		63112: "vessel",
-		63113: "vest",
-		63114: "vet",
-		63115: "vetch",
-		63116: "veto",
-		63121: "vex",
-		63122: "vf",
-		63123: "vg",
-		63124: "vh",
-		63125: "vi",
-		63126: "via",
-		63131: "vial",
-		63132: "vicar",
-		63133: "vice",
-		63134: "vichy",
-		63135: "vicky",
-		63136: "vida",
-		63141: "video",
-		63142: "vie",
-		63143: "viet",
-		63144: "view",
-		63145: "vigil",
-		63146: "vii",
-		63151: "viii",
-		63152: "vile",
-		63153: "villa",
-		63154: "vine",
-		63155: "vinyl",
-		63156: "viola",
-		63161: "violet",
-		63162: "virgil",
-		63163: "virgo",
-		63164: "virus",
-		63165: "vis",
-		63166: "visa",
-		63211: "vise",
-		63212: "visit",
-		63213: "visor",
-		63214: "vista",
-		63215: "vita",
-		63216: "vitae",
-		63221: "vital",
-		63222: "vito",
-		63223: "vitro",
-		63224: "viva",
-		63225: "vivian",
-		63226: "vivid",
-		63231: "vivo",
-		63232: "vixen",
-		63233: "viz",
-		63234: "vj",
-		63235: "vk",
-		63236: "vl",
-		63241: "vm",
-		63242: "vn",
-		63243: "vo",
-		63244: "vocal",
-		63245: "vogel",
-		63246: "vogue",
-		63251: "voice",
-		63252: "void",
-		63253: "volt",
-		63254: "volta",
-		63255: "volvo",
-		63256: "vomit",
-		63261: "von",
-		63262: "voss",
-		63263: "vote",
-		63264: "vouch",
-		63265: "vow",
-		63266: "vowel",
-		63311: "vp",
-		63312: "vq",
-		63313: "vr",
-		63314: "vs",
-		63315: "vt",
-		63316: "vu",
-		63321: "vulcan",
-		63322: "vv",
-		63323: "vvv",
-		63324: "vvvv",
-		63325: "vw",
-		63326: "vx",
-		63331: "vy",
-		63332: "vying",
-		63333: "vz",
-		63334: "w",
-		63335: "w's",
-		63336: "wa",
-		63341: "waals",
-		63342: "wac",
-		63343: "wack",
-		63344: "wacke",
-		63345: "wacky",
-		63346: "waco",
-		63351: "wad",
-		63352: "wade",
-		63353: "wadi",
-		63354: "wafer",
-		63355: "wag",
-		63356: "wage",
-		63361: "waggle",
-		63362: "wah",
-		63363: "wahl",
-		63364: "wail",
-		63365: "waist",
-		63366: "wait",
-		63411: "waite",
-		63412: "waive",
-		63413: "wake",
-		63414: "waken",
-		63415: "waldo",
-		63416: "wale",
-		63421: "walk",
-		63422: "walkie",
-		63423: "wall",
-		63424: "walls",
-		63425: "wally",
-		63426: "walsh",
-		63431: "walt",
-		63432: "walton",
-		63433: "waltz",
-		63434: "wan",
-		63435: "wand",
-		63436: "wane",
-		63441: "wang",
-		63442: "want",
-		63443: "war",
-		63444: "ward",
-		63445: "ware",
-		63446: "warm",
-		63451: "warmth",
-		63452: "warn",
-		63453: "warp",
-		63454: "warren",
-		63455: "wart",
-		63456: "warty",
-		63461: "wary",
-		63462: "was",
-		63463: "wash",
-		63464: "washy",
-		63465: "wasp",
-		63466: "wast",
-		63511: "waste",
-		63512: "watch",
-		63513: "water",
-		63514: "watt",
-		63515: "watts",
-		63516: "wave",
-		63521: "wavy",
-		63522: "wax",
-		63523: "waxen",
-		63524: "waxy",
-		63525: "way",
-		63526: "wayne",
-		63531: "wb",
-		63532: "wc",
-		63533: "wd",
-		63534: "we",
-		63535: "we'd",
-		63536: "we'll",
-		63541: "we're",
-		63542: "we've",
-		63543: "weak",
-		63544: "weal",
-		63545: "wealth",
-		63546: "wean",
-		63551: "wear",
-		63552: "weary",
-		63553: "weave",
-		63554: "web",
-		63555: "webb",
-		63556: "weber",
-		63561: "weco",
-		63562: "wed",
-		63563: "wedge",
-		63564: "wee",
-		63565: "weed",
-		63566: "weedy",
-		63611: "week",
-		63612: "weeks",
-		63613: "weep",
-		63614: "wehr",
-		63615: "wei",
-		63616: "weigh",
-		63621: "weir",
-		63622: "weird",
-		63623: "weiss",
-		63624: "welch",
-		63625: "weld",
-		63626: "well",
-		63631: "wells",
-		63632: "welsh",
-		63633: "welt",
-		63634: "wendy",
-		63635: "went",
-		63636: "wept",
-		63641: "were",
-		63642: "wert",
-		63643: "west",
-		63644: "wet",
-		63645: "wf",
-		63646: "wg",
-		63651: "wh",
-		63652: "whack",
-		63653: "whale",
-		63654: "wham",
-		63655: "wharf",
-		63656: "what",
-		63661: "wheat",
-		63662: "whee",
-		63663: "wheel",
-		63664: "whelk",
-		63665: "whelm",
-		63666: "whelp",
-		64111: "when",
-		64112: "where",
-		64113: "whet",
-		64114: "which",
-		64115: "whiff",
-		64116: "whig",
-		64121: "while",
-		64122: "whim",
-		64123: "whine",
-		64124: "whinny",
-		64125: "whip",
-		64126: "whir",
-		64131: "whirl",
-		64132: "whisk",
-		64133: "whit",
-		64134: "white",
-		64135: "whiz",
-		64136: "who",
-		64141: "who'd",
-		64142: "whoa",
-		64143: "whole",
-		64144: "whom",
-		64145: "whoop",
-		64146: "whoosh",
-		64151: "whop",
-		64152: "whose",
-		64153: "whup",
-		64154: "why",
-		64155: "wi",
-		64156: "wick",
-		64161: "wide",
-		64162: "widen",
-		64163: "widow",
-		64164: "width",
-		64165: "wield",
-		64166: "wier",
-		64211: "wife",
-		64212: "wig",
-		64213: "wild",
-		64214: "wile",
-		64215: "wiley",
-		64216: "wilkes",
-		64221: "will",
-		64222: "willa",
-		64223: "wills",
-		64224: "wilma",
-		64225: "wilt",
-		64226: "wily",
-		64231: "win",
-		64232: "wince",
-		64233: "winch",
-		64234: "wind",
-		64235: "windy",
-		64236: "wine",
-		64241: "wing",
-		64242: "wink",
-		64243: "winnie",
-		64244: "wino",
-		64245: "winter",
-		64246: "winy",
-		64251: "wipe",
-		64252: "wire",
-		64253: "wiry",
-		64254: "wise",
-		64255: "wish",
-		64256: "wishy",
-		64261: "wisp",
-		64262: "wispy",
-		64263: "wit",
-		64264: "witch",
-		64265: "with",
-		64266: "withe",
-		64311: "withy",
-		64312: "witt",
-		64313: "witty",
-		64314: "wive",
-		64315: "wj",
-		64316: "wk",
-		64321: "wl",
-		64322: "wm",
-		64323: "wn",
-		64324: "wo",
-		64325: "woe",
-		64326: "wok",
-		64331: "woke",
-		64332: "wold",
-		64333: "wolf",
-		64334: "wolfe",
-		64335: "wolff",
-		64336: "wolve",
-		64341: "woman",
-		64342: "womb",
-		64343: "women",
-		64344: "won",
-		64345: "won't",
-		64346: "wonder",
-		64351: "wong",
-		64352: "wont",
-		64353: "woo",
-		64354: "wood",
-		64355: "woods",
-		64356: "woody",
-		64361: "wool",
-		64362: "woozy",
-		64363: "word",
-		64364: "wordy",
-		64365: "wore",
-		64366: "work",
-		64411: "world",
-		64412: "worm",
-		64413: "wormy",
-		64414: "worn",
-		64415: "worry",
-		64416: "worse",
-		64421: "worst",
-		64422: "worth",
-		64423: "wotan",
-		64424: "would",
-		64425: "wound",
-		64426: "wove",
-		64431: "woven",
-		64432: "wow",
-		64433: "wp",
-		64434: "wq",
-		64435: "wr",
-		64436: "wrack",
-		64441: "wrap",
-		64442: "wrath",
-		64443: "wreak",
-		64444: "wreck",
-		64445: "wrest",
-		64446: "wring",
-		64451: "wrist",
-		64452: "writ",
-		64453: "write",
-		64454: "writhe",
-		64455: "wrong",
-		64456: "wrote",
-		64461: "wry",
-		64462: "ws",
-		64463: "wt",
-		64464: "wu",
-		64465: "wuhan",
-		64466: "wv",
-		64511: "ww",
-		64512: "www",
-		64513: "wwww",
-		64514: "wx",
-		64515: "wxy",
-		64516: "wy",
-		64521: "wyatt",
-		64522: "wyeth",
-		64523: "wylie",
-		64524: "wyman",
-		64525: "wyner",
-		64526: "wynn",
-		64531: "wz",
-		64532: "x",
-		64533: "x's",
-		64534: "xa",
-		64535: "xb",
-		64536: "xc",
-		64541: "xd",
-		64542: "xe",
-		64543: "xenon",
-		64544: "xerox",
-		64545: "xf",
-		64546: "xg",
-		64551: "xh",
-		64552: "xi",
-		64553: "xj",
-		64554: "xk",
-		64555: "xl",
-		64556: "xm",
-		64561: "xn",
-		64562: "xo",
-		64563: "xp",
-		64564: "xq",
-		64565: "xr",
-		64566: "xs",
-		64611: "xt",
-		64612: "xu",
-		64613: "xv",
-		64614: "xw",
-		64615: "xx",
-		64616: "xxx",
-		64621: "xxxx",
-		64622: "xy",
-		64623: "xylem",
-		64624: "xyz",
-		64625: "xz",
-		64626: "y",
-		64631: "y's",
-		64632: "ya",
-		64633: "yacht",
-		64634: "yah",
-		64635: "yak",
-		64636: "yale",
-		64641: "yalta",
-		64642: "yam",
-		64643: "yamaha",
-		64644: "yang",
-		64645: "yank",
-		64646: "yap",
-		64651: "yaqui",
-		64652: "yard",
-		64653: "yarn",
-		64654: "yates",
-		64655: "yaw",
-		64656: "yawl",
-		64661: "yawn",
-		64662: "yb",
-		64663: "yc",
-		64664: "yd",
-		64665: "ye",
-		64666: "yea",
-		65111: "yeah",
-		65112: "year",
-		65113: "yearn",
-		65114: "yeast",
-		65115: "yeasty",
-		65116: "yeats",
-		65121: "yell",
-		65122: "yelp",
-		65123: "yemen",
-		65124: "yen",
-		65125: "yet",
-		65126: "yf",
-		65131: "yg",
-		65132: "yh",
-		65133: "yi",
-		65134: "yield",
-		65135: "yin",
-		65136: "yip",
-		65141: "yj",
-		65142: "yk",
-		65143: "yl",
-		65144: "ym",
-		65145: "ymca",
-		65146: "yn",
-		65151: "yo",
-		65152: "yodel",
-		65153: "yoder",
-		65154: "yoga",
-		65155: "yogi",
-		65156: "yoke",
-		65161: "yokel",
-		65162: "yolk",
-		65163: "yon",
-		65164: "yond",
-		65165: "yore",
-		65166: "york",
-		65211: "yost",
-		65212: "you",
-		65213: "you'd",
-		65214: "young",
-		65215: "your",
-		65216: "youth",
-		65221: "yow",
-		65222: "yp",
-		65223: "yq",
-		65224: "yr",
-		65225: "ys",
-		65226: "yt",
-		65231: "yu",
-		65232: "yucca",
-		65233: "yuck",
-		65234: "yuh",
-		65235: "yuki",
-		65236: "yukon",
-		65241: "yule",
-		65242: "yv",
-		65243: "yves",
-		65244: "yw",
-		65245: "ywca",
-		65246: "yx",
-		65251: "yy",
-		65252: "yyy",
-		65253: "yyyy",
-		65254: "yz",
-		65255: "z",
-		65256: "z's",
-		65261: "za",
-		65262: "zag",
-		65263: "zaire",
-		65264: "zan",
-		65265: "zap",
-		65266: "zazen",
-		65311: "zb",
-		65312: "zc",
-		65313: "zd",
-		65314: "ze",
-		65315: "zeal",
-		65316: "zealot",
-		65321: "zebra",
-		65322: "zeiss",
-		65323: "zen",
-		65324: "zero",
-		65325: "zest",
-		65326: "zesty",
-		65331: "zeta",
-		65332: "zeus",
-		65333: "zf",
-		65334: "zg",
-		65335: "zh",
-		65336: "zi",
-		65341: "zig",
-		65342: "zilch",
-		65343: "zinc",
-		65344: "zing",
-		65345: "zion",
-		65346: "zip",
-		65351: "zj",
-		65352: "zk",
-		65353: "zl",
-		65354: "zloty",
-		65355: "zm",
-		65356: "zn",
-		65361: "zo",
-		65362: "zoe",
-		65363: "zomba",
-		65364: "zone",
-		65365: "zoo",
-		65366: "zoom",
-		65411: "zorn",
-		65412: "zp",
-		65413: "zq",
-		65414: "zr",
-		65415: "zs",
-		65416: "zt",
-		65421: "zu",
-		65422: "zurich",
-		65423: "zv",
-		65424: "zw",
-		65425: "zx",
-		65426: "zy",
-		65431: "zz",
-		65432: "zzz",
-		65433: "zzzz",
-		65434: "0",
-		65435: "1",
-		65436: "2",
-		65441: "3",
-		65442: "4",
-		65443: "5",
-		65444: "6",
-		65445: "7",
-		65446: "8",
-		65451: "9",
-		65452: "10",
-		65453: "11",
-		65454: "12",
-		65455: "13",
-		65456: "14",
-		65461: "15",
-		65462: "16",
-		65463: "17",
-		65464: "18",
-		65465: "19",
-		65466: "20",
-		65511: "21",
-		65512: "22",
-		65513: "23",
-		65514: "24",
-		65515: "25",
-		65516: "26",
-		65521: "27",
-		65522: "28",
-		65523: "29",
-		65524: "30",
-		65525: "31",
-		65526: "32",
-		65531: "33",
-		65532: "34",
-		65533: "35",
-		65534: "36",
-		65535: "37",
-		65536: "38",
-		65541: "39",
-		65542: "40",
-		65543: "41",
-		65544: "42",
-		65545: "43",
-		65546: "44",
-		65551: "45",
-		65552: "46",
-		65553: "47",
-		65554: "48",
-		65555: "49",
-		65556: "50",
-		65561: "51",
-		65562: "52",
-		65563: "53",
-		65564: "54",
-		65565: "55",
-		65566: "56",
-		65611: "57",
-		65612: "58",
-		65613: "59",
-		65614: "60",
-		65615: "61",
-		65616: "62",
-		65621: "63",
-		65622: "64",
-		65623: "65",
-		65624: "66",
-		65625: "67",
-		65626: "68",
-		65631: "69",
-		65632: "70",
-		65633: "71",
-		65634: "72",
-		65635: "73",
-		65636: "74",
-		65641: "75",
-		65642: "76",
-		65643: "77",
-		65644: "78",
-		65645: "79",
-		65646: "80",
-		65651: "81",
-		65652: "82",
-		65653: "83",
-		65654: "84",
-		65655: "85",
-		65656: "86",
-		65661: "87",
-		65662: "88",
-		65663: "89",
-		65664: "90",
-		65665: "91",
-		65666: "92",
-		66111: "93",
-		66112: "94",
-		66113: "95",
-		66114: "96",
-		66115: "97",
-		66116: "98",
-		66121: "99",
-		66122: "100",
-		66123: "101",
-		66124: "111",
-		66125: "123",
-		66126: "200",
-		66131: "222",
-		66132: "234",
-		66133: "300",
-		66134: "333",
-		66135: "345",
-		66136: "400",
-		66141: "444",
-		66142: "456",
-		66143: "500",
-		66144: "555",
-		66145: "567",
-		66146: "600",
-		66151: "666",
-		66152: "678",
-		66153: "700",
-		66154: "777",
-		66155: "789",
-		66156: "800",
-		66161: "888",
-		66162: "900",
-		66163: "999",
-		66164: "1000",
-		66165: "1111",
-		66166: "1234",
-		66211: "1492",
-		66212: "1500",
-		66213: "1600",
-		66214: "1700",
-		66215: "1776",
-		66216: "1800",
-		66221: "1812",
-		66222: "1900",
-		66223: "1910",
-		66224: "1920",
-		66225: "1925",
-		66226: "1930",
-		66231: "1935",
-		66232: "1940",
-		66233: "1945",
-		66234: "1950",
-		66235: "1955",
-		66236: "1960",
-		66241: "1965",
-		66242: "1970",
-		66243: "1975",
-		66244: "1980",
-		66245: "1985",
-		66246: "1990",
-		66251: "1991",
-		66252: "1992",
-		66253: "1993",
-		66254: "1994",
-		66255: "1995",
-		66256: "1996",
-		66261: "1997",
-		66262: "2000",
-		66263: "2001",
-		66264: "2020",
-		66265: "2222",
-		66266: "2345",
-		66311: "2468",
-		66312: "3000",
-		66313: "3333",
-		66314: "3456",
-		66315: "4000",
-		66316: "4321",
-		66321: "4444",
-		66322: "4567",
-		66323: "5000",
-		66324: "5555",
-		66325: "5678",
-		66326: "6000",
-		66331: "6666",
-		66332: "6789",
-		66333: "7000",
-		66334: "7777",
-		66335: "8000",
-		66336: "8888",
-		66341: "9000",
-		66342: "9876",
-		66343: "9999",
-		66344: "100th",
-		66345: "101st",
-		66346: "10th",
-		66351: "11th",
-		66352: "12th",
-		66353: "13th",
-		66354: "14th",
-		66355: "15th",
-		66356: "16th",
-		66361: "17th",
-		66362: "18th",
-		66363: "19th",
-		66364: "1st",
-		66365: "20th",
-		66366: "21st",
-		66411: "22nd",
-		66412: "23rd",
-		66413: "24th",
-		66414: "25th",
-		66415: "26th",
-		66416: "27th",
-		66421: "28th",
-		66422: "29th",
-		66423: "2nd",
-		66424: "30th",
-		66425: "31st",
-		66426: "32nd",
-		66431: "33rd",
-		66432: "34th",
-		66433: "35th",
-		66434: "36th",
-		66435: "37th",
-		66436: "38th",
-		66441: "39th",
-		66442: "3rd",
-		66443: "40th",
-		66444: "41st",
-		66445: "42nd",
-		66446: "43rd",
-		66451: "44th",
-		66452: "45th",
-		66453: "46th",
-		66454: "47th",
-		66455: "48th",
-		66456: "49th",
-		66461: "4th",
-		66462: "50th",
-		66463: "51st",
-		66464: "52nd",
-		66465: "53rd",
-		66466: "54th",
-		66511: "55th",
-		66512: "56th",
-		66513: "57th",
-		66514: "58th",
-		66515: "59th",
-		66516: "5th",
-		66521: "60th",
-		66522: "61st",
-		66523: "62nd",
-		66524: "63rd",
-		66525: "65th",
-		66526: "66th",
-		66531: "67th",
-		66532: "68th",
-		66533: "69th",
-		66534: "6th",
-		66535: "70th",
-		66536: "71st",
-		66541: "72nd",
-		66542: "73rd",
-		66543: "74th",
-		66544: "75th",
-		66545: "76th",
-		66546: "77th",
-		66551: "78th",
-		66552: "79th",
-		66553: "7th",
-		66554: "80th",
-		66555: "81st",
-		66556: "82nd",
-		66561: "83rd",
-		66562: "84th",
-		66563: "85th",
-		66564: "86th",
-		66565: "87th",
-		66566: "88th",
-		66611: "89th",
-		66612: "8th",
-		66613: "90th",
-		66614: "91st",
-		66615: "92nd",
-		66616: "93rd",
-		66621: "94th",
-		66622: "95th",
-		66623: "96th",
-		66624: "97th",
-		66625: "98th",
-		66626: "99th",
-		66631: "9th",
-		66632: "!",
-		66633: "!!",
-		66634: `"`,
-		66635: "#",
-		66636: "##",
-		66641: "$",
-		66642: "$$",
-		66643: "%",
-		66644: "%%",
-		66645: "&",
-		66646: "(",
-		66651: "()",
-		66652: ")",
-		66653: "*",
-		66654: "**",
-		66655: "+",
-		66656: "-",
-		66661: ":",
-		66662: ";",
-		66663: "=",
-		66664: "?",
-		66665: "??",
-		66666: "@",
-	},
+	originalData,
 )
+
+// OriginalSHA256 represents the SHA-256 digest of the words of Original, as
+// calculated by `Digest` and checked by `VerifyIntegrity`.
+const OriginalSHA256 = "37ed6e9b146abe2b96f22a36a0610384ce679036166fec9536e2ca8cb39ff462"
+
+// originalBuiltins represents Original as a built-in wordlist, along with its
+// expected digest.
+var originalBuiltins = []builtin{{wl: Original, digest: OriginalSHA256, selectable: true}}
@@ -0,0 +1,7 @@
+//go:build diceware_minimal || diceware_no_original
+
+package wordlist
+
+// originalBuiltins represents no built-in wordlist, as Original is left out of the
+// binary by a build tag.
+var originalBuiltins []builtin
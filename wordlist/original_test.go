@@ -1,3 +1,5 @@
+//go:build !diceware_minimal && !diceware_no_original
+
 package wordlist_test
 
 import (
@@ -42,3 +44,21 @@ func TestOriginalRolls(t *testing.T) {
 		"SidesOfDice should return 6",
 	)
 }
+
+func TestOriginalEmbedded(t *testing.T) {
+	assertEmbedded(t, wordlist.Original, "original", 7776, "a", "@")
+}
+
+func TestOriginalMap(t *testing.T) {
+	assert.Equal(t, "original", wordlist.Original.Name())
+}
+
+func TestOriginalValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	report := wordlist.Validate(wordlist.Original)
+	assert.Equal(7776, report.Words)
+	assert.True(report.Valid())
+
+	assert.False(wordlist.IsPrefixFree(wordlist.Original))
+}
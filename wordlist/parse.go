@@ -0,0 +1,209 @@
+package wordlist
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidFormat represents the error given when a line of a wordlist is
+	// not a roll value followed by a word
+	ErrInvalidFormat = errors.New("invalid wordlist format")
+	// ErrIncomplete represents the error given when a wordlist is missing a
+	// word for any of the roll values of its dice
+	ErrIncomplete = errors.New("wordlist is missing words for roll values of the dice")
+)
+
+// ParseError defines the error given when a line of a wordlist is unable to be
+// parsed, retaining the number of the line.
+type ParseError struct {
+	// Line represents the number of the line, starting from 1.
+	Line int
+
+	// Err represents the problem found within the line.
+	Err error
+}
+
+// Error returns a string.
+// It implements the logic for the error interface which describes the line
+// and the problem found within it.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns an error.
+// Implements the logic to allow `errors.Is` to match the problem found within
+// the line.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse returns a Map.
+// Implements the logic required to read a wordlist in the format of the
+// original diceware lists, a roll value followed by whitespace and the word on
+// each line, e.g. "11111	abacus".  Blank lines and lines starting with "#"
+// are skipped.  The number of dice is the number of digits of the roll values
+// and the sides of the dice are the largest digit found, so every roll value
+// of the dice must have a word.  Dice with more than 9 sides are read by
+// `ParseWithOptions`.  Problems within the lines are returned as a
+// *ParseError.
+func Parse(r io.Reader) (*Map, error) {
+	return ParseNamed("", r)
+}
+
+// ParseNamed returns a Map.
+// Implements the logic required to read a wordlist in the format described by
+// `Parse`, identifying the Map by the given name.
+func ParseNamed(name string, r io.Reader) (*Map, error) {
+	return ParseWithOptions(r, ParseOptions{Name: name})
+}
+
+// ParseOptions defines the configuration used to read a wordlist with
+// `ParseWithOptions`.
+type ParseOptions struct {
+	// Name represents the identifier of the wordlist.
+	Name string
+
+	// Sides represents the sides of the dice the wordlist is keyed with, such as
+	// `D10` or `D20`.  The faces of dice with more than 9 sides are zero padded,
+	// e.g. "011620" for a 1, 16 and 20 rolled with 20 sided dice, as written by
+	// `FormatRollValue`.  Zero detects the sides from the largest digit found,
+	// supporting dice with up to 9 sides.
+	Sides int
+}
+
+// ParseWithOptions returns a Map.
+// Implements the logic required to read a wordlist in the format described by
+// `Parse`, keyed with the dice given by the options.  The number of dice is
+// the number of digits of the roll values divided by the digits of each face.
+func ParseWithOptions(r io.Reader, opts ParseOptions) (*Map, error) {
+	if opts.Sides != 0 {
+		if err := ValidateDice(1, opts.Sides); err != nil {
+			return nil, err
+		}
+	}
+
+	builder := newMapBuilder(opts.Sides)
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, &ParseError{Line: line, Err: fmt.Errorf("%w: expected a roll value and a word", ErrInvalidFormat)}
+		}
+
+		if err := builder.add(fields[0], fields[1]); err != nil {
+			return nil, &ParseError{Line: line, Err: err}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading wordlist: %w", err)
+	}
+
+	return builder.build(opts.Name)
+}
+
+// mapBuilder defines the state collected while parsing the roll values and
+// words of a wordlist, before the Map is created.
+type mapBuilder struct {
+	// words represents the word of each roll value.
+	words map[int]string
+
+	// rolls represents the number of digits of the roll values.
+	rolls int
+
+	// sides represents the sides of the dice, which is the largest digit of the
+	// roll values when they are detected.
+	sides int
+
+	// detectSides represents whether the sides are detected from the digits of
+	// the roll values, rather than given.
+	detectSides bool
+}
+
+// newMapBuilder returns an initialized mapBuilder object for dice with the
+// given sides, detecting the sides from the roll values when zero.
+func newMapBuilder(sides int) *mapBuilder {
+	return &mapBuilder{words: map[int]string{}, sides: sides, detectSides: sides == 0}
+}
+
+// add returns an error.
+// Implements the logic to verify that the roll value has a face between 1 and
+// the sides for each die, with as many dice as every previous roll value,
+// before adding the word for it.
+func (b *mapBuilder) add(rollText, word string) error {
+	rollValue, err := b.parseRollValue(rollText)
+	if err != nil {
+		return err
+	}
+
+	if word == "" {
+		return fmt.Errorf("%w: empty word for roll value %d", ErrInvalidFormat, rollValue)
+	}
+
+	if _, found := b.words[rollValue]; found {
+		return fmt.Errorf("%w: duplicate roll value %d", ErrInvalidFormat, rollValue)
+	}
+
+	b.words[rollValue] = word
+
+	return nil
+}
+
+// parseRollValue returns an int.
+// Implements the logic to read the roll value, taking the number of dice from
+// the first roll value read, and detecting the sides from the largest digit
+// when they were not given.
+func (b *mapBuilder) parseRollValue(rollText string) (int, error) {
+	if !b.detectSides {
+		if b.rolls == 0 {
+			b.rolls = max(len(rollText)/faceDigits(b.sides), 1)
+		}
+
+		return ParseRollValue(rollText, b.rolls, b.sides)
+	}
+
+	rollValue, err := strconv.Atoi(rollText)
+	if err != nil || strings.ContainsAny(rollText, "0+-") {
+		return 0, fmt.Errorf("%w: invalid roll value %q", ErrInvalidFormat, rollText)
+	}
+
+	if b.rolls == 0 {
+		b.rolls = len(rollText)
+	}
+
+	if len(rollText) != b.rolls {
+		return 0, fmt.Errorf("%w: roll value %q is not %d digits", ErrInvalidFormat, rollText, b.rolls)
+	}
+
+	for rest := rollValue; rest > 0; rest /= 10 {
+		b.sides = max(b.sides, rest%10)
+	}
+
+	return rollValue, nil
+}
+
+// build returns a Map.
+// Implements the logic to create the Map from the added words, verifying that
+// every roll value of the dice has a word.
+func (b *mapBuilder) build(name string) (*Map, error) {
+	if len(b.words) == 0 {
+		return nil, fmt.Errorf("%w: no words found", ErrInvalidFormat)
+	}
+
+	if expected := combinations(b.rolls, b.sides); len(b.words) != expected {
+		return nil, fmt.Errorf("%w: %d of %d words found", ErrIncomplete, len(b.words), expected)
+	}
+
+	return newMap(name, b.rolls, b.sides, b.words), nil
+}
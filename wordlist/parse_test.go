@@ -0,0 +1,200 @@
+package wordlist_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Input string
+		Rolls int
+		Sides int
+		Words map[int]string
+		Line  int
+		Error error
+	}{
+		{
+			Name:  "will parse a complete wordlist",
+			Input: "# comment\n11\tacid\n12\tcat\n\n21 horse\r\n22   zoom\n",
+			Rolls: 2,
+			Sides: 2,
+			Words: map[int]string{11: "acid", 12: "cat", 21: "horse", 22: "zoom"},
+		}, {
+			Name:  "will error with a line missing the word",
+			Input: "11 acid\n12\n",
+			Line:  2,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a word containing whitespace",
+			Input: "1 acid rain\n",
+			Line:  1,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a roll value that is not a number",
+			Input: "1a acid\n",
+			Line:  1,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a roll value containing a zero",
+			Input: "10 acid\n",
+			Line:  1,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with roll values of different lengths",
+			Input: "11 acid\n1 cat\n",
+			Line:  2,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with a duplicate roll value",
+			Input: "1 acid\n1 cat\n",
+			Line:  2,
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error without any words",
+			Input: "# empty\n",
+			Error: wordlist.ErrInvalidFormat,
+		}, {
+			Name:  "will error with missing roll values",
+			Input: "11 acid\n12 cat\n21 horse\n",
+			Error: wordlist.ErrIncomplete,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.Parse(strings.NewReader(test.Input))
+		assert.ErrorIs(err, test.Error, test.Name)
+
+		var parseErr *wordlist.ParseError
+		if errors.As(err, &parseErr) {
+			assert.Equal(test.Line, parseErr.Line, test.Name)
+		} else {
+			assert.Zero(test.Line, test.Name)
+		}
+
+		if test.Error != nil {
+			continue
+		}
+
+		assert.Equal(test.Rolls, wl.Rolls(), test.Name)
+		assert.Equal(int64(test.Sides), wl.SidesOfDice().Int64(), test.Name)
+		for rollValue, word := range test.Words {
+			assert.Equal(word, wl.FetchWord(rollValue), test.Name)
+		}
+	}
+}
+
+// diceInput returns a wordlist in the text format for the given dice, with a
+// word for each roll value.
+func diceInput(rolls, sides int) string {
+	count := 1
+	for i := 0; i < rolls; i++ {
+		count *= sides
+	}
+
+	var input strings.Builder
+	for index := 0; index < count; index++ {
+		rollValue := wordlist.RollValueForIndex(index, rolls, sides)
+		input.WriteString(wordlist.FormatRollValue(rollValue, rolls, sides) + " word" +
+			wordlist.FormatRollValue(rollValue, rolls, sides) + "\n")
+	}
+
+	return input.String()
+}
+
+func TestParseWithOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name    string
+		Input   string
+		Options wordlist.ParseOptions
+		Rolls   int
+		Sides   int
+		Words   map[int]string
+		Line    int
+		Error   error
+	}{
+		{
+			Name:    "will parse a wordlist keyed with 20 sided dice",
+			Input:   diceInput(1, 20),
+			Options: wordlist.ParseOptions{Name: "d20", Sides: wordlist.D20},
+			Rolls:   1,
+			Sides:   20,
+			Words:   map[int]string{1: "word01", 20: "word20"},
+		}, {
+			Name:    "will parse a wordlist keyed with 2 10 sided dice",
+			Input:   diceInput(2, 10),
+			Options: wordlist.ParseOptions{Name: "d10", Sides: wordlist.D10},
+			Rolls:   2,
+			Sides:   10,
+			Words:   map[int]string{101: "word0101", 1010: "word1010"},
+		}, {
+			Name:    "will parse a wordlist keyed with 6 sided dice",
+			Input:   "1 acid\n2 cat\n3 horse\n4 zoom\n5 mouse\n6 staple\n",
+			Options: wordlist.ParseOptions{Sides: wordlist.D6},
+			Rolls:   1,
+			Sides:   6,
+			Words:   map[int]string{1: "acid", 6: "staple"},
+		}, {
+			Name:    "will error with a face larger than the sides",
+			Input:   "01 acid\n21 cat\n",
+			Options: wordlist.ParseOptions{Sides: wordlist.D20},
+			Line:    2,
+			Error:   wordlist.ErrInvalidFormat,
+		}, {
+			Name:    "will error with a face without zero padding",
+			Input:   "01 acid\n2 cat\n",
+			Options: wordlist.ParseOptions{Sides: wordlist.D20},
+			Line:    2,
+			Error:   wordlist.ErrInvalidFormat,
+		}, {
+			Name:    "will error with a zero face",
+			Input:   "00 acid\n",
+			Options: wordlist.ParseOptions{Sides: wordlist.D20},
+			Line:    1,
+			Error:   wordlist.ErrInvalidFormat,
+		}, {
+			Name:    "will error with missing roll values",
+			Input:   "01 acid\n02 cat\n",
+			Options: wordlist.ParseOptions{Sides: wordlist.D20},
+			Error:   wordlist.ErrIncomplete,
+		}, {
+			Name:    "will error with invalid sides",
+			Input:   "1 acid\n",
+			Options: wordlist.ParseOptions{Sides: 1},
+			Error:   wordlist.ErrInvalidDice,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.ParseWithOptions(strings.NewReader(test.Input), test.Options)
+		assert.ErrorIs(err, test.Error, test.Name)
+
+		var parseErr *wordlist.ParseError
+		if errors.As(err, &parseErr) {
+			assert.Equal(test.Line, parseErr.Line, test.Name)
+		} else {
+			assert.Zero(test.Line, test.Name)
+		}
+
+		if test.Error != nil {
+			continue
+		}
+
+		assert.Equal(test.Options.Name, wl.Name(), test.Name)
+		assert.Equal(test.Rolls, wl.Rolls(), test.Name)
+		assert.Equal(int64(test.Sides), wl.SidesOfDice().Int64(), test.Name)
+		assert.True(wordlist.Validate(wl).Valid(), test.Name)
+		for rollValue, word := range test.Words {
+			assert.Equal(word, wl.FetchWord(rollValue), test.Name)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package wordlist
+
+import "sort"
+
+// PrefixViolation defines a pair of words of a wordlist where one word is a
+// prefix of the other, which makes concatenating the words without a
+// separator ambiguous.
+type PrefixViolation struct {
+	// Prefix represents the word found at the start of Word.
+	Prefix string
+
+	// Word represents the word starting with Prefix.
+	Word string
+}
+
+// PrefixViolations returns a slice of PrefixViolations.
+// Implements the logic required to find every pair of distinct words within
+// the wordlist where one word is a prefix of the other, sorted by the prefix
+// and then the word.  A wordlist without any violations is prefix-free, such
+// as the EFF short prefix wordlist, so its words are able to be concatenated
+// without a separator and still be told apart.
+func PrefixViolations(wl Source) []PrefixViolation {
+	words := uniqueWords(wl)
+	sort.Strings(words)
+
+	violations := []PrefixViolation{}
+	for i, prefix := range words {
+		// the words starting with the prefix directly follow it once sorted
+		for _, word := range words[i+1:] {
+			if len(word) < len(prefix) || word[:len(prefix)] != prefix {
+				break
+			}
+
+			violations = append(violations, PrefixViolation{Prefix: prefix, Word: word})
+		}
+	}
+
+	return violations
+}
+
+// IsPrefixFree returns a bool.
+// Implements the logic to check whether no word within the wordlist is a
+// prefix of another word.
+func IsPrefixFree(wl Source) bool {
+	return len(PrefixViolations(wl)) == 0
+}
+
+// uniqueWords returns a slice of strings.
+// Implements the logic to collect each distinct word found for the roll
+// values of the dice of the wordlist.
+func uniqueWords(wl Source) []string {
+	sides := int(wl.SidesOfDice().Int64())
+	rolls := wl.Rolls()
+
+	seen := map[string]bool{}
+	words := []string{}
+	for index := 0; index < rollCount(wl); index++ {
+		word := wl.FetchWord(RollValueForIndex(index, rolls, sides))
+		if word != "" && !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
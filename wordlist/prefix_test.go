@@ -0,0 +1,48 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixViolations(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Wordlist   wordlist.Source
+		Violations []wordlist.PrefixViolation
+	}{
+		{
+			Name: "will find each word that is a prefix of another",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{
+				1: "cat",
+				2: "catalog",
+				3: "dog",
+				4: "category",
+				5: "ca",
+				6: "dogma",
+			}),
+			Violations: []wordlist.PrefixViolation{
+				{Prefix: "ca", Word: "cat"},
+				{Prefix: "ca", Word: "catalog"},
+				{Prefix: "ca", Word: "category"},
+				{Prefix: "cat", Word: "catalog"},
+				{Prefix: "cat", Word: "category"},
+				{Prefix: "dog", Word: "dogma"},
+			},
+		}, {
+			Name:       "will ignore duplicated words",
+			Wordlist:   wordlist.NewMap(1, 2, map[int]string{1: "cat", 2: "cat"}),
+			Violations: []wordlist.PrefixViolation{},
+		},
+	}
+
+	for _, test := range tests {
+		violations := wordlist.PrefixViolations(test.Wordlist)
+		assert.Equal(test.Violations, violations, test.Name)
+		assert.Equal(len(test.Violations) == 0, wordlist.IsPrefixFree(test.Wordlist), test.Name)
+	}
+}
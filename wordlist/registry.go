@@ -0,0 +1,131 @@
+package wordlist
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrAlreadyRegistered represents the error given when a wordlist is
+	// registered under a name already in use
+	ErrAlreadyRegistered = errors.New("wordlist already registered")
+	// ErrNotRegistered represents the error given when no wordlist is
+	// registered under the requested name
+	ErrNotRegistered = errors.New("wordlist not registered")
+)
+
+// builtin defines a wordlist compiled into the binary along with the expected
+// SHA-256 digest of its words.
+type builtin struct {
+	// wl represents the built-in wordlist.
+	wl interface {
+		Source
+		Name() string
+	}
+
+	// digest represents the hexadecimal SHA-256 digest of its words, which is
+	// checked by `VerifyIntegrity`.
+	digest string
+
+	// selectable represents whether the wordlist is registered under its name,
+	// which the wordlists of characters, such as Digits, are not.
+	selectable bool
+}
+
+// builtins represents every built-in wordlist compiled into the binary, where
+// each wordlist left out by a build tag gives an empty slice.
+var builtins = slices.Concat(
+	[]builtin{
+		{wl: EFFLong, digest: EFFLongSHA256, selectable: true},
+		{wl: Digits, digest: DigitsSHA256},
+		{wl: ExtraEntropy, digest: ExtraEntropySHA256},
+	},
+	originalBuiltins,
+	effShortBuiltins,
+	effShortPrefixBuiltins,
+	bip39EnglishBuiltins,
+	miniBuiltins,
+	emojiBuiltins,
+)
+
+// registry represents the wordlists registered by name, which starts out with
+// each of the selectable built-in wordlists compiled into the binary.
+var registry = struct {
+	sync.RWMutex
+	lists map[string]Source
+}{lists: builtinLists()}
+
+// builtinLists returns a map of Sources.
+// Implements the logic to key each of the selectable built-in wordlists by
+// its name.
+func builtinLists() map[string]Source {
+	lists := make(map[string]Source, len(builtins))
+	for _, b := range builtins {
+		if b.selectable {
+			lists[b.wl.Name()] = b.wl
+		}
+	}
+
+	return lists
+}
+
+// Register returns an error.
+// Implements the logic to make the wordlist available from `Get` under the
+// given name, so that wordlists can be selected by a string from a
+// configuration file or command line flag.  An error wrapping
+// ErrAlreadyRegistered is returned when the name is already in use, including
+// the names of the built-in wordlists, such as "eff-long".
+func Register(name string, wl Source) error {
+	if name == "" || wl == nil {
+		return fmt.Errorf("%w: a name and wordlist are required", ErrInvalidWordlist)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, found := registry.lists[name]; found {
+		return fmt.Errorf("%w: %q", ErrAlreadyRegistered, name)
+	}
+
+	registry.lists[name] = wl
+
+	return nil
+}
+
+// Get returns a Source.
+// Implements the logic to retrieve the wordlist registered under the given
+// name, returning an error wrapping ErrNotRegistered when there is none.  The
+// built-in wordlists are registered under their names, e.g. "eff-long",
+// "eff-short", "eff-short-prefix" and "original", unless they were left out
+// of the binary by a build tag.
+func Get(name string) (Source, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	wl, found := registry.lists[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %q", ErrNotRegistered, name)
+	}
+
+	return wl, nil
+}
+
+// Names returns a slice of strings.
+// Implements the logic to list the names of every registered wordlist in
+// ascending order.
+func Names() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.lists))
+	for name := range registry.lists {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,54 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name         string
+		WordlistName string
+		Wordlist     wordlist.Source
+		Error        error
+	}{
+		{
+			Name:         "will get the EFF long wordlist",
+			WordlistName: "eff-long",
+			Wordlist:     wordlist.EFFLong,
+		}, {
+			Name:         "will error for an unknown name",
+			WordlistName: "klingon",
+			Error:        wordlist.ErrNotRegistered,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.Get(test.WordlistName)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Wordlist, wl, test.Name)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	assert := assert.New(t)
+
+	custom := wordlist.NewNamedMap("custom", 1, 2, map[int]string{1: "acid", 2: "zoom"})
+
+	assert.NoError(wordlist.Register("test-custom", custom))
+	assert.ErrorIs(wordlist.Register("test-custom", custom), wordlist.ErrAlreadyRegistered)
+	assert.ErrorIs(wordlist.Register("eff-long", custom), wordlist.ErrAlreadyRegistered)
+	assert.ErrorIs(wordlist.Register("", custom), wordlist.ErrInvalidWordlist)
+	assert.ErrorIs(wordlist.Register("test-nil", nil), wordlist.ErrInvalidWordlist)
+
+	wl, err := wordlist.Get("test-custom")
+	if assert.NoError(err) {
+		assert.Equal(custom, wl)
+	}
+
+	assert.Subset(wordlist.Names(), []string{"eff-long", "test-custom"})
+}
@@ -0,0 +1,66 @@
+package wordlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooManyWords represents the error given when a wordlist has more words
+// than the roll values of the dice it is rekeyed with
+var ErrTooManyWords = errors.New("too many words for the roll values of the dice")
+
+// RekeyMode defines how `Rekey` handles a wordlist with more words than the
+// roll values of the dice it is rekeyed with.
+type RekeyMode int
+
+const (
+	// RekeyStrict represents returning an error wrapping ErrTooManyWords
+	// whenever any of the words would be left out.
+	RekeyStrict RekeyMode = iota
+	// RekeyTruncate represents keeping the words of the lowest roll values and
+	// leaving out the rest, which reduces the wordlist to the roll values of
+	// the dice.
+	RekeyTruncate
+)
+
+// Rekey returns a Map.
+// Implements the logic required to key the words of the given wordlist with
+// the roll values of a different number of dice with the given sides, in
+// ascending order of their original roll values, so that a 5 dice wordlist is
+// able to be rolled with 4 physical dice and the reverse.  The wordlist must
+// have a word for every roll value of the new dice, otherwise an error
+// wrapping ErrIncomplete is returned.  Words beyond the roll values of the
+// new dice are handled as given by the mode.  The new wordlist is named after
+// the given wordlist followed by its dice, e.g. "eff-long-4d6".
+func Rekey(wl Source, rolls, sides int, mode RekeyMode) (*Map, error) {
+	if err := ValidateDice(rolls, sides); err != nil {
+		return nil, err
+	}
+
+	total := combinations(rolls, sides)
+	words := make(map[int]string, total)
+	index := 0
+	for _, word := range allWords(wl) {
+		if index == total {
+			if mode == RekeyTruncate {
+				break
+			}
+
+			return nil, fmt.Errorf("%w: more than %d words for %d dice with %d sides", ErrTooManyWords, total, rolls, sides)
+		}
+
+		words[RollValueForIndex(index, rolls, sides)] = word
+		index++
+	}
+
+	if index < total {
+		return nil, fmt.Errorf("%w: %d of %d words found", ErrIncomplete, index, total)
+	}
+
+	name := ""
+	if named, ok := wl.(interface{ Name() string }); ok && named.Name() != "" {
+		name = fmt.Sprintf("%s-%dd%d", named.Name(), rolls, sides)
+	}
+
+	return newMap(name, rolls, sides, words), nil
+}
@@ -0,0 +1,77 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRekey(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Source
+		Rolls    int
+		Sides    int
+		Mode     wordlist.RekeyMode
+		Length   int
+		Value    string
+		Error    error
+	}{
+		{
+			Name:     "will rekey a 5 dice wordlist with a single die of 7776 sides",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    1,
+			Sides:    7776,
+			Length:   7776,
+			Value:    "eff-long-1d7776",
+		}, {
+			Name:     "will truncate a 5 dice wordlist to 4 dice",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    4,
+			Sides:    6,
+			Mode:     wordlist.RekeyTruncate,
+			Length:   1296,
+			Value:    "eff-long-4d6",
+		}, {
+			Name:     "will error for a 5 dice wordlist keyed with 4 dice",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    4,
+			Sides:    6,
+			Error:    wordlist.ErrTooManyWords,
+		}, {
+			Name:     "will error for a 5 dice wordlist keyed with 6 dice",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    6,
+			Sides:    6,
+			Mode:     wordlist.RekeyTruncate,
+			Error:    wordlist.ErrIncomplete,
+		}, {
+			Name:     "will error for invalid dice",
+			Wordlist: wordlist.EFFLong,
+			Rolls:    0,
+			Sides:    6,
+			Error:    wordlist.ErrInvalidDice,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.Rekey(test.Wordlist, test.Rolls, test.Sides, test.Mode)
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error != nil {
+			continue
+		}
+
+		assert.Equal(test.Value, wl.Name(), test.Name)
+		assert.Equal(test.Length, wl.Length(), test.Name)
+		assert.True(wordlist.Validate(wl).Valid(), test.Name)
+	}
+
+	rekeyed, err := wordlist.Rekey(wordlist.EFFLong, 4, 6, wordlist.RekeyTruncate)
+	if assert.NoError(err) {
+		assert.Equal("abacus", rekeyed.FetchWord(1111))
+		assert.Equal(wordlist.EFFLong.WordAt(1295), rekeyed.FetchWord(6666))
+	}
+}
@@ -0,0 +1,81 @@
+package wordlist
+
+import "math"
+
+// combinations returns an int.
+// Implements the logic to calculate the number of distinct results of rolling
+// the given number of dice with the given number of sides, which is zero when
+// the result does not fit within an int.
+func combinations(rolls, sides int) int {
+	if rolls < 1 || sides < 1 {
+		return 0
+	}
+
+	total := 1
+	for i := 0; i < rolls; i++ {
+		if total > math.MaxInt/sides {
+			return 0
+		}
+
+		total *= sides
+	}
+
+	return total
+}
+
+// RollPlace returns an int.
+// Implements the logic to calculate the multiplier between the digits of
+// consecutive dice within a roll value, which is 10 raised to the number of
+// decimal digits of the sides.  Dice with up to 9 sides contribute a single
+// digit each, e.g. 13624, while larger dice contribute zero padded digits,
+// e.g. 0116 for a 1 and a 16 rolled with 16-sided dice.
+func RollPlace(sides int) int {
+	place := 10
+	for place <= sides {
+		place *= 10
+	}
+
+	return place
+}
+
+// RollValueForIndex returns an int.
+// Implements the logic to convert an index within [0, sides^rolls) into the
+// roll value of the dice, where each die contributes its face value between 1
+// and the number of sides, with the first die being the most significant.
+func RollValueForIndex(index, rolls, sides int) int {
+	rollValue := 0
+	place := 1
+	for i := 0; i < rolls; i++ {
+		rollValue += place * (index%sides + 1)
+		index /= sides
+		place *= RollPlace(sides)
+	}
+
+	return rollValue
+}
+
+// IndexForRollValue returns an int and a bool.
+// Implements the logic to convert a roll value back into its index within
+// [0, sides^rolls), the reverse of RollValueForIndex.  False is returned when
+// the roll value is unable to be produced by the dice.
+func IndexForRollValue(rollValue, rolls, sides int) (int, bool) {
+	if rollValue < 0 {
+		return 0, false
+	}
+
+	index := 0
+	place := 1
+	rollPlace := RollPlace(sides)
+	for i := 0; i < rolls; i++ {
+		face := rollValue % rollPlace
+		if face < 1 || face > sides {
+			return 0, false
+		}
+
+		index += place * (face - 1)
+		rollValue /= rollPlace
+		place *= sides
+	}
+
+	return index, rollValue == 0
+}
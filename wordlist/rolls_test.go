@@ -0,0 +1,173 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollValueForIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		Index     int
+		Rolls     int
+		Sides     int
+		RollValue int
+	}{
+		{
+			Name:      "will convert the first index",
+			Index:     0,
+			Rolls:     5,
+			Sides:     6,
+			RollValue: 11111,
+		}, {
+			Name:      "will convert the second index",
+			Index:     1,
+			Rolls:     5,
+			Sides:     6,
+			RollValue: 11112,
+		}, {
+			Name:      "will carry into the next die",
+			Index:     6,
+			Rolls:     5,
+			Sides:     6,
+			RollValue: 11121,
+		}, {
+			Name:      "will convert the last index",
+			Index:     7775,
+			Rolls:     5,
+			Sides:     6,
+			RollValue: 66666,
+		}, {
+			Name:      "will convert a single die",
+			Index:     9,
+			Rolls:     1,
+			Sides:     10,
+			RollValue: 10,
+		}, {
+			Name:      "will pad the digits of dice with more than 9 sides",
+			Index:     15,
+			Rolls:     4,
+			Sides:     16,
+			RollValue: 1010116,
+		}, {
+			Name:      "will convert the last index of a 65,536 word wordlist",
+			Index:     65535,
+			Rolls:     4,
+			Sides:     16,
+			RollValue: 16161616,
+		}, {
+			Name:      "will convert a single die with 65,536 sides",
+			Index:     65535,
+			Rolls:     1,
+			Sides:     65536,
+			RollValue: 65536,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.RollValue, wordlist.RollValueForIndex(test.Index, test.Rolls, test.Sides), test.Name)
+	}
+}
+
+func TestIndexForRollValue(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name      string
+		RollValue int
+		Rolls     int
+		Sides     int
+		Index     int
+		Valid     bool
+	}{
+		{
+			Name:      "will convert the first roll value",
+			RollValue: 11111,
+			Rolls:     5,
+			Sides:     6,
+			Index:     0,
+			Valid:     true,
+		}, {
+			Name:      "will convert the last roll value",
+			RollValue: 6666,
+			Rolls:     4,
+			Sides:     6,
+			Index:     1295,
+			Valid:     true,
+		}, {
+			Name:      "will reject a face larger than the sides of the dice",
+			RollValue: 11117,
+			Rolls:     5,
+			Sides:     6,
+		}, {
+			Name:      "will reject a face of zero",
+			RollValue: 11011,
+			Rolls:     5,
+			Sides:     6,
+		}, {
+			Name:      "will reject too many dice",
+			RollValue: 111111,
+			Rolls:     5,
+			Sides:     6,
+		}, {
+			Name:      "will reject a negative roll value",
+			RollValue: -1,
+			Rolls:     1,
+			Sides:     6,
+		}, {
+			Name:      "will convert the padded digits of dice with more than 9 sides",
+			RollValue: 1010116,
+			Rolls:     4,
+			Sides:     16,
+			Index:     15,
+			Valid:     true,
+		}, {
+			Name:      "will not convert a padded digit greater than the sides",
+			RollValue: 1010117,
+			Rolls:     4,
+			Sides:     16,
+		},
+	}
+
+	for _, test := range tests {
+		index, valid := wordlist.IndexForRollValue(test.RollValue, test.Rolls, test.Sides)
+		assert.Equal(test.Valid, valid, test.Name)
+		assert.Equal(test.Index, index, test.Name)
+	}
+}
+
+func TestRollPlace(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Sides int
+		Value int
+	}{
+		{
+			Name:  "will give a single digit to dice with up to 9 sides",
+			Sides: 6,
+			Value: 10,
+		}, {
+			Name:  "will give 2 digits to dice with 10 sides",
+			Sides: 10,
+			Value: 100,
+		}, {
+			Name:  "will give 2 digits to dice with 20 sides",
+			Sides: 20,
+			Value: 100,
+		}, {
+			Name:  "will give 5 digits to dice with 65,536 sides",
+			Sides: 65536,
+			Value: 100000,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.RollPlace(test.Sides), test.Name)
+	}
+}
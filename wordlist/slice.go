@@ -0,0 +1,220 @@
+package wordlist
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"math/big"
+	"sync"
+)
+
+// Slice defines the implementation of the Wordlist interface having a
+// `[]string` be the main way of storing the wordlist in go, supporting any
+// number of words rather than only a power of the sides of the dice.  The
+// words are keyed with the roll values of the dice in ascending order, and
+// the roll values past the last word are rejected, so they must be rerolled.
+// Each word is therefore sampled uniformly, either by sampling a single index
+// or by rerolling the dice until a roll value with a word is rolled.
+type Slice struct {
+	// name represents the identifier of the wordlist.
+	name string
+
+	// rolls represents the number of dice rolled to select a word.
+	rolls int
+
+	// sidesOfDice represents the sides on each of the dice.
+	sidesOfDice *big.Int
+
+	// words represents the words in the ascending order of their roll values,
+	// which are only loaded once entries has been called.
+	words []string
+
+	// load represents the function building the words of an embedded wordlist
+	// on first use, so that unused wordlists do not cost any memory.
+	load func() []string
+
+	// once represents the guard ensuring the words are loaded a single time.
+	once sync.Once
+
+	// cache represents the WordIndex of the wordlist, as its words never
+	// change.
+	cache *indexCache
+}
+
+// FromSlice returns a Slice.
+// Implements the logic required to build a wordlist from the given words,
+// keyed with roll values in the order given.  The common physical dice with
+// the fewest roll values covering every word are chosen, e.g. 5 6 sided dice
+// for 5000 words, so that the fewest rolls are rejected.  An error wrapping
+// ErrInvalidWordlist is returned for empty or duplicated words, and one
+// wrapping ErrTooFewWords for fewer than `MinWords` words.
+func FromSlice(words []string) (*Slice, error) {
+	seen := make(map[string]bool, len(words))
+	for index, word := range words {
+		if word == "" {
+			return nil, fmt.Errorf("%w: empty word at index %d", ErrInvalidWordlist, index)
+		}
+
+		if seen[word] {
+			return nil, fmt.Errorf("%w: duplicated word %q at index %d", ErrInvalidWordlist, word, index)
+		}
+
+		seen[word] = true
+	}
+
+	return fromWords("", words)
+}
+
+// newSlice returns an initialized Slice object identified by the given name,
+// keyed with the dice chosen by `geometryFor` and taking ownership of the
+// given words.
+func newSlice(name string, words []string) *Slice {
+	wl := &Slice{name: name, cache: &indexCache{}}
+	wl.setWords(words)
+
+	return wl
+}
+
+// newLazySlice returns an initialized Slice object identified by the given
+// name, whose words are built by load, and whose dice are chosen, the first
+// time they are needed.
+func newLazySlice(name string, load func() []string) *Slice {
+	return &Slice{name: name, load: load, cache: &indexCache{}}
+}
+
+// newEmbeddedSlice returns an initialized Slice object identified by the given
+// name, whose words are decompressed from the given data, as read by
+// `decodeWords`, the first time they are needed.
+func newEmbeddedSlice(name string, data []byte) *Slice {
+	return newLazySlice(name, func() []string { return decodeWords(data) })
+}
+
+// entries returns a slice of strings.
+// Implements the logic to build the words of the wordlist and choose its dice
+// on first use, safely when called by multiple goroutines at once.
+func (wl *Slice) entries() []string {
+	wl.once.Do(func() {
+		if wl.load != nil {
+			wl.setWords(wl.load())
+			wl.load = nil
+		}
+	})
+
+	return wl.words
+}
+
+// setWords implements the logic to store the words of the wordlist alongside
+// the dice chosen by `geometryFor` to key them.
+func (wl *Slice) setWords(words []string) {
+	rolls, sides := geometryFor(len(words))
+
+	wl.rolls = rolls
+	wl.sidesOfDice = big.NewInt(int64(sides))
+	wl.words = words
+}
+
+// FetchWord returns a string.
+// It implements the logic for the Wordlist interface which pulls the word of
+// the roll value, which is empty for the rejected roll values.
+func (wl *Slice) FetchWord(diceRoll int) string {
+	word, _ := wl.FetchWordE(diceRoll)
+	return word
+}
+
+// FetchWordE returns a string.
+// It implements the logic for the CheckedWordlist interface which pulls the
+// word of the roll value, reporting whether the roll value was unable to be
+// rolled with the dice or was rejected and must be rerolled.
+func (wl *Slice) FetchWordE(diceRoll int) (string, error) {
+	words := wl.entries()
+
+	index, valid := IndexForRollValue(diceRoll, wl.rolls, int(wl.sidesOfDice.Int64()))
+	if !valid {
+		return "", fmt.Errorf("%w: %d", ErrRollOutOfRange, diceRoll)
+	}
+
+	if index >= len(words) {
+		return "", fmt.Errorf("%w: %d", ErrRollRejected, diceRoll)
+	}
+
+	return words[index], nil
+}
+
+// Name returns a string.
+// It implements the logic for the NamedWordlist interface which gives the
+// identifier of the wordlist.
+func (wl *Slice) Name() string {
+	return wl.name
+}
+
+// Rolls returns an int.
+// It implements the logic for the Wordlist interface which gives the number of
+// dice rolls that should occur in order to create the correct number to
+// retrieve a word from the wordlist.
+func (wl *Slice) Rolls() int {
+	wl.entries()
+	return wl.rolls
+}
+
+// SidesOfDice returns an int.
+// Implements the logic for the Wordlist interface which gives the number of
+// sides on the dice that will be rolled.
+func (wl *Slice) SidesOfDice() *big.Int {
+	wl.entries()
+	return wl.sidesOfDice
+}
+
+// Len returns an int.
+// It implements the logic for the IndexedWordlist interface which gives the
+// number of words, excluding the rejected roll values.
+func (wl *Slice) Len() int {
+	return len(wl.entries())
+}
+
+// Length returns an int.
+// It implements the logic for the Sizer interface which gives the number of
+// words, which is the same as Len.
+func (wl *Slice) Length() int {
+	return len(wl.entries())
+}
+
+// WordAt returns a string.
+// It implements the logic for the IndexedWordlist interface which pulls the
+// word found at the given index.
+func (wl *Slice) WordAt(index int) string {
+	words := wl.entries()
+	if index < 0 || index >= len(words) {
+		return ""
+	}
+
+	return words[index]
+}
+
+// Words returns an iter.Seq2 of ints and strings.
+// It implements the logic for the Enumerable interface which yields the roll
+// value and word of each word in the order they were given, leaving out the
+// rejected roll values.
+func (wl *Slice) Words() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		words := wl.entries()
+		sides := int(wl.sidesOfDice.Int64())
+
+		for index, word := range words {
+			if !yield(RollValueForIndex(index, wl.rolls, sides), word) {
+				return
+			}
+		}
+	}
+}
+
+// Entropy returns a float64.
+// Implements the logic to calculate the entropy in bits provided by each word
+// sampled uniformly from the wordlist.
+func (wl *Slice) Entropy() float64 {
+	words := wl.entries()
+	if len(words) == 0 {
+		return 0
+	}
+
+	return math.Log2(float64(len(words)))
+}
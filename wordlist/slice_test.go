@@ -0,0 +1,149 @@
+package wordlist_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceOf returns a slice of count distinct words.
+func sliceOf(count int) []string {
+	words := make([]string, count)
+	for index := range words {
+		words[index] = fmt.Sprintf("word%05d", index)
+	}
+
+	return words
+}
+
+func TestFromSlice(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name  string
+		Words []string
+		Rolls int
+		Sides int64
+		Error error
+	}{
+		{
+			Name:  "will use 6 sided dice for 7776 words",
+			Words: sliceOf(7776),
+			Rolls: 5,
+			Sides: 6,
+		}, {
+			Name:  "will use 8 sided dice for 4096 words",
+			Words: sliceOf(4096),
+			Rolls: 4,
+			Sides: 8,
+		}, {
+			Name:  "will use 10 sided dice for 10000 words",
+			Words: sliceOf(10000),
+			Rolls: 4,
+			Sides: 10,
+		}, {
+			Name:  "will use 4 sided dice for the 65536 words of niceware",
+			Words: sliceOf(65536),
+			Rolls: 8,
+			Sides: 4,
+		}, {
+			Name:  "will use the dice rejecting the fewest rolls for any other number of words",
+			Words: sliceOf(5000),
+			Rolls: 5,
+			Sides: 6,
+		}, {
+			Name:  "will error with too few words",
+			Words: sliceOf(1000),
+			Error: wordlist.ErrTooFewWords,
+		}, {
+			Name:  "will error with an empty word",
+			Words: append(sliceOf(2000), ""),
+			Error: wordlist.ErrInvalidWordlist,
+		}, {
+			Name:  "will error with a duplicated word",
+			Words: append(sliceOf(2000), "word00001"),
+			Error: wordlist.ErrInvalidWordlist,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.FromSlice(test.Words)
+		if test.Error != nil {
+			assert.ErrorIs(err, test.Error, test.Name)
+			assert.Nil(wl, test.Name)
+			continue
+		}
+
+		if !assert.NoError(err, test.Name) {
+			continue
+		}
+
+		assert.Equal(test.Rolls, wl.Rolls(), test.Name)
+		assert.Equal(test.Sides, wl.SidesOfDice().Int64(), test.Name)
+		assert.True(wordlist.Validate(wl).Valid(), test.Name)
+		for index, word := range test.Words {
+			assert.Equal(word, wl.WordAt(index), test.Name)
+		}
+	}
+}
+
+func TestSliceFetchWordE(t *testing.T) {
+	assert := assert.New(t)
+
+	// 5000 words are keyed with 5 6-sided dice, rejecting the last 2776 rolls
+	wl, err := wordlist.FromSlice(sliceOf(5000))
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal(5000, wl.Length())
+
+	index := 0
+	for rollValue, word := range wl.Words() {
+		assert.Equal(wordlist.RollValueForIndex(index, 5, 6), rollValue)
+		assert.Equal(wl.WordAt(index), word)
+		index++
+	}
+
+	assert.Equal(5000, index)
+
+	tests := []struct {
+		Name     string
+		DiceRoll int
+		Value    string
+		Error    error
+	}{
+		{
+			Name:     "will return the first word",
+			DiceRoll: 11111,
+			Value:    "word00000",
+		}, {
+			Name:     "will return the last word",
+			DiceRoll: wordlist.RollValueForIndex(4999, 5, 6),
+			Value:    "word04999",
+		}, {
+			Name:     "will error for a roll value past the last word",
+			DiceRoll: wordlist.RollValueForIndex(5000, 5, 6),
+			Error:    wordlist.ErrRollRejected,
+		}, {
+			Name:     "will error for a roll value outside of the dice",
+			DiceRoll: 11117,
+			Error:    wordlist.ErrRollOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		word, err := wl.FetchWordE(test.DiceRoll)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, word, test.Name)
+		assert.Equal(test.Value, wl.FetchWord(test.DiceRoll), test.Name)
+	}
+
+	assert.Equal(5000, wl.Len())
+	assert.Equal("", wl.WordAt(5000))
+	assert.Equal("", wl.WordAt(-1))
+	assert.InDelta(12.2877, wl.Entropy(), 0.0001)
+	assert.True(wordlist.Validate(wl).Valid())
+}
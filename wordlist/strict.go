@@ -0,0 +1,106 @@
+package wordlist
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Checked defines a wordlist wrapped by `Strict`, which has been verified to
+// hold a word for every roll value of its dice and reports an error for any
+// roll value without a word rather than returning an empty word.
+type Checked struct {
+	// wl represents the wrapped wordlist.
+	wl Source
+
+	// length represents the number of roll values of the dice with a word,
+	// counted when the wordlist was verified.
+	length int
+}
+
+// Strict returns a Checked.
+// Implements the logic required to verify that the given wordlist holds a
+// word for every roll value of its dice, other than the rejected roll values
+// of a Slice, returning an error wrapping ErrIncomplete when any are missing
+// or empty.  The words of the wordlist are expected not to change afterwards.
+func Strict(wl Source) (*Checked, error) {
+	if wl == nil {
+		return nil, fmt.Errorf("%w: nil wordlist given", ErrInvalidWordlist)
+	}
+
+	if err := ValidateDice(wl.Rolls(), int(wl.SidesOfDice().Int64())); err != nil {
+		return nil, err
+	}
+
+	report := Validate(wl)
+	if len(report.Missing) > 0 || len(report.Empty) > 0 {
+		return nil, fmt.Errorf("%w: %d missing and %d empty words", ErrIncomplete, len(report.Missing), len(report.Empty))
+	}
+
+	return &Checked{wl: wl, length: report.Words}, nil
+}
+
+// FetchWord returns a string.
+// It implements the logic for the Wordlist interface which pulls the correct
+// word from the wrapped wordlist.
+func (wl *Checked) FetchWord(diceRoll int) string {
+	return wl.wl.FetchWord(diceRoll)
+}
+
+// FetchWordE returns a string.
+// It implements the logic for the CheckedWordlist interface which pulls the
+// correct word from the wrapped wordlist, guaranteeing an error for any roll
+// value without a word, such as ErrRollOutOfRange, ErrRollRejected or
+// ErrWordNotFound.
+func (wl *Checked) FetchWordE(diceRoll int) (string, error) {
+	if checked, ok := wl.wl.(interface{ FetchWordE(int) (string, error) }); ok {
+		word, err := checked.FetchWordE(diceRoll)
+		if err == nil && word == "" {
+			err = fmt.Errorf("%w: %d", ErrWordNotFound, diceRoll)
+		}
+
+		return word, err
+	}
+
+	if _, valid := IndexForRollValue(diceRoll, wl.Rolls(), int(wl.SidesOfDice().Int64())); !valid {
+		return "", fmt.Errorf("%w: %d", ErrRollOutOfRange, diceRoll)
+	}
+
+	word := wl.wl.FetchWord(diceRoll)
+	if word == "" {
+		return "", fmt.Errorf("%w: %d", ErrWordNotFound, diceRoll)
+	}
+
+	return word, nil
+}
+
+// Name returns a string.
+// It implements the logic for the NamedWordlist interface which gives the
+// identifier of the wrapped wordlist, which is empty when it is unnamed.
+func (wl *Checked) Name() string {
+	if named, ok := wl.wl.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+
+	return ""
+}
+
+// Rolls returns an int.
+// It implements the logic for the Wordlist interface which gives the number
+// of dice of the wrapped wordlist.
+func (wl *Checked) Rolls() int {
+	return wl.wl.Rolls()
+}
+
+// SidesOfDice returns a big.Int.
+// It implements the logic for the Wordlist interface which gives the sides of
+// the dice of the wrapped wordlist.
+func (wl *Checked) SidesOfDice() *big.Int {
+	return wl.wl.SidesOfDice()
+}
+
+// Length returns an int.
+// It implements the logic for the Sizer interface which gives the number of
+// words of the wrapped wordlist.
+func (wl *Checked) Length() int {
+	return wl.length
+}
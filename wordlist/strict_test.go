@@ -0,0 +1,125 @@
+package wordlist_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// plainWordlist defines a wordlist without the FetchWordE method, keyed with
+// a single 6-sided die.
+type plainWordlist map[int]string
+
+func (wl plainWordlist) FetchWord(diceRoll int) string { return wl[diceRoll] }
+func (wl plainWordlist) Rolls() int                    { return 1 }
+func (wl plainWordlist) SidesOfDice() *big.Int         { return big.NewInt(6) }
+
+func TestStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	words, err := wordlist.FromSlice(sliceOf(5000))
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Source
+		Length   int
+		Error    error
+	}{
+		{
+			Name:     "will accept a complete map",
+			Wordlist: wordlist.EFFLong,
+			Length:   7776,
+		}, {
+			Name:     "will accept a slice with rejected roll values",
+			Wordlist: words,
+			Length:   5000,
+		}, {
+			Name:     "will error for a map missing a word",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{1: "a", 2: "b", 3: "c", 4: "d", 5: "e"}),
+			Error:    wordlist.ErrIncomplete,
+		}, {
+			Name:     "will error for a map with an empty word",
+			Wordlist: wordlist.NewMap(1, 2, map[int]string{1: "a", 2: ""}),
+			Error:    wordlist.ErrIncomplete,
+		}, {
+			Name:     "will error for a wordlist without any dice",
+			Wordlist: wordlist.NewMap(0, 6, map[int]string{}),
+			Error:    wordlist.ErrInvalidDice,
+		}, {
+			Name:  "will error for a nil wordlist",
+			Error: wordlist.ErrInvalidWordlist,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.Strict(test.Wordlist)
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error == nil && assert.NotNil(wl, test.Name) {
+			assert.Equal(test.Length, wl.Length(), test.Name)
+		}
+	}
+}
+
+func TestCheckedFetchWordE(t *testing.T) {
+	assert := assert.New(t)
+
+	words, err := wordlist.FromSlice(sliceOf(5000))
+	if !assert.NoError(err) {
+		return
+	}
+
+	slice, err := wordlist.Strict(words)
+	if !assert.NoError(err) {
+		return
+	}
+
+	plain, err := wordlist.Strict(plainWordlist{1: "a", 2: "b", 3: "c", 4: "d", 5: "e", 6: "f"})
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name     string
+		Wordlist *wordlist.Checked
+		DiceRoll int
+		Value    string
+		Error    error
+	}{
+		{
+			Name:     "will return the word of a slice",
+			Wordlist: slice,
+			DiceRoll: 11111,
+			Value:    "word00000",
+		}, {
+			Name:     "will error for a rejected roll value of a slice",
+			Wordlist: slice,
+			DiceRoll: wordlist.RollValueForIndex(5000, 5, 6),
+			Error:    wordlist.ErrRollRejected,
+		}, {
+			Name:     "will return the word of a wordlist without FetchWordE",
+			Wordlist: plain,
+			DiceRoll: 6,
+			Value:    "f",
+		}, {
+			Name:     "will error for a roll value outside of the dice of a wordlist without FetchWordE",
+			Wordlist: plain,
+			DiceRoll: 7,
+			Error:    wordlist.ErrRollOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		word, err := test.Wordlist.FetchWordE(test.DiceRoll)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, word, test.Name)
+	}
+
+	assert.Equal("", plain.Name())
+	assert.Equal(5, slice.Rolls())
+	assert.Equal(int64(6), slice.SidesOfDice().Int64())
+}
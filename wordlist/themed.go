@@ -0,0 +1,145 @@
+package wordlist
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Metadata defines the provenance of a wordlist, such as a themed list
+// published by a community.
+type Metadata struct {
+	// Title represents the human readable title of the wordlist.
+	Title string
+
+	// Author represents the person or group that created the wordlist.
+	Author string
+
+	// License represents the license the wordlist is published under, such as
+	// "CC BY 3.0 US".
+	License string
+
+	// SourceURL represents the location the wordlist was published at.
+	SourceURL string
+}
+
+// Describable defines the optional method a wordlist can implement in order to
+// give its provenance, which applications can display alongside the
+// passphrases generated from it.
+type Describable interface {
+	// Metadata describes the provenance of the wordlist
+	Metadata() Metadata
+}
+
+// Themed defines a wordlist that carries the metadata describing where it
+// came from, such as the fandom wordlists published by the EFF.
+type Themed struct {
+	*Map
+
+	// metadata represents the provenance of the wordlist.
+	metadata Metadata
+}
+
+// Metadata returns a Metadata.
+// It implements the logic for the Describable interface which gives the
+// provenance read from the header of the wordlist.
+func (wl *Themed) Metadata() Metadata {
+	return wl.metadata
+}
+
+// ParseThemed returns a Themed.
+// Implements the logic required to read a wordlist in the format described by
+// `Parse`, preceded by a header of comments giving its metadata, e.g.:
+//
+//	# Title: Star Wars
+//	# Author: Electronic Frontier Foundation
+//	# License: CC BY 3.0 US
+//	# Source: https://www.eff.org/deeplinks/2018/08/dragon-con-diceware
+//	1111	aayla
+//
+// The keys are case insensitive and any other comments are ignored.  The
+// wordlist is named after its title.
+func ParseThemed(r io.Reader) (*Themed, error) {
+	return parseThemed("", r)
+}
+
+// LoadThemedFile returns a Themed.
+// Implements the logic required to open and parse the themed wordlist found at
+// the given path, as described by `ParseThemed`.  The wordlist is named after
+// the file, without its extension.
+func LoadThemedFile(path string) (*Themed, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening wordlist: %w", err)
+	}
+	defer file.Close()
+
+	fileName := filepath.Base(path)
+
+	return parseThemed(strings.TrimSuffix(fileName, filepath.Ext(fileName)), file)
+}
+
+// parseThemed returns a Themed.
+// Implements the logic of ParseThemed, identifying the Map by the given name,
+// or by the title of the wordlist when the name is empty.
+func parseThemed(name string, r io.Reader) (*Themed, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading wordlist: %w", err)
+	}
+
+	metadata := parseMetadata(contents)
+	if name == "" {
+		name = metadata.Title
+	}
+
+	wl, err := ParseNamed(name, bytes.NewReader(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Themed{Map: wl, metadata: metadata}, nil
+}
+
+// parseMetadata returns a Metadata.
+// Implements the logic to read the "# Key: value" comments found before the
+// first word of the wordlist.
+func parseMetadata(contents []byte) Metadata {
+	var metadata Metadata
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		comment, found := strings.CutPrefix(text, "#")
+		if !found {
+			break
+		}
+
+		key, value, found := strings.Cut(comment, ":")
+		if !found {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "title":
+			metadata.Title = value
+		case "author":
+			metadata.Author = value
+		case "license":
+			metadata.License = value
+		case "source", "url":
+			metadata.SourceURL = value
+		}
+	}
+
+	return metadata
+}
@@ -0,0 +1,79 @@
+package wordlist_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseThemed(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Input    string
+		Metadata wordlist.Metadata
+		Error    error
+	}{
+		{
+			Name: "will read the metadata from the header",
+			Input: "# Title: Star Wars\n# author: EFF\n\n# LICENSE: CC BY 3.0 US\n" +
+				"# Source: https://example.com/star-wars.txt\n1\tyoda\n2\tleia\n",
+			Metadata: wordlist.Metadata{
+				Title:     "Star Wars",
+				Author:    "EFF",
+				License:   "CC BY 3.0 US",
+				SourceURL: "https://example.com/star-wars.txt",
+			},
+		}, {
+			Name:     "will ignore other comments and those after the first word",
+			Input:    "# a list of characters\n# url: https://example.com\n1\tyoda\n# Title: Ignored\n2\tleia\n",
+			Metadata: wordlist.Metadata{SourceURL: "https://example.com"},
+		}, {
+			Name:  "will return blank metadata without a header",
+			Input: "1\tyoda\n2\tleia\n",
+		}, {
+			Name:  "will error for an invalid wordlist",
+			Input: "# Title: Star Wars\n1\tyoda\n3\tluke\n",
+			Error: wordlist.ErrIncomplete,
+		},
+	}
+
+	for _, test := range tests {
+		wl, err := wordlist.ParseThemed(strings.NewReader(test.Input))
+		assert.ErrorIs(err, test.Error, test.Name)
+		if test.Error != nil {
+			continue
+		}
+
+		var describable wordlist.Describable = wl
+		assert.Equal(test.Metadata, describable.Metadata(), test.Name)
+		assert.Equal(test.Metadata.Title, wl.Name(), test.Name)
+		assert.Equal("leia", wl.FetchWord(2), test.Name)
+	}
+}
+
+func TestLoadThemedFile(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "star-wars.txt")
+	assert.NoError(os.WriteFile(path, []byte("# Title: Star Wars\n1\tyoda\n2\tleia\n"), 0o600))
+
+	wl, err := wordlist.LoadThemedFile(path)
+	if assert.NoError(err) {
+		assert.Equal("star-wars", wl.Name())
+		assert.Equal("Star Wars", wl.Metadata().Title)
+		assert.Equal("yoda", wl.FetchWord(1))
+	}
+
+	_, err = wordlist.LoadThemedFile(filepath.Join(t.TempDir(), "missing.txt"))
+	var pathErr *fs.PathError
+	assert.True(errors.As(err, &pathErr))
+	assert.ErrorIs(err, fs.ErrNotExist)
+}
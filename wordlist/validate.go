@@ -0,0 +1,152 @@
+package wordlist
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidWordlist represents the error given by `Report.Err` when the
+// validation of a wordlist found problems
+var ErrInvalidWordlist = errors.New("invalid wordlist")
+
+// Source defines the methods of a wordlist read by the functions analyzing
+// wordlists, which match the `diceware.Wordlist` interface so that any
+// diceware wordlist can be analyzed.
+type Source interface {
+	// FetchWord describes the logic to fetch a word from the word list with the
+	// given dice roll value
+	FetchWord(int) string
+
+	// Rolls describes the number of dice that should be rolled to retrieve an
+	// appropriate word from the wordlist
+	Rolls() int
+
+	// SidesOfDice describes the maximum number on the dice to be rolled
+	SidesOfDice() *big.Int
+}
+
+// Report defines the problems found while validating a wordlist.
+type Report struct {
+	// Words represents the number of roll values of the dice with a word.
+	Words int
+
+	// Missing represents the roll values of the dice without a word.
+	Missing []int
+
+	// Empty represents the roll values explicitly given an empty word, which
+	// are only distinguished from Missing for a Map.
+	Empty []int
+
+	// OutOfRange represents the roll values given a word that are unable to be
+	// rolled with the dice, which are only found for a Map.
+	OutOfRange []int
+
+	// Duplicates represents the roll values of each word found more than once.
+	Duplicates map[string][]int
+
+	// Whitespace represents the roll values of the words containing
+	// whitespace, which are unable to be told apart from separators.
+	Whitespace []int
+}
+
+// Valid returns a bool.
+// Implements the logic to check whether the validation found no problems.
+func (r Report) Valid() bool {
+	return len(r.Missing) == 0 && len(r.Empty) == 0 && len(r.OutOfRange) == 0 &&
+		len(r.Duplicates) == 0 && len(r.Whitespace) == 0
+}
+
+// Err returns an error.
+// Implements the logic to summarize the problems found by the validation into
+// an error wrapping ErrInvalidWordlist, which is nil when there were none.
+func (r Report) Err() error {
+	if r.Valid() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d missing, %d empty, %d out of range, %d duplicated and %d whitespace words",
+		ErrInvalidWordlist, len(r.Missing), len(r.Empty), len(r.OutOfRange), len(r.Duplicates), len(r.Whitespace))
+}
+
+// Validate returns a Report.
+// Implements the logic required to check every roll value of the dice of the
+// wordlist, other than the rejected roll values of a Slice, reporting the
+// roll values without a word, the words found more than once and the words
+// containing whitespace.  A Map is additionally checked for empty words and
+// words given to roll values the dice are unable to roll.
+func Validate(wl Source) Report {
+	report := Report{Duplicates: map[string][]int{}}
+	sides := int(wl.SidesOfDice().Int64())
+	rolls := wl.Rolls()
+
+	seen := map[string][]int{}
+	for index := 0; index < rollCount(wl); index++ {
+		rollValue := RollValueForIndex(index, rolls, sides)
+		word := wl.FetchWord(rollValue)
+		if word == "" {
+			report.Missing = append(report.Missing, rollValue)
+			continue
+		}
+
+		report.Words++
+		seen[word] = append(seen[word], rollValue)
+		if strings.ContainsFunc(word, unicode.IsSpace) {
+			report.Whitespace = append(report.Whitespace, rollValue)
+		}
+	}
+
+	for word, rollValues := range seen {
+		if len(rollValues) > 1 {
+			report.Duplicates[word] = rollValues
+		}
+	}
+
+	if m, ok := wl.(*Map); ok {
+		report.validateMap(m)
+	}
+
+	return report
+}
+
+// rollCount returns an int.
+// Implements the logic to count the roll values of the dice of the wordlist
+// that are able to have a word, which is the length of wordlists implementing
+// a Len method, such as a Slice with its rejected roll values, and otherwise
+// every roll value of the dice.
+func rollCount(wl Source) int {
+	if sized, ok := wl.(interface{ Len() int }); ok {
+		return sized.Len()
+	}
+
+	return combinations(wl.Rolls(), int(wl.SidesOfDice().Int64()))
+}
+
+// validateMap implements the logic to separate the empty words of the Map
+// from the missing words, and to find the words given to roll values the dice
+// are unable to roll.
+func (r *Report) validateMap(wl *Map) {
+	wl.loadOnce()
+
+	missing := r.Missing[:0]
+	for _, rollValue := range r.Missing {
+		if _, found := wl.lookup(rollValue); found {
+			r.Empty = append(r.Empty, rollValue)
+		} else {
+			missing = append(missing, rollValue)
+		}
+	}
+
+	r.Missing = missing
+
+	for rollValue := range wl.words {
+		if _, valid := IndexForRollValue(rollValue, wl.rolls, int(wl.sidesOfDice.Int64())); !valid {
+			r.OutOfRange = append(r.OutOfRange, rollValue)
+		}
+	}
+
+	sort.Ints(r.OutOfRange)
+}
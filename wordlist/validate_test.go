@@ -0,0 +1,71 @@
+package wordlist_test
+
+import (
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name       string
+		Wordlist   wordlist.Source
+		Words      int
+		Missing    []int
+		Empty      []int
+		OutOfRange []int
+		Duplicates map[string][]int
+		Whitespace []int
+	}{
+		{
+			Name:     "will validate the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Words:    7776,
+		}, {
+			Name: "will report every problem of a custom wordlist",
+			Wordlist: wordlist.NewMap(2, 2, map[int]string{
+				11: "acid",
+				12: "",
+				21: "acid",
+				17: "cat",
+				31: "horse",
+			}),
+			Words:      2,
+			Missing:    []int{22},
+			Empty:      []int{12},
+			OutOfRange: []int{17, 31},
+			Duplicates: map[string][]int{"acid": {11, 21}},
+		}, {
+			Name:       "will report words containing whitespace",
+			Wordlist:   wordlist.NewMap(1, 2, map[int]string{1: "acid rain", 2: "cat\t"}),
+			Words:      2,
+			Whitespace: []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		report := wordlist.Validate(test.Wordlist)
+		assert.Equal(test.Words, report.Words, test.Name)
+		assert.ElementsMatch(test.Missing, report.Missing, test.Name)
+		assert.ElementsMatch(test.Empty, report.Empty, test.Name)
+		assert.Equal(test.OutOfRange, report.OutOfRange, test.Name)
+		assert.ElementsMatch(test.Whitespace, report.Whitespace, test.Name)
+		if test.Duplicates == nil {
+			assert.Empty(report.Duplicates, test.Name)
+		} else {
+			assert.Equal(test.Duplicates, report.Duplicates, test.Name)
+		}
+
+		valid := test.Missing == nil && test.Empty == nil && test.OutOfRange == nil &&
+			test.Duplicates == nil && test.Whitespace == nil
+		assert.Equal(valid, report.Valid(), test.Name)
+		if valid {
+			assert.NoError(report.Err(), test.Name)
+		} else {
+			assert.ErrorIs(report.Err(), wordlist.ErrInvalidWordlist, test.Name)
+		}
+	}
+}
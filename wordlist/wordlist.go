@@ -1,10 +1,54 @@
 package wordlist
 
-import "math/big"
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math"
+	"math/big"
+	"sync"
+)
+
+var (
+	// ErrRollOutOfRange represents the error given when a roll value is unable
+	// to be produced by the dice of the wordlist
+	ErrRollOutOfRange = errors.New("roll value out of range of the dice")
+	// ErrWordNotFound represents the error given when a roll value is able to
+	// be produced by the dice, but the wordlist has no word for it
+	ErrWordNotFound = errors.New("no word found for roll value")
+	// ErrRollRejected represents the error given when a roll value is past the
+	// last word of a Slice, so the dice must be rolled again
+	ErrRollRejected = errors.New("roll value rejected, roll the dice again")
+)
+
+// Sizer defines the optional method a wordlist can implement in order to give
+// its number of words without each roll value of its dice being fetched,
+// which is implemented by Map and Slice.
+type Sizer interface {
+	// Length describes the number of roll values of the dice with a word
+	Length() int
+}
+
+// Enumerable defines the optional method a wordlist can implement in order to
+// give each of its words without every roll value of its dice being fetched,
+// which is implemented by Map and Slice.
+type Enumerable interface {
+	// Words describes the roll value and word of each roll value of the dice
+	// with a word, in ascending order of the roll values
+	Words() iter.Seq2[int, string]
+}
 
 // Map defines the implementation of the Wordlist interface having
-// a `map[int]string` be the main way of storing the wordlist in go.
+// a `map[int]string` be the main way of storing the wordlist in go.  The
+// compressed embedded wordlists are instead stored as a `[]string` in
+// ascending order of their roll values once decompressed.  A Map is immutable
+// once created, including the built-in wordlists, and is safe for concurrent
+// use.
 type Map struct {
+	// name represents the identifier of the wordlist.
+	name string
+
 	// rolls represents the number of dice rolls are needed to create the number
 	// passed into the wordslist map to fetch a word.
 	rolls int
@@ -13,27 +57,165 @@ type Map struct {
 	// rolled.
 	sidesOfDice *big.Int
 
-	// words represents the wordlist represented in a map.
+	// words represents the wordlist represented in a map, which is only
+	// complete once loadOnce has been called.
 	words map[int]string
+
+	// list represents the words of a compressed embedded wordlist in ascending
+	// order of their roll values, which is used in place of words.
+	list []string
+
+	// load represents the function building the words of an embedded wordlist
+	// on first use, so that unused wordlists do not cost any memory.
+	load func() map[int]string
+
+	// decode represents the function decompressing the list of a compressed
+	// embedded wordlist on first use.
+	decode func() []string
+
+	// length represents the number of roll values of the dice with a word,
+	// which is counted once the words are loaded.
+	length int
+
+	// once represents the guard ensuring the words are loaded a single time.
+	once sync.Once
+
+	// cache represents the WordIndex of the wordlist, as its words never
+	// change.
+	cache *indexCache
 }
 
-// NewMap returns an initialized Map object
+// NewMap returns an initialized Map object.  The words are copied, so later
+// changes to the given map do not affect the Map.
 func NewMap(rolls, sidesOfDice int, words map[int]string) *Map {
+	return NewNamedMap("", rolls, sidesOfDice, words)
+}
+
+// NewNamedMap returns an initialized Map object identified by the given name.
+// The words are copied, so later changes to the given map do not affect the
+// Map.
+func NewNamedMap(name string, rolls, sidesOfDice int, words map[int]string) *Map {
+	return newMap(name, rolls, sidesOfDice, maps.Clone(words))
+}
+
+// newMap returns an initialized Map object identified by the given name,
+// taking ownership of the given words.
+func newMap(name string, rolls, sidesOfDice int, words map[int]string) *Map {
 	return &Map{
+		name:        name,
 		rolls:       rolls,
 		sidesOfDice: big.NewInt(int64(sidesOfDice)),
 		words:       words,
+		cache:       &indexCache{},
+	}
+}
+
+// newLazyMap returns an initialized Map object identified by the given name,
+// whose words are built by load the first time they are needed.
+func newLazyMap(name string, rolls, sidesOfDice int, load func() map[int]string) *Map {
+	return &Map{
+		name:        name,
+		rolls:       rolls,
+		sidesOfDice: big.NewInt(int64(sidesOfDice)),
+		load:        load,
+		cache:       &indexCache{},
+	}
+}
+
+// newEmbeddedMap returns an initialized Map object identified by the given
+// name, whose words are decompressed from the given data, as read by
+// `decodeWords`, the first time they are needed.
+func newEmbeddedMap(name string, rolls, sidesOfDice int, data []byte) *Map {
+	return &Map{
+		name:        name,
+		rolls:       rolls,
+		sidesOfDice: big.NewInt(int64(sidesOfDice)),
+		decode:      func() []string { return decodeWords(data) },
+		cache:       &indexCache{},
+	}
+}
+
+// loadOnce implements the logic to build the words of the wordlist on first
+// use and count them, safely when called by multiple goroutines at once.
+func (wl *Map) loadOnce() {
+	wl.once.Do(func() {
+		if wl.load != nil {
+			wl.words = wl.load()
+			wl.load = nil
+		}
+
+		if wl.decode != nil {
+			wl.list = wl.decode()
+			wl.decode = nil
+		}
+
+		for _, word := range wl.list {
+			if word != "" {
+				wl.length++
+			}
+		}
+
+		sides := int(wl.sidesOfDice.Int64())
+		for rollValue, word := range wl.words {
+			if _, valid := IndexForRollValue(rollValue, wl.rolls, sides); valid && word != "" {
+				wl.length++
+			}
+		}
+	})
+}
+
+// lookup returns a string and a bool.
+// Implements the logic to find the word of the roll value, reporting whether
+// the roll value was given a word, even an empty one.
+func (wl *Map) lookup(diceRoll int) (string, bool) {
+	wl.loadOnce()
+
+	if wl.list != nil {
+		index, valid := IndexForRollValue(diceRoll, wl.rolls, int(wl.sidesOfDice.Int64()))
+		if !valid || index >= len(wl.list) {
+			return "", false
+		}
+
+		return wl.list[index], true
 	}
+
+	word, found := wl.words[diceRoll]
+
+	return word, found
 }
 
 // FetchWord returns a string.
 // It implements the logic for the Wordlist interface which pulls the correct
 // word from the internal wordlist.
 func (wl *Map) FetchWord(diceRoll int) string {
-	word := wl.words[diceRoll]
+	word, _ := wl.lookup(diceRoll)
 	return word
 }
 
+// FetchWordE returns a string.
+// It implements the logic for the CheckedWordlist interface which pulls the
+// correct word from the internal wordlist, reporting whether a missing word
+// was due to the roll value being out of range or missing from the wordlist.
+func (wl *Map) FetchWordE(diceRoll int) (string, error) {
+	if _, valid := IndexForRollValue(diceRoll, wl.rolls, int(wl.sidesOfDice.Int64())); !valid {
+		return "", fmt.Errorf("%w: %d", ErrRollOutOfRange, diceRoll)
+	}
+
+	word, found := wl.lookup(diceRoll)
+	if !found || word == "" {
+		return "", fmt.Errorf("%w: %d", ErrWordNotFound, diceRoll)
+	}
+
+	return word, nil
+}
+
+// Name returns a string.
+// It implements the logic for the NamedWordlist interface which gives the
+// identifier of the wordlist.
+func (wl *Map) Name() string {
+	return wl.name
+}
+
 // Rolls returns an int.
 // It implements the logic for the Wordlist interface which gives the number of
 // dice rolls that should occur in order to create the correct number to
@@ -48,3 +230,61 @@ func (wl *Map) Rolls() int {
 func (wl *Map) SidesOfDice() *big.Int {
 	return wl.sidesOfDice
 }
+
+// Len returns an int.
+// It implements the logic for the IndexedWordlist interface which gives the
+// number of roll values that can be rolled with the dice of the wordlist.
+func (wl *Map) Len() int {
+	return combinations(wl.rolls, int(wl.sidesOfDice.Int64()))
+}
+
+// Length returns an int.
+// It implements the logic for the Sizer interface which gives the number of
+// roll values of the dice with a word, which is less than Len when any words
+// are missing.
+func (wl *Map) Length() int {
+	wl.loadOnce()
+	return wl.length
+}
+
+// WordAt returns a string.
+// It implements the logic for the IndexedWordlist interface which pulls the
+// word of the roll value found at the given index, where the roll values are
+// in ascending order.
+func (wl *Map) WordAt(index int) string {
+	if index < 0 || index >= wl.Len() {
+		return ""
+	}
+
+	return wl.FetchWord(RollValueForIndex(index, wl.rolls, int(wl.sidesOfDice.Int64())))
+}
+
+// Words returns an iter.Seq2 of ints and strings.
+// It implements the logic for the Enumerable interface which yields the roll
+// value and word of each roll value of the dice with a word, in ascending
+// order of the roll values.  Words given to roll values the dice are unable
+// to roll are left out.
+func (wl *Map) Words() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		sides := int(wl.sidesOfDice.Int64())
+		for index := 0; index < wl.Len(); index++ {
+			rollValue := RollValueForIndex(index, wl.rolls, sides)
+			if word := wl.FetchWord(rollValue); word != "" && !yield(rollValue, word) {
+				return
+			}
+		}
+	}
+}
+
+// Entropy returns a float64.
+// Implements the logic to calculate the entropy in bits provided by each word
+// rolled from the wordlist, assuming every roll value has a distinct word.
+func (wl *Map) Entropy() float64 {
+	if wl.rolls < 1 || wl.sidesOfDice.Sign() <= 0 {
+		return 0
+	}
+
+	sides, _ := wl.sidesOfDice.Float64()
+
+	return float64(wl.rolls) * math.Log2(sides)
+}
@@ -1,6 +1,7 @@
 package wordlist_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/everlastingbeta/diceware/wordlist"
@@ -33,3 +34,221 @@ func TestMapFetchWord(t *testing.T) {
 		assert.Equal(test.Value, fetchedValue, test.Name)
 	}
 }
+
+func TestMapFetchWordE(t *testing.T) {
+	assert := assert.New(t)
+
+	wordlistMap := wordlist.NewMap(2, 6, map[int]string{11: "test"})
+
+	tests := []struct {
+		Name     string
+		DiceRoll int
+		Value    string
+		Error    error
+	}{
+		{
+			Name:     "will return a value from the map",
+			DiceRoll: 11,
+			Value:    "test",
+		}, {
+			Name:     "will error for a roll value missing from the map",
+			DiceRoll: 12,
+			Error:    wordlist.ErrWordNotFound,
+		}, {
+			Name:     "will error for a roll value outside of the dice",
+			DiceRoll: 17,
+			Error:    wordlist.ErrRollOutOfRange,
+		}, {
+			Name:     "will error for a roll value with too few dice",
+			DiceRoll: 1,
+			Error:    wordlist.ErrRollOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		fetchedValue, err := wordlistMap.FetchWordE(test.DiceRoll)
+		assert.ErrorIs(err, test.Error, test.Name)
+		assert.Equal(test.Value, fetchedValue, test.Name)
+	}
+}
+
+func TestNewMapCopiesWords(t *testing.T) {
+	assert := assert.New(t)
+
+	words := map[int]string{1: "one", 2: "two"}
+	wl := wordlist.NewNamedMap("copied", 1, 2, words)
+
+	words[1] = "changed"
+	delete(words, 2)
+
+	assert.Equal("one", wl.FetchWord(1))
+	assert.Equal("two", wl.FetchWord(2))
+	assert.Equal(2, wl.Length())
+}
+
+func TestMapName(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist *wordlist.Map
+		Value    string
+	}{
+		{
+			Name:     "will return a blank name for an unnamed map",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{}),
+			Value:    "",
+		}, {
+			Name:     "will return the name of a named map",
+			Wordlist: wordlist.NewNamedMap("custom", 1, 6, map[int]string{}),
+			Value:    "custom",
+		}, {
+			Name:     "will return the name of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Value:    "eff-long",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, test.Wordlist.Name(), test.Name)
+	}
+}
+
+func TestMapWordAt(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(7776, wordlist.EFFLong.Len())
+
+	tests := []struct {
+		Name  string
+		Index int
+		Value string
+	}{
+		{
+			Name:  "will return the first word",
+			Index: 0,
+			Value: "abacus",
+		}, {
+			Name:  "will return the last word",
+			Index: 7775,
+			Value: "zoom",
+		}, {
+			Name:  "will return a blank value for a negative index",
+			Index: -1,
+			Value: "",
+		}, {
+			Name:  "will return a blank value for an index past the end",
+			Index: 7776,
+			Value: "",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, wordlist.EFFLong.WordAt(test.Index), test.Name)
+	}
+}
+
+func TestMapLength(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Sizer
+		Value    int
+	}{
+		{
+			Name:     "will count the words of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Value:    7776,
+		}, {
+			Name:     "will exclude missing, blank and invalid roll values",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{1: "one", 2: "", 7: "seven", 9: "nine"}),
+			Value:    1,
+		}, {
+			Name:     "will return zero without any words",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{}),
+			Value:    0,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.Value, test.Wordlist.Length(), test.Name)
+	}
+}
+
+func TestMapWords(t *testing.T) {
+	assert := assert.New(t)
+
+	wl := wordlist.NewMap(1, 6, map[int]string{4: "four", 1: "one", 2: "", 7: "seven", 6: "six"})
+
+	var rollValues []int
+	var words []string
+	for rollValue, word := range wl.Words() {
+		rollValues = append(rollValues, rollValue)
+		words = append(words, word)
+	}
+
+	assert.Equal([]int{1, 4, 6}, rollValues)
+	assert.Equal([]string{"one", "four", "six"}, words)
+
+	count := 0
+	for rollValue, word := range wordlist.EFFLong.Words() {
+		assert.Equal(wordlist.EFFLong.FetchWord(rollValue), word)
+		if count++; count == 10 {
+			break
+		}
+	}
+
+	assert.Equal(10, count)
+}
+
+func TestMapEntropy(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		Name     string
+		Wordlist *wordlist.Map
+		Value    float64
+	}{
+		{
+			Name:     "will calculate the entropy of the EFF long wordlist",
+			Wordlist: wordlist.EFFLong,
+			Value:    12.9248,
+		}, {
+			Name:     "will return zero without any dice",
+			Wordlist: wordlist.NewMap(0, 6, map[int]string{}),
+			Value:    0,
+		},
+	}
+
+	for _, test := range tests {
+		assert.InDelta(test.Value, test.Wordlist.Entropy(), 0.0001, test.Name)
+	}
+}
+
+func TestEmbeddedWordlistsConcurrentUse(t *testing.T) {
+	assert := assert.New(t)
+
+	// the embedded wordlists are built on first use, which must be safe from
+	// multiple goroutines at once
+	wordlists := []wordlist.Source{wordlist.Digits, wordlist.ExtraEntropy}
+	for _, name := range wordlist.Names() {
+		wl, err := wordlist.Get(name)
+		if assert.NoError(err, name) {
+			wordlists = append(wordlists, wl)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		for _, wl := range wordlists {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NotEmpty(wl.FetchWord(wordlist.RollValueForIndex(0, wl.Rolls(), int(wl.SidesOfDice().Int64()))))
+			}()
+		}
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,58 @@
+package wordlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Write returns an error.
+// Implements the logic required to write the wordlist in the format of the
+// original diceware lists, the roll value and word of each roll value of the
+// dice separated by a tab on its own line, e.g. "11111	abacus", in ascending
+// order of the roll values.  The faces of dice with more than 9 sides are zero
+// padded as written by `FormatRollValue`.  Roll values without a word, such as
+// the rejected roll values of a Slice, are left out.  The written wordlist is
+// read back by `Parse`, or by `ParseWithOptions` for dice with more than 9
+// sides.
+func Write(w io.Writer, wl Source) error {
+	rolls := wl.Rolls()
+	sides := int(wl.SidesOfDice().Int64())
+
+	buffered := bufio.NewWriter(w)
+	for rollValue, word := range allWords(wl) {
+		if _, err := fmt.Fprintf(buffered, "%s\t%s\n", FormatRollValue(rollValue, rolls, sides), word); err != nil {
+			return fmt.Errorf("writing wordlist: %w", err)
+		}
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("writing wordlist: %w", err)
+	}
+
+	return nil
+}
+
+// allWords returns an iter.Seq2 of ints and strings.
+// Implements the logic to yield the roll value and word of each roll value of
+// the dice of the wordlist with a word, in ascending order of the roll values,
+// through the Words method of wordlists implementing Enumerable and otherwise
+// by fetching the word of every roll value.
+func allWords(wl Source) iter.Seq2[int, string] {
+	if enumerable, ok := wl.(Enumerable); ok {
+		return enumerable.Words()
+	}
+
+	return func(yield func(int, string) bool) {
+		rolls := wl.Rolls()
+		sides := int(wl.SidesOfDice().Int64())
+
+		for index := 0; index < rollCount(wl); index++ {
+			rollValue := RollValueForIndex(index, rolls, sides)
+			if word := wl.FetchWord(rollValue); word != "" && !yield(rollValue, word) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package wordlist_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/everlastingbeta/diceware/wordlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingWriter is an io.Writer failing every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	d20, err := wordlist.NewDiceMap("d20", 1, wordlist.D20, map[int]string{1: "acid", 20: "zoom"})
+	if !assert.NoError(err) {
+		return
+	}
+
+	tests := []struct {
+		Name     string
+		Wordlist wordlist.Source
+		Value    string
+	}{
+		{
+			Name:     "will write each roll value and word",
+			Wordlist: wordlist.NewMap(2, 2, map[int]string{11: "acid", 12: "cat", 21: "horse", 22: "zoom"}),
+			Value:    "11\tacid\n12\tcat\n21\thorse\n22\tzoom\n",
+		}, {
+			Name:     "will zero pad the faces of dice with more than 9 sides",
+			Wordlist: d20,
+			Value:    "01\tacid\n20\tzoom\n",
+		}, {
+			Name:     "will leave out the roll values without a word",
+			Wordlist: wordlist.NewMap(1, 6, map[int]string{2: "cat"}),
+			Value:    "2\tcat\n",
+		},
+	}
+
+	for _, test := range tests {
+		var output bytes.Buffer
+		assert.NoError(wordlist.Write(&output, test.Wordlist), test.Name)
+		assert.Equal(test.Value, output.String(), test.Name)
+	}
+
+	assert.Error(wordlist.Write(failingWriter{}, wordlist.EFFLong))
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	var output bytes.Buffer
+	if !assert.NoError(wordlist.Write(&output, wordlist.EFFLong)) {
+		return
+	}
+
+	assert.True(strings.HasPrefix(output.String(), "11111\tabacus\n"))
+
+	parsed, err := wordlist.Parse(&output)
+	if assert.NoError(err) {
+		for index := 0; index < wordlist.EFFLong.Len(); index++ {
+			assert.Equal(wordlist.EFFLong.WordAt(index), parsed.WordAt(index))
+		}
+	}
+}